@@ -16,6 +16,10 @@ type InfinispanSecurity struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CredentialStore Secret",xDescriptors={"urn:alm:descriptor:io.kubernetes:Secret"}
 	CredentialStoreSecretName string `json:"credentialStoreSecretName,omitempty"`
+	// An external secret store whose entries are added to the CredentialStore at runtime, without being copied into a
+	// Secret. Cannot be combined with CredentialStoreSecretName
+	// +optional
+	CredentialStoreSource *CredentialStoreSourceSpec `json:"credentialStoreSource,omitempty"`
 	// Enable or disable user authentication
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Toggle Authentication",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
@@ -26,6 +30,104 @@ type InfinispanSecurity struct {
 	EndpointSecretName string `json:"endpointSecretName,omitempty"`
 	// +optional
 	EndpointEncryption *EndpointEncryption `json:"endpointEncryption,omitempty"`
+	// Authenticate users against an LDAP directory instead of the credentials in EndpointSecretName
+	// +optional
+	LDAP *LDAPRealmSpec `json:"ldap,omitempty"`
+	// Authenticate users with the OAuth2/OIDC bearer tokens of an identity provider instead of the credentials in EndpointSecretName
+	// +optional
+	TokenRealm *TokenRealmSpec `json:"tokenRealm,omitempty"`
+	// Periodically rotate the passwords of the operator and generated application users
+	// +optional
+	CredentialRotation *CredentialRotationSpec `json:"credentialRotation,omitempty"`
+}
+
+// CredentialStoreSourceSpec defines the external secret store whose keys and values are added to the CredentialStore
+// as aliases and passwords. Exactly one store must be configured
+type CredentialStoreSourceSpec struct {
+	// How often the entries are retrieved from the secret store, e.g. 1m. Defaults to 5m
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+	// +optional
+	Vault *VaultCredentialStoreSpec `json:"vault,omitempty"`
+}
+
+// VaultCredentialStoreSpec defines a secret of the HashiCorp Vault KV version 2 secrets engine. The operator
+// authenticates with either a token or the Kubernetes auth method
+type VaultCredentialStoreSpec struct {
+	// The address of the Vault server, using the http:// or https:// scheme
+	URL string `json:"url"`
+	// The mount path of the KV version 2 secrets engine. Defaults to secret
+	// +optional
+	Mount string `json:"mount,omitempty"`
+	// The path of the secret in the secrets engine
+	Path string `json:"path"`
+	// The secret containing a Vault token in the 'token' key
+	// +optional
+	TokenSecretName string `json:"tokenSecretName,omitempty"`
+	// The Vault role used to login with the Kubernetes auth method, using the ServiceAccount token of the operator
+	// +optional
+	KubernetesRole string `json:"kubernetesRole,omitempty"`
+}
+
+// CredentialRotationSpec defines when the passwords generated by the operator are rotated. Rotations can also be
+// requested on demand with the infinispan.org/rotate-credentials annotation
+type CredentialRotationSpec struct {
+	// The interval between rotations, e.g. 720h. Passwords are only rotated on demand if not set
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// How long the previous passwords remain valid after a rotation, so that clients can pick up the updated secrets. Defaults to 1h
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// LDAPRealmSpec defines the LDAP directory used to authenticate users and retrieve their groups. The password of the
+// bind DN is added to the credential store of the server
+type LDAPRealmSpec struct {
+	// The URL of the LDAP server, using the ldap:// or ldaps:// scheme
+	URL string `json:"url"`
+	// The secret containing the PEM encoded CA certificates trusted by LDAPS connections in the 'ca.crt' key. LDAPS
+	// connections trust the default truststore of the JVM if not set
+	// +optional
+	CASecretName string `json:"caSecretName,omitempty"`
+	// The distinguished name used to bind to the LDAP server when searching for users and groups
+	BindDN string `json:"bindDN"`
+	// The secret containing the password of the bind DN in the 'password' key
+	BindCredentialSecretName string `json:"bindCredentialSecretName"`
+	// The distinguished name of the entry under which users are searched
+	UserSearchDN string `json:"userSearchDN"`
+	// The filter used to find the entry of a user, where {0} is replaced by the username. Defaults to (uid={0})
+	// +optional
+	UserFilter string `json:"userFilter,omitempty"`
+	// The attribute of a user entry containing the username. Defaults to uid
+	// +optional
+	UserNameAttribute string `json:"userNameAttribute,omitempty"`
+	// The distinguished name of the entry under which the groups of a user are searched. Groups are not retrieved if not set
+	// +optional
+	GroupSearchDN string `json:"groupSearchDN,omitempty"`
+	// The filter used to find the groups of a user, where {1} is replaced by the distinguished name of the user. Defaults to (member={1})
+	// +optional
+	GroupFilter string `json:"groupFilter,omitempty"`
+	// The attribute of a group entry that is mapped to a role of the user. Defaults to cn
+	// +optional
+	GroupNameAttribute string `json:"groupNameAttribute,omitempty"`
+}
+
+// TokenRealmSpec defines the identity provider whose JWT bearer tokens are used to authenticate Hot Rod and REST clients,
+// in addition to the users of the authentication secret
+type TokenRealmSpec struct {
+	// The URL of the identity provider that issues the tokens, matched against the iss claim
+	IssuerURL string `json:"issuerURL"`
+	// The client ID registered with the identity provider, matched against the aud claim
+	ClientID string `json:"clientID"`
+	// The PEM encoded public key used to verify the signature of tokens. The jku header of tokens is never trusted, so
+	// the key must be updated when the identity provider rotates its signing key
+	PublicKey string `json:"publicKey"`
+	// The claim of a token containing the username. Defaults to preferred_username
+	// +optional
+	PrincipalClaim string `json:"principalClaim,omitempty"`
+	// The claim of a token containing the roles of the user. Defaults to groups
+	// +optional
+	RoleClaim string `json:"roleClaim,omitempty"`
 }
 
 type Authorization struct {
@@ -41,7 +143,7 @@ type AuthorizationRole struct {
 }
 
 // CertificateSourceType specifies all the possible sources for the encryption certificate
-// +kubebuilder:validation:Enum=Service;service;Secret;secret;None
+// +kubebuilder:validation:Enum=Service;service;Secret;secret;CertManager;None
 type CertificateSourceType string
 
 const (
@@ -55,6 +157,9 @@ const (
 	// CertificateSourceTypeSecretLowCase certificate coming from a user provided secret
 	CertificateSourceTypeSecretLowCase CertificateSourceType = "secret"
 
+	// CertificateSourceTypeCertManager certificate issued by cert-manager
+	CertificateSourceTypeCertManager CertificateSourceType = "CertManager"
+
 	// CertificateSourceTypeNoneNoEncryption no certificate encryption disabled
 	CertificateSourceTypeNoneNoEncryption CertificateSourceType = "None"
 )
@@ -76,7 +181,7 @@ const (
 type EndpointEncryption struct {
 	// Disable or modify endpoint encryption.
 	// +optional
-	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Configure Encryption",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:Service","urn:alm:descriptor:com.tectonic.ui:select:Secret","urn:alm:descriptor:com.tectonic.ui:select:None"}
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Configure Encryption",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:Service","urn:alm:descriptor:com.tectonic.ui:select:Secret","urn:alm:descriptor:com.tectonic.ui:select:CertManager","urn:alm:descriptor:com.tectonic.ui:select:None"}
 	Type CertificateSourceType `json:"type,omitempty"`
 	// A service that provides TLS certificates
 	// +optional
@@ -86,12 +191,37 @@ type EndpointEncryption struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Encryption Secret",xDescriptors={"urn:alm:descriptor:io.kubernetes:Secret", "urn:alm:descriptor:com.tectonic.ui:fieldDependency:security.endpointEncryption.type:Secret"}
 	CertSecretName string `json:"certSecretName,omitempty"`
+	// The cert-manager Issuer used to issue the TLS certificate
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Certificate Issuer",xDescriptors="urn:alm:descriptor:com.tectonic.ui:fieldDependency:security.endpointEncryption.type:CertManager"
+	CertIssuer *CertificateIssuerRef `json:"certIssuer,omitempty"`
 	// +optional
 	ClientCert ClientCertType `json:"clientCert,omitempty"`
 	// +optional
 	ClientCertSecretName string `json:"clientCertSecretName,omitempty"`
 }
 
+// CertificateIssuerKind the kind of cert-manager issuer
+// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+type CertificateIssuerKind string
+
+const (
+	CertificateIssuerKindIssuer        CertificateIssuerKind = "Issuer"
+	CertificateIssuerKindClusterIssuer CertificateIssuerKind = "ClusterIssuer"
+)
+
+// CertificateIssuerRef references the cert-manager Issuer or ClusterIssuer used to issue certificates
+type CertificateIssuerRef struct {
+	// The name of the Issuer
+	Name string `json:"name"`
+	// The kind of the Issuer. Defaults to Issuer
+	// +optional
+	Kind CertificateIssuerKind `json:"kind,omitempty"`
+	// The API group of the Issuer. Defaults to cert-manager.io
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
 // InfinispanServiceContainerSpec resource requirements specific for service
 type InfinispanServiceContainerSpec struct {
 	// The amount of storage for the persistent volume claim.
@@ -355,6 +485,39 @@ type ExposeSpec struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// EndpointConnectorType the protocol served by an endpoint connector
+// +kubebuilder:validation:Enum=HotRod;REST;RESP;Memcached
+type EndpointConnectorType string
+
+const (
+	EndpointConnectorTypeHotRod    EndpointConnectorType = "HotRod"
+	EndpointConnectorTypeREST      EndpointConnectorType = "REST"
+	EndpointConnectorTypeRESP      EndpointConnectorType = "RESP"
+	EndpointConnectorTypeMemcached EndpointConnectorType = "Memcached"
+)
+
+// EndpointSpec describes a server endpoint that serves a single protocol on a dedicated port
+type EndpointSpec struct {
+	// The name of the endpoint, used to name the socket binding, connector and Services of the endpoint
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=15
+	Name string `json:"name"`
+	// The protocol served by the endpoint
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Connector Type",xDescriptors="urn:alm:descriptor:com.tectonic.ui:select:HotRod,urn:alm:descriptor:com.tectonic.ui:select:REST,urn:alm:descriptor:com.tectonic.ui:select:RESP,urn:alm:descriptor:com.tectonic.ui:select:Memcached"
+	Type EndpointConnectorType `json:"type"`
+	// The container port that the endpoint listens on
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// The security realm used to authenticate clients of the endpoint. Defaults to the realm of the default endpoint.
+	// Set to 'ldap' or 'token' to only authenticate the users of spec.security.ldap or spec.security.tokenRealm
+	// +optional
+	SecurityRealm string `json:"securityRealm,omitempty"`
+	// How the endpoint is exposed outside of the Kubernetes cluster
+	// +optional
+	Expose *ExposeSpec `json:"expose,omitempty"`
+}
+
 // CrossSiteExposeSpec describe how Infinispan Cross-Site service will be exposed externally
 type CrossSiteExposeSpec struct {
 	// Type specifies different exposition methods for data grid
@@ -372,14 +535,120 @@ type CrossSiteExposeSpec struct {
 
 // Autoscale describe autoscaling configuration for the cluster
 type Autoscale struct {
-	MaxReplicas        int32 `json:"maxReplicas"`
-	MinReplicas        int32 `json:"minReplicas"`
-	MaxMemUsagePercent int   `json:"maxMemUsagePercent"`
-	MinMemUsagePercent int   `json:"minMemUsagePercent"`
+	// Must be true for the Operator to scale the cluster. Autoscale configurations created for earlier Operator
+	// versions are ignored until explicitly enabled
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Enable Autoscaling",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	Enabled bool `json:"enabled,omitempty"`
+	// The maximum number of replicas that the cluster is scaled up to
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Replicas",xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	MaxReplicas int32 `json:"maxReplicas"`
+	// The minimum number of replicas that the cluster is scaled down to
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Minimum Replicas",xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	MinReplicas int32 `json:"minReplicas"`
+	// The percentage of the cluster's heap usage above which a replica is added
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Maximum Memory Usage Percent",xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	MaxMemUsagePercent int `json:"maxMemUsagePercent"`
+	// The percentage of the cluster's heap usage below which a replica is removed. Must be less than maxMemUsagePercent
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Minimum Memory Usage Percent",xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	MinMemUsagePercent int `json:"minMemUsagePercent"`
+	// The minimum time between two scaling operations, defaults to "5m"
+	// +optional
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+	// The number of owners configured for the cluster's distributed caches, used to determine whether the remaining
+	// replicas are able to hold all entries before a replica is removed. Defaults to 2
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replication Factor",xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	ReplicationFactor int32 `json:"replicationFactor,omitempty"`
 	// +optional
 	Disabled bool `json:"disabled,omitempty"`
 }
 
+// AutoscaleStatus describes the state of the cluster's autoscaling
+type AutoscaleStatus struct {
+	// The last time that the cluster was scaled by the Operator
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+}
+
+// TLSStatus describes the endpoint certificate and the progress of certificate rotations
+type TLSStatus struct {
+	// The start of the certificate's validity period
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+	// The end of the certificate's validity period
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+	// The time that the rotation of updated certificates started, present whilst a rotation is in progress
+	// +optional
+	RotationStartTime *metav1.Time `json:"rotationStartTime,omitempty"`
+}
+
+// CredentialRotationStatus describes the most recent rotation of the generated passwords
+type CredentialRotationStatus struct {
+	// The time that the passwords were last rotated
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// The time that the current rotation started, present until the rotated passwords have been applied to every pod
+	// +optional
+	RotationStartTime *metav1.Time `json:"rotationStartTime,omitempty"`
+	// The time that the previous passwords are revoked, present whilst the grace period of a rotation is in progress
+	// +optional
+	GracePeriodEndTime *metav1.Time `json:"gracePeriodEndTime,omitempty"`
+}
+
+// CrossSiteStatus describes the cross-site replication state of the cluster as reported by the server
+type CrossSiteStatus struct {
+	// The remote sites of the cluster
+	// +optional
+	Sites []CrossSiteLocationStatus `json:"sites,omitempty"`
+	// The names of the pods that relay requests to the remote sites
+	// +optional
+	RelayNodes []string `json:"relayNodes,omitempty"`
+	// The status of each cache that backs up to a remote site
+	// +optional
+	Caches []CrossSiteCacheStatus `json:"caches,omitempty"`
+	// The last time that the cross-site state was retrieved from the server
+	// +optional
+	LastObservedTime *metav1.Time `json:"lastObservedTime,omitempty"`
+}
+
+// CrossSiteLocationStatus describes the state of a remote site
+type CrossSiteLocationStatus struct {
+	// The name of the site
+	Name string `json:"name"`
+	// Whether the site is part of the cross-site view
+	Reachable bool `json:"reachable"`
+	// Whether backups to the site are online, offline or mixed across all caches
+	// +optional
+	Status string `json:"status,omitempty"`
+}
+
+// CrossSiteCacheStatus describes the state of the backups of a single cache
+type CrossSiteCacheStatus struct {
+	// The name of the cache
+	Name string `json:"name"`
+	// The state of each backup location of the cache
+	// +optional
+	Backups []CrossSiteBackupStatus `json:"backups,omitempty"`
+	// The error encountered when retrieving the state of the cache's backups, if any
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// CrossSiteBackupStatus describes the state of a cache's backup location
+type CrossSiteBackupStatus struct {
+	// The name of the backup site
+	Site string `json:"site"`
+	// Whether backups to the site are online or offline, or mixed if the state differs between pods
+	// +optional
+	Status string `json:"status,omitempty"`
+	// The status of the most recent state transfer to the site, if any
+	// +optional
+	StateTransfer string `json:"stateTransfer,omitempty"`
+}
+
 // InfinispanExternalDependencies describes all the external dependencies
 // used by the Infinispan cluster: i.e. lib folder with custom jar, maven artifact, images ...
 type InfinispanExternalDependencies struct {
@@ -464,6 +733,15 @@ type ConfigListenerSpec struct {
 	Memory string `json:"memory,omitempty"`
 	// +optional
 	CPU string `json:"cpu,omitempty"`
+	// The number of ConfigListener pods. A single pod processes server events at any one time, with the remaining pods
+	// taking over if the active pod fails. Defaults to 1
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Config Listener Replicas",xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Affinity of the ConfigListener pods. Defaults to preferring that pods are deployed on distinct nodes
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
 }
 
 type ConfigListenerLoggingSpec struct {
@@ -480,6 +758,90 @@ type JmxSpec struct {
 	Enabled bool `json:"enabled,omitempty"`
 }
 
+// JGroupsDiscoveryType the protocol used by the cluster members to discover each other
+// +kubebuilder:validation:Enum=DNS;Kubernetes;Static
+type JGroupsDiscoveryType string
+
+const (
+	// JGroupsDiscoveryTypeDNS members are discovered with DNS_PING via the ping service
+	JGroupsDiscoveryTypeDNS JGroupsDiscoveryType = "DNS"
+	// JGroupsDiscoveryTypeKubernetes members are discovered with KUBE_PING via the Kubernetes API
+	JGroupsDiscoveryTypeKubernetes JGroupsDiscoveryType = "Kubernetes"
+	// JGroupsDiscoveryTypeStatic members are discovered with TCPPING from a static list of hosts
+	JGroupsDiscoveryTypeStatic JGroupsDiscoveryType = "Static"
+)
+
+// JGroupsEncryptionType the protocol used to encrypt intra-cluster traffic
+// +kubebuilder:validation:Enum=Symmetric;Asymmetric
+type JGroupsEncryptionType string
+
+const (
+	// JGroupsEncryptionTypeSymmetric traffic is encrypted with SYM_ENCRYPT using a shared secret key
+	JGroupsEncryptionTypeSymmetric JGroupsEncryptionType = "Symmetric"
+	// JGroupsEncryptionTypeAsymmetric traffic is encrypted with ASYM_ENCRYPT using a secret key distributed by the coordinator
+	JGroupsEncryptionTypeAsymmetric JGroupsEncryptionType = "Asymmetric"
+)
+
+// JGroupsSpec configures the JGroups transport used for intra-cluster communication
+type JGroupsSpec struct {
+	// The discovery protocol can only be updated when spec.replicas is 0
+	// +optional
+	Discovery *JGroupsDiscoverySpec `json:"discovery,omitempty"`
+	// +optional
+	FailureDetection *JGroupsFailureDetectionSpec `json:"failureDetection,omitempty"`
+	// +optional
+	Merge *JGroupsMergeSpec `json:"merge,omitempty"`
+	// Transport encryption can only be updated when spec.replicas is 0
+	// +optional
+	Encryption *JGroupsEncryptionSpec `json:"encryption,omitempty"`
+}
+
+// JGroupsDiscoverySpec configures how the cluster members discover each other
+type JGroupsDiscoverySpec struct {
+	// The discovery protocol. Defaults to DNS
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Discovery Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:DNS","urn:alm:descriptor:com.tectonic.ui:select:Kubernetes","urn:alm:descriptor:com.tectonic.ui:select:Static"}
+	Type JGroupsDiscoveryType `json:"type"`
+	// The initial members of the cluster in the format host[port], required when type is Static
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+}
+
+// JGroupsFailureDetectionSpec configures the detection of failed cluster members
+type JGroupsFailureDetectionSpec struct {
+	// The interval in milliseconds at which heartbeats are sent to the other members
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Interval *int64 `json:"interval,omitempty"`
+	// The time in milliseconds without a heartbeat after which a member is suspected
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Timeout *int64 `json:"timeout,omitempty"`
+}
+
+// JGroupsMergeSpec configures how often the cluster checks for, and merges, partitions
+type JGroupsMergeSpec struct {
+	// The minimum interval in milliseconds between checks for partitions
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinInterval *int64 `json:"minInterval,omitempty"`
+	// The maximum interval in milliseconds between checks for partitions
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxInterval *int64 `json:"maxInterval,omitempty"`
+}
+
+// JGroupsEncryptionSpec configures the encryption of intra-cluster traffic
+type JGroupsEncryptionSpec struct {
+	// The encryption protocol
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Transport Encryption Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:Symmetric","urn:alm:descriptor:com.tectonic.ui:select:Asymmetric"}
+	Type JGroupsEncryptionType `json:"type"`
+	// The Secret containing the PKCS12 keystore with the shared secret key, required when type is Symmetric. The
+	// Secret must contain the keys 'keystore.p12', 'password' and 'alias'
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Transport Encryption Secret",xDescriptors={"urn:alm:descriptor:io.kubernetes:Secret","urn:alm:descriptor:com.tectonic.ui:fieldDependency:jgroups.encryption.type:Symmetric"}
+	SecretName string `json:"secretName,omitempty"`
+}
+
 type SchedulingSpec struct {
 	// +optional
 	Affinity *corev1.Affinity `json:"affinity,omitempty"`
@@ -512,6 +874,11 @@ type InfinispanSpec struct {
 	Logging *InfinispanLoggingSpec `json:"logging,omitempty"`
 	// +optional
 	Expose *ExposeSpec `json:"expose,omitempty"`
+	// Additional endpoints that serve a single protocol on a dedicated port, alongside the default endpoint
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Endpoints []EndpointSpec `json:"endpoints,omitempty"`
 	// +optional
 	Autoscale *Autoscale `json:"autoscale,omitempty"`
 	// +optional
@@ -533,11 +900,66 @@ type InfinispanSpec struct {
 	Jmx *JmxSpec `json:"jmx,omitempty"`
 	// +optional
 	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// +optional
+	JGroups *JGroupsSpec `json:"jgroups,omitempty"`
 }
 
 // InfinispanUpgradesSpec defines the Infinispan upgrade strategy
 type InfinispanUpgradesSpec struct {
 	Type UpgradeType `json:"type"`
+	// Checks that must pass before the cluster is shutdown for a Shutdown upgrade
+	// +optional
+	Preflight *UpgradePreflightSpec `json:"preflight,omitempty"`
+	// Automatic rollback of Shutdown upgrades that fail to form a cluster
+	// +optional
+	Rollback *UpgradeRollbackSpec `json:"rollback,omitempty"`
+	// Checkpoints of HotRodRolling upgrades
+	// +optional
+	HotRodRolling *HotRodRollingUpgradeSpec `json:"hotRodRolling,omitempty"`
+	// Verification of the target Operand on a single canary pod before the cluster is upgraded
+	// +optional
+	Canary *UpgradeCanarySpec `json:"canary,omitempty"`
+}
+
+// UpgradeCanarySpec defines the verification of the target Operand that is performed before an upgrade is started.
+// A canary pod is started with the target Operand and the upgrade only proceeds once its health, cache operations and
+// metrics have been verified
+type UpgradeCanarySpec struct {
+	// The time allowed for the canary pod to pass all checks before the upgrade is blocked. Defaults to 10m
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// HotRodRollingUpgradeSpec defines the stages before which a HotRodRolling upgrade is paused. A paused upgrade is
+// resumed by adding the infinispan.org/hotrod-upgrade-resume annotation to the Infinispan CR
+type HotRodRollingUpgradeSpec struct {
+	// Pause the upgrade before clients are redirected to the target cluster
+	// +optional
+	PauseBeforeRedirect bool `json:"pauseBeforeRedirect,omitempty"`
+	// Pause the upgrade before the source cluster is replaced by the target cluster
+	// +optional
+	PauseBeforeStatefulSetReplace bool `json:"pauseBeforeStatefulSetReplace,omitempty"`
+}
+
+// UpgradePreflightSpec defines the checks performed before a Shutdown upgrade is started. The upgrade is not started
+// until the cluster is healthy, the data of all caches survives the restart, there is enough free space on the data
+// volume of each pod and the image of the target Operand can be pulled
+type UpgradePreflightSpec struct {
+	// The names of caches without persistent storage whose data can be lost during the upgrade
+	// +optional
+	VolatileCaches []string `json:"volatileCaches,omitempty"`
+	// The minimum percentage of free space required on the data volume of each pod. Defaults to 10
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MinFreeStoragePercent *int32 `json:"minFreeStoragePercent,omitempty"`
+}
+
+// UpgradeRollbackSpec defines when a Shutdown upgrade is rolled back to the previous Operand
+type UpgradeRollbackSpec struct {
+	// The time to wait for the upgraded cluster to become WellFormed before the previous Operand is restored. Defaults to 10m
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 type UpgradeType string
@@ -562,6 +984,18 @@ const (
 	ConditionCrossSiteViewFormed ConditionType = "CrossSiteViewFormed"
 	ConditionGossipRouterReady   ConditionType = "GossipRouterReady"
 	ConditionTLSSecretValid      ConditionType = "TLSSecretValid"
+	// ConditionTLSCertificateExpiring is true when the endpoint certificate expires within the warning period
+	ConditionTLSCertificateExpiring ConditionType = "TLSCertificateExpiring"
+	// ConditionCachesSynchronized is false when the last ConfigListener resync repaired Cache CRs that had drifted from the server
+	ConditionCachesSynchronized ConditionType = "CachesSynchronized"
+	// ConditionUpgradePreflightChecksPassed is false when a Shutdown upgrade is blocked by a failed preflight check
+	ConditionUpgradePreflightChecksPassed ConditionType = "UpgradePreflightChecksPassed"
+	// ConditionCanaryHealthCheckPassed is true when the canary pod of an upgrade reports a healthy server
+	ConditionCanaryHealthCheckPassed ConditionType = "CanaryHealthCheckPassed"
+	// ConditionCanaryCacheCheckPassed is true when entries can be written to and read from a cache on the canary pod of an upgrade
+	ConditionCanaryCacheCheckPassed ConditionType = "CanaryCacheCheckPassed"
+	// ConditionCanaryMetricsCheckPassed is true when the canary pod of an upgrade exposes valid metrics
+	ConditionCanaryMetricsCheckPassed ConditionType = "CanaryMetricsCheckPassed"
 )
 
 // InfinispanCondition define a condition of the cluster
@@ -606,6 +1040,30 @@ type InfinispanStatus struct {
 	ConsoleUrl *string `json:"consoleUrl,omitempty"`
 	// +optional
 	HotRodRollingUpgradeStatus *HotRodRollingUpgradeStatus `json:"hotRodRollingUpgradeStatus,omitempty"`
+	// The status of the most recent Shutdown upgrade, present while the upgrade is in progress or after it was rolled back
+	// +optional
+	GracefulShutdownUpgrade *GracefulShutdownUpgradeStatus `json:"gracefulShutdownUpgrade,omitempty"`
+	// The status of the canary verification of the most recent upgrade, present when spec.upgrades.canary is configured
+	// +optional
+	UpgradeCanary *UpgradeCanaryStatus `json:"upgradeCanary,omitempty"`
+	// The autoscaling status, present when spec.autoscale is configured
+	// +optional
+	Autoscale *AutoscaleStatus `json:"autoscale,omitempty"`
+	// The cross-site status, present when spec.service.sites is configured
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Cross-Site Status"
+	CrossSite *CrossSiteStatus `json:"crossSite,omitempty"`
+	// The endpoint certificate status, present when endpoint encryption is enabled
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="TLS Status"
+	TLS *TLSStatus `json:"tls,omitempty"`
+	// The credential rotation status, present once the generated passwords have been rotated
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Credential Rotation Status"
+	CredentialRotation *CredentialRotationStatus `json:"credentialRotation,omitempty"`
+	// The names of the Services, Routes or Ingresses that expose the endpoints of spec.endpoints
+	// +optional
+	ExposedEndpoints []string `json:"exposedEndpoints,omitempty"`
 	// The Operand status
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Operand Status"
@@ -651,6 +1109,82 @@ type HotRodRollingUpgradeStatus struct {
 	SourceStatefulSetName string                    `json:"SourceStatefulSetName,omitempty"`
 	SourceVersion         string                    `json:"SourceVersion,omitempty"`
 	TargetStatefulSetName string                    `json:"TargetStatefulSetName,omitempty"`
+	// True if the upgrade is waiting for the infinispan.org/hotrod-upgrade-resume annotation before executing the current stage
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// The migration progress of each cache
+	// +optional
+	Caches []HotRodRollingUpgradeCacheStatus `json:"caches,omitempty"`
+}
+
+type HotRodRollingUpgradeCacheState string
+
+const (
+	// HotRodRollingUpgradeCacheConnected indicates that the target cache reads missing entries from the source cluster
+	HotRodRollingUpgradeCacheConnected HotRodRollingUpgradeCacheState = "Connected"
+	// HotRodRollingUpgradeCacheSynced indicates that all entries have been copied to the target cache and the source disconnected
+	HotRodRollingUpgradeCacheSynced HotRodRollingUpgradeCacheState = "Synced"
+	// HotRodRollingUpgradeCacheFailed indicates that the last attempt to copy the entries to the target cache failed
+	HotRodRollingUpgradeCacheFailed HotRodRollingUpgradeCacheState = "Failed"
+)
+
+type HotRodRollingUpgradeCacheStatus struct {
+	Name  string                         `json:"name"`
+	State HotRodRollingUpgradeCacheState `json:"state"`
+	// The number of entries copied from the source cluster
+	// +optional
+	EntriesMigrated int64 `json:"entriesMigrated,omitempty"`
+	// The error encountered by the last failed attempt to copy the entries
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+type GracefulShutdownUpgradePhase string
+
+const (
+	// GracefulShutdownUpgradePhaseUpgrading indicates that the cluster is being restarted with the target Operand
+	GracefulShutdownUpgradePhaseUpgrading GracefulShutdownUpgradePhase = "Upgrading"
+	// GracefulShutdownUpgradePhaseRollingBack indicates that the cluster is being restarted with the source Operand
+	GracefulShutdownUpgradePhaseRollingBack GracefulShutdownUpgradePhase = "RollingBack"
+	// GracefulShutdownUpgradePhaseRolledBack indicates that the target Operand failed to form a cluster and the source Operand was restored
+	GracefulShutdownUpgradePhaseRolledBack GracefulShutdownUpgradePhase = "RolledBack"
+)
+
+type GracefulShutdownUpgradeStatus struct {
+	// +optional
+	Phase GracefulShutdownUpgradePhase `json:"phase,omitempty"`
+	// The Operand installed before the upgrade
+	// +optional
+	Source OperandStatus `json:"source,omitempty"`
+	// The time at which the cluster was restarted with the target Operand
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type UpgradeCanaryPhase string
+
+const (
+	// UpgradeCanaryPhaseVerifying indicates that the canary pod is being started and checked
+	UpgradeCanaryPhaseVerifying UpgradeCanaryPhase = "Verifying"
+	// UpgradeCanaryPhaseSucceeded indicates that the canary pod passed all checks and the upgrade can proceed
+	UpgradeCanaryPhaseSucceeded UpgradeCanaryPhase = "Succeeded"
+	// UpgradeCanaryPhaseFailed indicates that the canary pod did not pass all checks within the timeout and the upgrade is blocked
+	UpgradeCanaryPhaseFailed UpgradeCanaryPhase = "Failed"
+)
+
+type UpgradeCanaryStatus struct {
+	// +optional
+	Phase UpgradeCanaryPhase `json:"phase,omitempty"`
+	// The image of the target Operand verified by the canary pod
+	// +optional
+	Image string `json:"image,omitempty"`
+	// The time at which the canary verification was started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type HotRodRollingUpgradeStage string
@@ -711,6 +1245,10 @@ type EncryptionSiteSpec struct {
 	RouterKeyStore    CrossSiteKeyStore `json:"routerKeyStore"`
 	// +optional
 	TrustStore *CrossSiteTrustStore `json:"trustStore,omitempty"`
+	// The cert-manager Issuer used to issue the transport and router keystores. When configured, the keystores are
+	// created in the transportKeyStore and routerKeyStore secrets by cert-manager
+	// +optional
+	CertIssuer *CertificateIssuerRef `json:"certIssuer,omitempty"`
 }
 
 // CrossSiteKeyStore keystore configuration for cross-site replication with TLS
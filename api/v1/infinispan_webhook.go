@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/blang/semver"
 	consts "github.com/infinispan/infinispan-operator/controllers/constants"
@@ -77,11 +80,55 @@ func (i *Infinispan) Default() {
 	} else if i.IsGeneratedSecret() {
 		i.Spec.Security.EndpointSecretName = ""
 	}
+	if ldap := i.Spec.Security.LDAP; ldap != nil {
+		if ldap.UserFilter == "" {
+			ldap.UserFilter = consts.DefaultLDAPUserFilter
+		}
+		if ldap.UserNameAttribute == "" {
+			ldap.UserNameAttribute = consts.DefaultLDAPUserNameAttribute
+		}
+		if ldap.GroupSearchDN != "" {
+			if ldap.GroupFilter == "" {
+				ldap.GroupFilter = consts.DefaultLDAPGroupFilter
+			}
+			if ldap.GroupNameAttribute == "" {
+				ldap.GroupNameAttribute = consts.DefaultLDAPGroupNameAttribute
+			}
+		}
+	}
+	if token := i.Spec.Security.TokenRealm; token != nil {
+		if token.PrincipalClaim == "" {
+			token.PrincipalClaim = consts.DefaultTokenRealmPrincipalClaim
+		}
+		if token.RoleClaim == "" {
+			token.RoleClaim = consts.DefaultTokenRealmRoleClaim
+		}
+	}
+	if source := i.Spec.Security.CredentialStoreSource; source != nil {
+		if source.RefreshInterval == nil {
+			source.RefreshInterval = &metav1.Duration{Duration: consts.DefaultCredentialStoreRefreshInterval}
+		}
+		if source.Vault != nil && source.Vault.Mount == "" {
+			source.Vault.Mount = consts.DefaultCredentialStoreVaultMount
+		}
+	}
+	if rotation := i.Spec.Security.CredentialRotation; rotation != nil && rotation.GracePeriod == nil {
+		rotation.GracePeriod = &metav1.Duration{Duration: consts.DefaultCredentialRotationGracePeriod}
+	}
 	if i.Spec.Upgrades == nil {
 		i.Spec.Upgrades = &InfinispanUpgradesSpec{
 			Type: UpgradeTypeShutdown,
 		}
 	}
+	if i.Spec.Upgrades.Preflight != nil && i.Spec.Upgrades.Preflight.MinFreeStoragePercent == nil {
+		i.Spec.Upgrades.Preflight.MinFreeStoragePercent = pointer.Int32(consts.DefaultUpgradePreflightMinFreeStoragePercent)
+	}
+	if i.Spec.Upgrades.Rollback != nil && i.Spec.Upgrades.Rollback.Timeout == nil {
+		i.Spec.Upgrades.Rollback.Timeout = &metav1.Duration{Duration: consts.DefaultUpgradeRollbackTimeout}
+	}
+	if i.Spec.Upgrades.Canary != nil && i.Spec.Upgrades.Canary.Timeout == nil {
+		i.Spec.Upgrades.Canary.Timeout = &metav1.Duration{Duration: consts.DefaultUpgradeCanaryTimeout}
+	}
 	if i.Spec.ConfigListener == nil {
 		i.Spec.ConfigListener = &ConfigListenerSpec{
 			Enabled: true,
@@ -93,6 +140,10 @@ func (i *Infinispan) Default() {
 		}
 	}
 
+	if i.Spec.Autoscale != nil && i.Spec.Autoscale.Enabled && i.Spec.Autoscale.Cooldown == nil {
+		i.Spec.Autoscale.Cooldown = &metav1.Duration{Duration: consts.DefaultAutoscaleCooldown}
+	}
+
 	if i.Spec.Scheduling == nil {
 		i.Spec.Scheduling = &SchedulingSpec{}
 	}
@@ -192,8 +243,9 @@ func (i *Infinispan) ValidateUpdate(oldRuntimeObj runtime.Object) error {
 		// If the oldOperand has been removed, then no validation required as we must upgrade to a newer version
 		if !errors.As(err, &unknown) {
 			if i.GracefulShutdownUpgrades() {
-				// Version downgrades are not supported with Graceful Shutdown
-				if operand.LT(oldOperand) {
+				// Version downgrades are not supported with Graceful Shutdown, unless the Operator is rolling back a
+				// failed upgrade to the source Operand
+				if operand.LT(oldOperand) && !(old.IsUpgradeRollingBack() && operand.Ref() == old.Status.GracefulShutdownUpgrade.Source.Version) {
 					detail := fmt.Sprintf("Version downgrading not supported. Existing='%s', Requested='%s'.", oldOperand.Ref(), operand.Ref())
 					allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("version"), detail))
 				}
@@ -224,6 +276,20 @@ func (i *Infinispan) ValidateUpdate(oldRuntimeObj runtime.Object) error {
 		allErrs = append(allErrs, err)
 	}
 
+	// Pods with a different discovery protocol or encryption configuration are unable to join the existing members, so
+	// rolling the StatefulSet would split the cluster. These changes require the cluster to be shutdown first
+	if old.Spec.Replicas > 0 {
+		jgroupsPath := field.NewPath("spec").Child("jgroups")
+		if old.GetJGroupsDiscoveryType() != i.GetJGroupsDiscoveryType() {
+			msg := "JGroups discovery cannot be updated while the cluster is running. Set spec.replicas to 0 before updating spec.jgroups.discovery.type"
+			allErrs = append(allErrs, field.Forbidden(jgroupsPath.Child("discovery").Child("type"), msg))
+		}
+		if !reflect.DeepEqual(old.getJGroupsEncryption(), i.getJGroupsEncryption()) {
+			msg := "JGroups encryption cannot be updated while the cluster is running. Set spec.replicas to 0 before updating spec.jgroups.encryption"
+			allErrs = append(allErrs, field.Forbidden(jgroupsPath.Child("encryption"), msg))
+		}
+	}
+
 	if i.Spec.Service.Container != nil && i.Spec.Service.Container.Storage != nil && *old.Spec.Service.Container.Storage != *i.Spec.Service.Container.Storage {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("service").Child("container").Child("storage"), "Storage configuration is immutable and cannot be updated after initial Infinispan creation"))
 	}
@@ -280,10 +346,24 @@ func (i *Infinispan) validate() error {
 		allErrs = append(allErrs, err)
 	}
 
-	if i.Spec.Autoscale != nil {
-		msg := "Autoscale is no longer supported. Please remove spec.autoscale field."
-		err := field.Forbidden(field.NewPath("spec").Child("autoscale"), msg)
-		allErrs = append(allErrs, err)
+	// Configurations that have not been enabled are ignored by the Operator, so only validate enabled configurations
+	if a := i.Spec.Autoscale; a != nil && a.Enabled {
+		path := field.NewPath("spec").Child("autoscale")
+		if a.MinReplicas < 1 {
+			allErrs = append(allErrs, field.Invalid(path.Child("minReplicas"), a.MinReplicas, "minReplicas must be greater than 0"))
+		}
+		if a.MaxReplicas < a.MinReplicas {
+			allErrs = append(allErrs, field.Invalid(path.Child("maxReplicas"), a.MaxReplicas, "maxReplicas must be greater than or equal to minReplicas"))
+		}
+		if a.MaxMemUsagePercent < 1 || a.MaxMemUsagePercent > 100 {
+			allErrs = append(allErrs, field.Invalid(path.Child("maxMemUsagePercent"), a.MaxMemUsagePercent, "maxMemUsagePercent must be between 1 and 100"))
+		}
+		if a.MinMemUsagePercent < 0 || a.MinMemUsagePercent >= a.MaxMemUsagePercent {
+			allErrs = append(allErrs, field.Invalid(path.Child("minMemUsagePercent"), a.MinMemUsagePercent, "minMemUsagePercent must be at least 0 and less than maxMemUsagePercent"))
+		}
+		if a.Cooldown != nil && a.Cooldown.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("cooldown"), a.Cooldown.Duration.String(), "cooldown must not be negative"))
+		}
 	}
 
 	if i.IsEncryptionEnabled() {
@@ -299,6 +379,102 @@ func (i *Infinispan) validate() error {
 			err := field.Forbidden(field.NewPath("spec").Child("security").Child("endpointEncryption").Child("certServiceName"), msg)
 			allErrs = append(allErrs, err)
 		}
+
+		path := field.NewPath("spec").Child("security").Child("endpointEncryption")
+		if i.IsEncryptionCertFromCertManager() {
+			if e.CertServiceName != "" {
+				msg := fmt.Sprintf(".certServiceName cannot be configured with Encryption .type=%s", CertificateSourceTypeCertManager)
+				allErrs = append(allErrs, field.Forbidden(path.Child("certServiceName"), msg))
+			}
+			if e.CertIssuer == nil || e.CertIssuer.Name == "" {
+				msg := fmt.Sprintf("field must be provided for 'spec.security.endpointEncryption.type=%s' to be configured", CertificateSourceTypeCertManager)
+				allErrs = append(allErrs, field.Required(path.Child("certIssuer").Child("name"), msg))
+			}
+		} else if e.CertIssuer != nil {
+			msg := fmt.Sprintf(".certIssuer can only be configured with Encryption .type=%s", CertificateSourceTypeCertManager)
+			allErrs = append(allErrs, field.Forbidden(path.Child("certIssuer"), msg))
+		}
+	}
+
+	if ldap := i.Spec.Security.LDAP; ldap != nil {
+		path := field.NewPath("spec").Child("security").Child("ldap")
+		if !strings.HasPrefix(ldap.URL, "ldap://") && !strings.HasPrefix(ldap.URL, "ldaps://") {
+			allErrs = append(allErrs, field.Invalid(path.Child("url"), ldap.URL, "LDAP URL must use the ldap:// or ldaps:// scheme"))
+		} else if ldap.CASecretName != "" && !strings.HasPrefix(ldap.URL, "ldaps://") {
+			allErrs = append(allErrs, field.Forbidden(path.Child("caSecretName"), "CA certificates can only be configured with an ldaps:// URL"))
+		}
+		if !i.IsAuthenticationEnabled() {
+			msg := "LDAP realm requires 'spec.security.endpointAuthentication=true'"
+			allErrs = append(allErrs, field.Forbidden(path, msg))
+		} else if i.IsClientCertEnabled() && i.Spec.Security.EndpointEncryption.ClientCert == ClientCertAuthenticate {
+			msg := fmt.Sprintf("LDAP realm cannot be configured with 'spec.security.endpointEncryption.clientCert=%s'", ClientCertAuthenticate)
+			allErrs = append(allErrs, field.Forbidden(path, msg))
+		}
+	}
+
+	if token := i.Spec.Security.TokenRealm; token != nil {
+		path := field.NewPath("spec").Child("security").Child("tokenRealm")
+		if !strings.HasPrefix(token.IssuerURL, "https://") && !strings.HasPrefix(token.IssuerURL, "http://") {
+			allErrs = append(allErrs, field.Invalid(path.Child("issuerURL"), token.IssuerURL, "Issuer URL must use the http:// or https:// scheme"))
+		}
+		if token.ClientID == "" {
+			allErrs = append(allErrs, field.Required(path.Child("clientID"), "Client ID must be provided"))
+		}
+		if token.PublicKey == "" {
+			allErrs = append(allErrs, field.Required(path.Child("publicKey"), "Public key must be provided"))
+		}
+		if !i.IsAuthenticationEnabled() {
+			msg := "Token realm requires 'spec.security.endpointAuthentication=true'"
+			allErrs = append(allErrs, field.Forbidden(path, msg))
+		} else if i.Spec.Security.LDAP != nil {
+			msg := "Token realm cannot be configured with 'spec.security.ldap'"
+			allErrs = append(allErrs, field.Forbidden(path, msg))
+		} else if i.IsClientCertEnabled() && i.Spec.Security.EndpointEncryption.ClientCert == ClientCertAuthenticate {
+			msg := fmt.Sprintf("Token realm cannot be configured with 'spec.security.endpointEncryption.clientCert=%s'", ClientCertAuthenticate)
+			allErrs = append(allErrs, field.Forbidden(path, msg))
+		}
+	}
+
+	if source := i.Spec.Security.CredentialStoreSource; source != nil {
+		path := field.NewPath("spec").Child("security").Child("credentialStoreSource")
+		if i.IsCredentialStoreSecretDefined() {
+			msg := "CredentialStore source cannot be configured with 'spec.security.credentialStoreSecretName'"
+			allErrs = append(allErrs, field.Forbidden(path, msg))
+		}
+		if source.RefreshInterval != nil && source.RefreshInterval.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("refreshInterval"), source.RefreshInterval.Duration.String(), "Refresh interval must be greater than zero"))
+		}
+		if vault := source.Vault; vault == nil {
+			allErrs = append(allErrs, field.Required(path.Child("vault"), "A secret store must be configured"))
+		} else {
+			path := path.Child("vault")
+			if !strings.HasPrefix(vault.URL, "https://") && !strings.HasPrefix(vault.URL, "http://") {
+				allErrs = append(allErrs, field.Invalid(path.Child("url"), vault.URL, "Vault URL must use the http:// or https:// scheme"))
+			}
+			if vault.Path == "" {
+				allErrs = append(allErrs, field.Required(path.Child("path"), "Secret path must be provided"))
+			}
+			if vault.TokenSecretName == "" && vault.KubernetesRole == "" {
+				allErrs = append(allErrs, field.Required(path, "One of 'tokenSecretName' or 'kubernetesRole' must be provided"))
+			} else if vault.TokenSecretName != "" && vault.KubernetesRole != "" {
+				allErrs = append(allErrs, field.Forbidden(path.Child("kubernetesRole"), "Kubernetes auth role cannot be configured with 'tokenSecretName'"))
+			}
+		}
+	}
+
+	if rotation := i.Spec.Security.CredentialRotation; rotation != nil {
+		path := field.NewPath("spec").Child("security").Child("credentialRotation")
+		if rotation.GracePeriod != nil && rotation.GracePeriod.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("gracePeriod"), rotation.GracePeriod.Duration.String(), "Grace period must be greater than zero"))
+		}
+		if rotation.Interval != nil {
+			if rotation.Interval.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("interval"), rotation.Interval.Duration.String(), "Interval must be greater than zero"))
+			} else if rotation.GracePeriod != nil && rotation.Interval.Duration <= rotation.GracePeriod.Duration {
+				msg := fmt.Sprintf("Interval must be greater than the grace period '%s'", rotation.GracePeriod.Duration)
+				allErrs = append(allErrs, field.Invalid(path.Child("interval"), rotation.Interval.Duration.String(), msg))
+			}
+		}
 	}
 
 	if cl := i.Spec.ConfigListener; cl != nil {
@@ -343,6 +519,33 @@ func (i *Infinispan) validate() error {
 		}
 	}
 
+	if i.Spec.Upgrades.Type != UpgradeTypeShutdown {
+		path := field.NewPath("spec").Child("upgrades")
+		if i.Spec.Upgrades.Preflight != nil {
+			msg := fmt.Sprintf("Preflight checks only supported with %s upgrades", UpgradeTypeShutdown)
+			allErrs = append(allErrs, field.Forbidden(path.Child("preflight"), msg))
+		}
+		if i.Spec.Upgrades.Rollback != nil {
+			msg := fmt.Sprintf("Automatic rollback only supported with %s upgrades", UpgradeTypeShutdown)
+			allErrs = append(allErrs, field.Forbidden(path.Child("rollback"), msg))
+		}
+	}
+
+	if i.Spec.Upgrades.Type != UpgradeTypeHotRodRolling && i.Spec.Upgrades.HotRodRolling != nil {
+		msg := fmt.Sprintf("Upgrade checkpoints only supported with %s upgrades", UpgradeTypeHotRodRolling)
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("upgrades").Child("hotRodRolling"), msg))
+	}
+
+	if i.Spec.Upgrades.Rollback != nil && i.Spec.Upgrades.Rollback.Timeout != nil && i.Spec.Upgrades.Rollback.Timeout.Duration <= 0 {
+		msg := "Rollback timeout must be greater than zero"
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("upgrades").Child("rollback").Child("timeout"), i.Spec.Upgrades.Rollback.Timeout.String(), msg))
+	}
+
+	if i.Spec.Upgrades.Canary != nil && i.Spec.Upgrades.Canary.Timeout != nil && i.Spec.Upgrades.Canary.Timeout.Duration <= 0 {
+		msg := "Canary timeout must be greater than zero"
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec").Child("upgrades").Child("canary").Child("timeout"), i.Spec.Upgrades.Canary.Timeout.String(), msg))
+	}
+
 	if i.HasExternalArtifacts() {
 		path := field.NewPath("spec").Child("dependencies")
 		for i, artifact := range i.Spec.Dependencies.Artifacts {
@@ -433,8 +636,26 @@ func (i *Infinispan) validate() error {
 			}
 		}
 
+		if i.IsSiteCertFromCertManager() {
+			path := field.NewPath("spec").Child("service").Child("sites").Child("local").Child("encryption")
+			tls := i.Spec.Service.Sites.Local.Encryption
+			if tls.CertIssuer.Name == "" {
+				allErrs = append(allErrs, field.Required(path.Child("certIssuer").Child("name"), "The Issuer name must be provided"))
+			}
+			if tls.TransportKeyStore.SecretName == tls.RouterKeyStore.SecretName {
+				allErrs = append(allErrs, field.Duplicate(path.Child("routerKeyStore").Child("secretName"), tls.RouterKeyStore.SecretName))
+			}
+			msg := fmt.Sprintf(".filename cannot be configured with .certIssuer, cert-manager creates '%s'", consts.DefaultSiteKeyStoreFileName)
+			if tls.TransportKeyStore.Filename != "" {
+				allErrs = append(allErrs, field.Forbidden(path.Child("transportKeyStore").Child("filename"), msg))
+			}
+			if tls.RouterKeyStore.Filename != "" {
+				allErrs = append(allErrs, field.Forbidden(path.Child("routerKeyStore").Child("filename"), msg))
+			}
+		}
+
 		// print a warning if the truststore is not configured
-		if i.IsSiteTLSEnabled() && i.Spec.Service.Sites.Local.Encryption.TrustStore == nil {
+		if i.IsSiteTLSEnabled() && !i.IsSiteCertFromCertManager() && i.Spec.Service.Sites.Local.Encryption.TrustStore == nil {
 			errMsg := "The Trust Store for Cross-Site Encryption is recommended but it is not configured. It will fallback to the JVM default Trust Store."
 			eventRec.Event(i, corev1.EventTypeWarning, "CrossSiteTrustStoreMissing", errMsg)
 			log.Info(errMsg, "Request.Namespace", i.Namespace, "Request.Name", i.Name)
@@ -451,6 +672,11 @@ func (i *Infinispan) validate() error {
 		log.Info(errMsg, "Request.Namespace", i.Namespace, "Request.Name", i.Name)
 	}
 
+	i.validateEndpoints(&allErrs)
+	if i.Spec.JGroups != nil {
+		i.validateJGroups(operand, &allErrs)
+	}
+
 	validateProbes := func(c *ContainerProbeSpec, path *field.Path, readinessProbe bool) {
 		checkMinVal := func(val, min int32, path *field.Path) {
 			if val < min {
@@ -478,6 +704,136 @@ func (i *Infinispan) validate() error {
 	return errorListToError(i, allErrs)
 }
 
+// reservedEndpointNames the socket bindings, connectors and container ports configured by the operator
+var reservedEndpointNames = []string{
+	consts.DefaultEndpointSecurityRealm,
+	"admin",
+	"hotrod",
+	"rest",
+	"resp",
+	"memcached",
+	consts.InfinispanAdminPortName,
+	consts.InfinispanJmxPortName,
+	consts.InfinispanPingPortName,
+	consts.InfinispanUserPortName,
+	consts.CrossSitePortName,
+}
+
+// reservedEndpointPorts the container ports used by the operator
+var reservedEndpointPorts = []int32{
+	consts.InfinispanAdminPort,
+	consts.InfinispanJmxPort,
+	consts.InfinispanPingPort,
+	consts.InfinispanUserPort,
+	consts.CrossSitePort,
+	consts.InfinispanJGroupsPort,
+}
+
+func (i *Infinispan) validateEndpoints(allErrs *field.ErrorList) {
+	realms := []string{consts.DefaultEndpointSecurityRealm}
+	if i.IsLDAPRealmEnabled() {
+		realms = append(realms, consts.LDAPEndpointSecurityRealm)
+	}
+	if i.IsTokenRealmEnabled() {
+		realms = append(realms, consts.TokenEndpointSecurityRealm)
+	}
+	ports := make(map[int32]bool, len(i.Spec.Endpoints))
+	for idx := range i.Spec.Endpoints {
+		e := &i.Spec.Endpoints[idx]
+		path := field.NewPath("spec").Child("endpoints").Index(idx)
+		for _, name := range reservedEndpointNames {
+			if e.Name == name {
+				*allErrs = append(*allErrs, field.Invalid(path.Child("name"), e.Name, "The name is reserved by the operator"))
+			}
+		}
+		for _, port := range reservedEndpointPorts {
+			if e.Port == port {
+				*allErrs = append(*allErrs, field.Invalid(path.Child("port"), e.Port, "The port is reserved by the operator"))
+			}
+		}
+		if ports[e.Port] {
+			*allErrs = append(*allErrs, field.Duplicate(path.Child("port"), e.Port))
+		}
+		ports[e.Port] = true
+
+		supported := false
+		for _, realm := range realms {
+			supported = supported || e.GetSecurityRealm() == realm
+		}
+		if !supported {
+			*allErrs = append(*allErrs, field.NotSupported(path.Child("securityRealm"), e.GetSecurityRealm(), realms))
+		}
+
+		if e.IsExposed() && e.Expose.Type == ExposeTypeRoute && e.Type != EndpointConnectorTypeREST && !i.IsEncryptionEnabled() {
+			msg := fmt.Sprintf("Expose type Route requires encryption for '%s' endpoints, as connections are routed with TLS passthrough", e.Type)
+			*allErrs = append(*allErrs, field.Forbidden(path.Child("expose").Child("type"), msg))
+		}
+	}
+}
+
+// getJGroupsEncryption returns the JGroups encryption configuration, or nil if intra-cluster traffic is not encrypted
+func (i *Infinispan) getJGroupsEncryption() *JGroupsEncryptionSpec {
+	if !i.IsJGroupsEncryptionEnabled() {
+		return nil
+	}
+	return i.Spec.JGroups.Encryption
+}
+
+// staticHostPattern matches a TCPPING initial host in the format host[port]
+var staticHostPattern = regexp.MustCompile(`^[^\s\[\],]+\[[0-9]+\]$`)
+
+func (i *Infinispan) validateJGroups(operand version.Operand, allErrs *field.ErrorList) {
+	path := field.NewPath("spec").Child("jgroups")
+	jgroups := i.Spec.JGroups
+
+	if discovery := jgroups.Discovery; discovery != nil {
+		hostsPath := path.Child("discovery").Child("hosts")
+		if discovery.Type == JGroupsDiscoveryTypeStatic {
+			if len(discovery.Hosts) == 0 {
+				*allErrs = append(*allErrs, field.Required(hostsPath, "At least one host must be configured with discovery type Static"))
+			}
+			for idx, host := range discovery.Hosts {
+				if !staticHostPattern.MatchString(host) {
+					*allErrs = append(*allErrs, field.Invalid(hostsPath.Index(idx), host, "Hosts must be in the format host[port]"))
+				}
+			}
+		} else if len(discovery.Hosts) > 0 {
+			*allErrs = append(*allErrs, field.Forbidden(hostsPath, "Hosts can only be configured with discovery type Static"))
+		}
+	}
+
+	if fd := jgroups.FailureDetection; fd != nil {
+		interval := pointer.Int64Deref(fd.Interval, consts.DefaultJGroupsFDInterval)
+		timeout := pointer.Int64Deref(fd.Timeout, consts.DefaultJGroupsFDTimeout)
+		if interval >= timeout {
+			msg := fmt.Sprintf("Failure detection interval ('%d') must be less than timeout ('%d')", interval, timeout)
+			*allErrs = append(*allErrs, field.Invalid(path.Child("failureDetection").Child("interval"), interval, msg))
+		}
+	}
+
+	if merge := jgroups.Merge; merge != nil {
+		minInterval := pointer.Int64Deref(merge.MinInterval, consts.DefaultJGroupsMergeMinInterval)
+		maxInterval := pointer.Int64Deref(merge.MaxInterval, consts.DefaultJGroupsMergeMaxInterval)
+		if minInterval >= maxInterval {
+			msg := fmt.Sprintf("Merge minInterval ('%d') must be less than maxInterval ('%d')", minInterval, maxInterval)
+			*allErrs = append(*allErrs, field.Invalid(path.Child("merge").Child("minInterval"), minInterval, msg))
+		}
+	}
+
+	if encryption := jgroups.Encryption; encryption != nil {
+		encryptionPath := path.Child("encryption")
+		if operand.UpstreamVersion != nil && operand.UpstreamVersion.LT(consts.MinVersionJGroupsEncryption) {
+			msg := fmt.Sprintf("Transport encryption requires Infinispan '%s' or later", consts.MinVersionJGroupsEncryption)
+			*allErrs = append(*allErrs, field.Forbidden(encryptionPath.Child("type"), msg))
+		}
+		if encryption.Type == JGroupsEncryptionTypeSymmetric && encryption.SecretName == "" {
+			*allErrs = append(*allErrs, field.Required(encryptionPath.Child("secretName"), "The Secret containing the secret key must be provided with encryption type Symmetric"))
+		} else if encryption.Type != JGroupsEncryptionTypeSymmetric && encryption.SecretName != "" {
+			*allErrs = append(*allErrs, field.Forbidden(encryptionPath.Child("secretName"), "A Secret can only be configured with encryption type Symmetric"))
+		}
+	}
+}
+
 func validateRequestLimits(val string, fn func() (req, limit resource.Quantity, err error), path *field.Path, allErrs *field.ErrorList) (req resource.Quantity, limit resource.Quantity) {
 	if val == "" {
 		return
@@ -71,9 +71,56 @@ var _ = Describe("Infinispan Webhooks", func() {
 			expectInvalidErrStatus(err, statusDetailCause{"FieldValueForbidden", "spec.service.type", "CacheService is no longer supported."})
 		})
 
-		It("Should return an error if spec.autoscale is enabled", func() {
+		It("Should return an error if spec.autoscale is invalid", func() {
 
 			failed := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Autoscale: &Autoscale{
+						Enabled:            true,
+						MaxReplicas:        3,
+						MaxMemUsagePercent: 50,
+						MinMemUsagePercent: 60,
+					},
+				},
+			}
+			err := k8sClient.Create(ctx, failed)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.autoscale.minReplicas", "minReplicas must be greater than 0"},
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.autoscale.minMemUsagePercent", "minMemUsagePercent must be at least 0 and less than maxMemUsagePercent"},
+			)
+		})
+
+		It("Should initiate spec.autoscale defaults", func() {
+
+			created := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Autoscale: &Autoscale{
+						Enabled:            true,
+						MinReplicas:        1,
+						MaxReplicas:        3,
+						MaxMemUsagePercent: 70,
+						MinMemUsagePercent: 30,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+			Expect(k8sClient.Get(ctx, key, created)).Should(Succeed())
+			Expect(created.Spec.Autoscale.Cooldown.Duration).Should(Equal(consts.DefaultAutoscaleCooldown))
+		})
+
+		It("Should ignore spec.autoscale if not enabled", func() {
+
+			created := &Infinispan{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      key.Name,
 					Namespace: key.Namespace,
@@ -83,8 +130,10 @@ var _ = Describe("Infinispan Webhooks", func() {
 					Autoscale: &Autoscale{MaxReplicas: 3},
 				},
 			}
-			err := k8sClient.Create(ctx, failed)
-			expectInvalidErrStatus(err, statusDetailCause{"FieldValueForbidden", "spec.autoscale", "Autoscale is no longer supported."})
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+			Expect(k8sClient.Get(ctx, key, created)).Should(Succeed())
+			Expect(created.Spec.Autoscale.Cooldown).Should(BeNil())
+			Expect(created.IsAutoscalingEnabled()).Should(BeFalse())
 		})
 
 		It("Should initiate DataGrid defaults", func() {
@@ -593,6 +642,356 @@ var _ = Describe("Infinispan Webhooks", func() {
 			})
 		})
 
+		It("Should allow rollback of GracefulShutdown upgrade to the source version", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Version:  "13.0.10",
+					Upgrades: &InfinispanUpgradesSpec{
+						Type:     UpgradeTypeShutdown,
+						Rollback: &UpgradeRollbackSpec{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, ispn)).Should(Succeed())
+			Expect(ispn.Spec.Upgrades.Rollback.Timeout.Duration).Should(Equal(consts.DefaultUpgradeRollbackTimeout))
+
+			ispn.Status.GracefulShutdownUpgrade = &GracefulShutdownUpgradeStatus{
+				Phase:  GracefulShutdownUpgradePhaseRollingBack,
+				Source: OperandStatus{Version: "13.0.9"},
+			}
+			Expect(k8sClient.Status().Update(ctx, ispn)).Should(Succeed())
+			ispn.Spec.Version = "13.0.8"
+			expectInvalidErrStatus(k8sClient.Update(ctx, ispn), statusDetailCause{
+				"FieldValueForbidden", "spec.version", "downgrading not supported",
+			})
+			ispn.Spec.Version = "13.0.9"
+			Expect(k8sClient.Update(ctx, ispn)).Should(Succeed())
+		})
+
+		It("Should prevent upgrade preflight checks and rollback with HotRodRolling upgrades", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Service: InfinispanServiceSpec{
+						Type: ServiceTypeDataGrid,
+					},
+					Replicas: 1,
+					Upgrades: &InfinispanUpgradesSpec{
+						Type:      UpgradeTypeHotRodRolling,
+						Preflight: &UpgradePreflightSpec{},
+						Rollback:  &UpgradeRollbackSpec{},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{"FieldValueForbidden", "spec.upgrades.preflight", "only supported with Shutdown upgrades"},
+				statusDetailCause{"FieldValueForbidden", "spec.upgrades.rollback", "only supported with Shutdown upgrades"},
+			)
+		})
+
+		It("Should default LDAP realm search attributes", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						LDAP: &LDAPRealmSpec{
+							URL:                      "ldap://ldap:389",
+							BindDN:                   "cn=admin,dc=example,dc=org",
+							BindCredentialSecretName: "ldap-bind",
+							UserSearchDN:             "ou=users,dc=example,dc=org",
+							GroupSearchDN:            "ou=groups,dc=example,dc=org",
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, ispn)).Should(Succeed())
+			ldap := ispn.Spec.Security.LDAP
+			Expect(ldap.UserFilter).Should(Equal(consts.DefaultLDAPUserFilter))
+			Expect(ldap.UserNameAttribute).Should(Equal(consts.DefaultLDAPUserNameAttribute))
+			Expect(ldap.GroupFilter).Should(Equal(consts.DefaultLDAPGroupFilter))
+			Expect(ldap.GroupNameAttribute).Should(Equal(consts.DefaultLDAPGroupNameAttribute))
+		})
+
+		It("Should prevent invalid LDAP realm configurations", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						EndpointAuthentication: pointer.Bool(false),
+						LDAP: &LDAPRealmSpec{
+							URL:                      "http://ldap:389",
+							BindDN:                   "cn=admin,dc=example,dc=org",
+							BindCredentialSecretName: "ldap-bind",
+							UserSearchDN:             "ou=users,dc=example,dc=org",
+						},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{"FieldValueInvalid", "spec.security.ldap.url", "must use the ldap:// or ldaps:// scheme"},
+				statusDetailCause{"FieldValueForbidden", "spec.security.ldap", "requires 'spec.security.endpointAuthentication=true'"},
+			)
+		})
+
+		It("Should only allow LDAP CA certificates with an ldaps:// URL", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						LDAP: &LDAPRealmSpec{
+							URL:                      "ldap://ldap:389",
+							CASecretName:             "ldap-ca",
+							BindDN:                   "cn=admin,dc=example,dc=org",
+							BindCredentialSecretName: "ldap-bind",
+							UserSearchDN:             "ou=users,dc=example,dc=org",
+						},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{"FieldValueForbidden", "spec.security.ldap.caSecretName", "can only be configured with an ldaps:// URL"},
+			)
+
+			ispn.Spec.Security.LDAP.URL = "ldaps://ldap:636"
+			Expect(k8sClient.Create(ctx, ispn)).Should(Succeed())
+		})
+
+		It("Should default token realm claims", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						TokenRealm: &TokenRealmSpec{
+							IssuerURL: "https://keycloak/realms/infinispan",
+							ClientID:  "infinispan",
+							PublicKey: "-----BEGIN PUBLIC KEY-----",
+						},
+					},
+					Endpoints: []EndpointSpec{
+						{Name: "hotrod-token", Type: EndpointConnectorTypeHotRod, Port: 11000, SecurityRealm: consts.TokenEndpointSecurityRealm},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, ispn)).Should(Succeed())
+			token := ispn.Spec.Security.TokenRealm
+			Expect(token.PrincipalClaim).Should(Equal(consts.DefaultTokenRealmPrincipalClaim))
+			Expect(token.RoleClaim).Should(Equal(consts.DefaultTokenRealmRoleClaim))
+		})
+
+		It("Should prevent invalid token realm configurations", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						LDAP: &LDAPRealmSpec{
+							URL:                      "ldap://ldap:389",
+							BindDN:                   "cn=admin,dc=example,dc=org",
+							BindCredentialSecretName: "ldap-bind",
+							UserSearchDN:             "ou=users,dc=example,dc=org",
+						},
+						TokenRealm: &TokenRealmSpec{
+							IssuerURL: "keycloak/realms/infinispan",
+						},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{"FieldValueInvalid", "spec.security.tokenRealm.issuerURL", "must use the http:// or https:// scheme"},
+				statusDetailCause{"FieldValueRequired", "spec.security.tokenRealm.clientID", "Client ID must be provided"},
+				statusDetailCause{"FieldValueRequired", "spec.security.tokenRealm.publicKey", "Public key must be provided"},
+				statusDetailCause{"FieldValueForbidden", "spec.security.tokenRealm", "cannot be configured with 'spec.security.ldap'"},
+			)
+		})
+
+		It("Should default credential rotation grace period", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						CredentialRotation: &CredentialRotationSpec{
+							Interval: &metav1.Duration{Duration: 720 * time.Hour},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, ispn)).Should(Succeed())
+			Expect(ispn.Spec.Security.CredentialRotation.GracePeriod.Duration).Should(Equal(consts.DefaultCredentialRotationGracePeriod))
+		})
+
+		It("Should prevent invalid credential rotation configurations", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						CredentialRotation: &CredentialRotationSpec{
+							Interval:    &metav1.Duration{Duration: time.Minute},
+							GracePeriod: &metav1.Duration{Duration: time.Hour},
+						},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn), statusDetailCause{
+				"FieldValueInvalid", "spec.security.credentialRotation.interval", "must be greater than the grace period",
+			})
+
+			ispn.Spec.Security.CredentialRotation = &CredentialRotationSpec{
+				Interval:    &metav1.Duration{Duration: -time.Hour},
+				GracePeriod: &metav1.Duration{Duration: -time.Minute},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{"FieldValueInvalid", "spec.security.credentialRotation.gracePeriod", "Grace period must be greater than zero"},
+				statusDetailCause{"FieldValueInvalid", "spec.security.credentialRotation.interval", "Interval must be greater than zero"},
+			)
+		})
+
+		It("Should default the credential store source", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						CredentialStoreSource: &CredentialStoreSourceSpec{
+							Vault: &VaultCredentialStoreSpec{
+								URL:             "http://vault.vault.svc:8200",
+								Path:            "infinispan/credentials",
+								TokenSecretName: "vault-token",
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, ispn)).Should(Succeed())
+			source := ispn.Spec.Security.CredentialStoreSource
+			Expect(source.RefreshInterval.Duration).Should(Equal(consts.DefaultCredentialStoreRefreshInterval))
+			Expect(source.Vault.Mount).Should(Equal(consts.DefaultCredentialStoreVaultMount))
+		})
+
+		It("Should prevent invalid credential store sources", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						CredentialStoreSecretName: "credential-store",
+						CredentialStoreSource: &CredentialStoreSourceSpec{
+							RefreshInterval: &metav1.Duration{Duration: -time.Minute},
+						},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{"FieldValueForbidden", "spec.security.credentialStoreSource", "cannot be configured with 'spec.security.credentialStoreSecretName'"},
+				statusDetailCause{"FieldValueInvalid", "spec.security.credentialStoreSource.refreshInterval", "Refresh interval must be greater than zero"},
+				statusDetailCause{"FieldValueRequired", "spec.security.credentialStoreSource.vault", "A secret store must be configured"},
+			)
+
+			ispn.Spec.Security.CredentialStoreSecretName = ""
+			ispn.Spec.Security.CredentialStoreSource = &CredentialStoreSourceSpec{
+				Vault: &VaultCredentialStoreSpec{
+					URL: "vault:8200",
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{"FieldValueInvalid", "spec.security.credentialStoreSource.vault.url", "must use the http:// or https:// scheme"},
+				statusDetailCause{"FieldValueRequired", "spec.security.credentialStoreSource.vault.path", "Secret path must be provided"},
+				statusDetailCause{"FieldValueRequired", "spec.security.credentialStoreSource.vault", "One of 'tokenSecretName' or 'kubernetesRole' must be provided"},
+			)
+
+			ispn.Spec.Security.CredentialStoreSource.Vault = &VaultCredentialStoreSpec{
+				URL:             "https://vault.vault.svc:8200",
+				Path:            "infinispan/credentials",
+				TokenSecretName: "vault-token",
+				KubernetesRole:  "infinispan",
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn), statusDetailCause{
+				"FieldValueForbidden", "spec.security.credentialStoreSource.vault.kubernetesRole", "cannot be configured with 'tokenSecretName'",
+			})
+		})
+
+		It("Should prevent upgrade checkpoints with Shutdown upgrades", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Upgrades: &InfinispanUpgradesSpec{
+						Type:          UpgradeTypeShutdown,
+						HotRodRolling: &HotRodRollingUpgradeSpec{PauseBeforeRedirect: true},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn), statusDetailCause{
+				"FieldValueForbidden", "spec.upgrades.hotRodRolling", "only supported with HotRodRolling upgrades",
+			})
+		})
+
+		It("Should default and validate the upgrade canary timeout", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Upgrades: &InfinispanUpgradesSpec{
+						Type:   UpgradeTypeShutdown,
+						Canary: &UpgradeCanarySpec{},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, ispn)).Should(Succeed())
+			Expect(ispn.Spec.Upgrades.Canary.Timeout.Duration).Should(Equal(consts.DefaultUpgradeCanaryTimeout))
+
+			ispn.Spec.Upgrades.Canary.Timeout = &metav1.Duration{}
+			expectInvalidErrStatus(k8sClient.Update(ctx, ispn), statusDetailCause{
+				"FieldValueInvalid", "spec.upgrades.canary.timeout", "must be greater than zero",
+			})
+		})
+
 		It("Should prevent only allow correctly formatted versions", func() {
 			ispn := &Infinispan{
 				ObjectMeta: metav1.ObjectMeta{
@@ -645,6 +1044,35 @@ var _ = Describe("Infinispan Webhooks", func() {
 			)
 		})
 
+		It("Should prevent JGroups discovery being updated on a running cluster", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+				},
+			}
+			Expect(k8sClient.Create(ctx, ispn)).Should(Succeed())
+			Expect(k8sClient.Get(ctx, key, ispn)).Should(Succeed())
+			ispn.Spec.JGroups = &JGroupsSpec{
+				Discovery: &JGroupsDiscoverySpec{Type: JGroupsDiscoveryTypeKubernetes},
+			}
+			expectInvalidErrStatus(k8sClient.Update(ctx, ispn),
+				statusDetailCause{"FieldValueForbidden", "spec.jgroups.discovery.type", "JGroups discovery cannot be updated while the cluster is running"},
+			)
+
+			// Discovery can be updated once the cluster has been shutdown
+			Expect(k8sClient.Get(ctx, key, ispn)).Should(Succeed())
+			ispn.Spec.Replicas = 0
+			Expect(k8sClient.Update(ctx, ispn)).Should(Succeed())
+			ispn.Spec.JGroups = &JGroupsSpec{
+				Discovery: &JGroupsDiscoverySpec{Type: JGroupsDiscoveryTypeKubernetes},
+			}
+			Expect(k8sClient.Update(ctx, ispn)).Should(Succeed())
+		})
+
 		It("Should prevent incompatible TLS configuration", func() {
 			ispn := &Infinispan{
 				ObjectMeta: metav1.ObjectMeta{
@@ -667,6 +1095,168 @@ var _ = Describe("Infinispan Webhooks", func() {
 			)
 		})
 
+		It("Should initiate CertManager TLS defaults", func() {
+			created := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						EndpointEncryption: &EndpointEncryption{
+							Type: CertificateSourceTypeCertManager,
+							CertIssuer: &CertificateIssuerRef{
+								Name: "ca-issuer",
+								Kind: CertificateIssuerKindClusterIssuer,
+							},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+
+			Expect(k8sClient.Get(ctx, key, created)).Should(Succeed())
+			Expect(created.Spec.Security.EndpointEncryption.CertSecretName).Should(Equal(key.Name + "-cert-secret"))
+			Expect(created.IsEncryptionCertFromCertManager()).Should(BeTrue())
+		})
+
+		It("Should prevent incompatible CertManager TLS configuration", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						EndpointEncryption: &EndpointEncryption{
+							CertServiceName: "service.com",
+							Type:            CertificateSourceTypeCertManager,
+						},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{"FieldValueForbidden", "spec.security.endpointEncryption.certServiceName", ".certServiceName cannot be configured with Encryption .type=CertManager"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.security.endpointEncryption.certIssuer.name", "type=CertManager' to be configured"},
+			)
+
+			ispn.Spec.Security.EndpointEncryption = &EndpointEncryption{
+				CertSecretName: "secret-name",
+				CertIssuer:     &CertificateIssuerRef{Name: "ca-issuer"},
+				Type:           CertificateSourceTypeSecret,
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{"FieldValueForbidden", "spec.security.endpointEncryption.certIssuer", ".certIssuer can only be configured with Encryption .type=CertManager"},
+			)
+		})
+
+		It("Should prevent incompatible CertManager cross-site TLS configuration", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Service: InfinispanServiceSpec{
+						Sites: &InfinispanSitesSpec{
+							Locations: []InfinispanSiteLocationSpec{{
+								Name:        "SiteB",
+								ClusterName: "example-clustera",
+								URL:         "infinispan+xsite://some.host.com:6443",
+							}},
+							Local: InfinispanSitesLocalSpec{
+								Name: "SiteA",
+								Expose: CrossSiteExposeSpec{
+									Type: CrossSiteExposeTypeClusterIP,
+								},
+								Encryption: &EncryptionSiteSpec{
+									TransportKeyStore: CrossSiteKeyStore{
+										SecretName: "keystore",
+										Filename:   "transport.p12",
+									},
+									RouterKeyStore: CrossSiteKeyStore{
+										SecretName: "keystore",
+									},
+									CertIssuer: &CertificateIssuerRef{},
+								},
+							},
+						},
+						Type: ServiceTypeDataGrid,
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.service.sites.local.encryption.certIssuer.name", "The Issuer name must be provided"},
+				statusDetailCause{metav1.CauseTypeFieldValueDuplicate, "spec.service.sites.local.encryption.routerKeyStore.secretName", ""},
+				statusDetailCause{"FieldValueForbidden", "spec.service.sites.local.encryption.transportKeyStore.filename", ".filename cannot be configured with .certIssuer"},
+			)
+		})
+
+		It("Should prevent invalid endpoint configuration", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					Security: InfinispanSecurity{
+						EndpointEncryption: &EndpointEncryption{
+							Type: CertificateSourceTypeNoneNoEncryption,
+						},
+					},
+					Endpoints: []EndpointSpec{
+						{Name: "rest", Type: EndpointConnectorTypeREST, Port: 11222},
+						{Name: "redis", Type: EndpointConnectorTypeRESP, Port: 6379, SecurityRealm: "ldap", Expose: &ExposeSpec{Type: ExposeTypeRoute}},
+						{Name: "memcached-text", Type: EndpointConnectorTypeMemcached, Port: 6379},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.endpoints[0].name", "The name is reserved by the operator"},
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.endpoints[0].port", "The port is reserved by the operator"},
+				statusDetailCause{metav1.CauseTypeFieldValueNotSupported, "spec.endpoints[1].securityRealm", ""},
+				statusDetailCause{"FieldValueForbidden", "spec.endpoints[1].expose.type", "Expose type Route requires encryption for 'RESP' endpoints"},
+				statusDetailCause{metav1.CauseTypeFieldValueDuplicate, "spec.endpoints[2].port", ""},
+			)
+		})
+
+		It("Should prevent invalid JGroups configuration", func() {
+			ispn := &Infinispan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: InfinispanSpec{
+					Replicas: 1,
+					JGroups: &JGroupsSpec{
+						Discovery: &JGroupsDiscoverySpec{
+							Type:  JGroupsDiscoveryTypeStatic,
+							Hosts: []string{"host1[7800]", "host2:7800"},
+						},
+						FailureDetection: &JGroupsFailureDetectionSpec{
+							Interval: pointer.Int64(50000),
+						},
+						Merge: &JGroupsMergeSpec{
+							MinInterval: pointer.Int64(40000),
+						},
+						Encryption: &JGroupsEncryptionSpec{
+							Type: JGroupsEncryptionTypeSymmetric,
+						},
+					},
+				},
+			}
+			expectInvalidErrStatus(k8sClient.Create(ctx, ispn),
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.jgroups.discovery.hosts[1]", "Hosts must be in the format host[port]"},
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.jgroups.failureDetection.interval", "must be less than timeout"},
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.jgroups.merge.minInterval", "must be less than maxInterval"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.jgroups.encryption.secretName", "The Secret containing the secret key must be provided"},
+			)
+		})
+
 		It("Should transform affinity spec", func() {
 			affinitySpec := &corev1.Affinity{
 				PodAntiAffinity: &corev1.PodAntiAffinity{
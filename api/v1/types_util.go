@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	consts "github.com/infinispan/infinispan-operator/controllers/constants"
@@ -66,6 +67,13 @@ const (
 	// ServiceMonitoringAnnotation defines if we need to create ServiceMonitor or not
 	ServiceMonitoringAnnotation string = "infinispan.org/monitoring"
 
+	// HttpTransportAnnotation defines the transport used by the operator to send REST requests to the cluster pods
+	HttpTransportAnnotation string = "infinispan.org/httpTransport"
+	// HttpTransportCurl executes curl requests inside the server container, the default
+	HttpTransportCurl string = "curl"
+	// HttpTransportNative sends requests directly from the operator to the pod's admin endpoint
+	HttpTransportNative string = "native"
+
 	SiteServiceNameTemplate = "%v-site"
 	SiteRouteNameSuffix     = "-route-site"
 	SiteServiceFQNTemplate  = "%s.%s.svc.cluster.local"
@@ -189,6 +197,10 @@ func (ispn *Infinispan) ApplyEndpointEncryptionSettings(servingCertsMode string)
 		}
 	}
 
+	if ispn.IsEncryptionCertFromCertManager() && encryption.CertSecretName == "" {
+		encryption.CertSecretName = ispn.Name + "-cert-secret"
+	}
+
 	if encryption != nil {
 		if encryption.ClientCert == "" {
 			encryption.ClientCert = ClientCertNone
@@ -234,6 +246,22 @@ func (ispn *Infinispan) IsHotRodUpgrade() bool {
 	return ispn.HotRodRollingUpgrades() && ispn.Status.HotRodRollingUpgradeStatus != nil
 }
 
+// IsHotRodUpgradePausedBefore returns true if a Hot Rod rolling upgrade must be resumed manually before stage is executed
+func (ispn *Infinispan) IsHotRodUpgradePausedBefore(stage HotRodRollingUpgradeStage) bool {
+	if ispn.Spec.Upgrades == nil || ispn.Spec.Upgrades.HotRodRolling == nil {
+		return false
+	}
+	spec := ispn.Spec.Upgrades.HotRodRolling
+	switch stage {
+	case HotRodRollingStageRedirect:
+		return spec.PauseBeforeRedirect
+	case HotRodRollingStageStatefulSetReplace:
+		return spec.PauseBeforeStatefulSetReplace
+	default:
+		return false
+	}
+}
+
 func (ispn *Infinispan) GetServiceExternalName() string {
 	externalServiceName := fmt.Sprintf("%s-external", ispn.Name)
 	if ispn.IsExposed() && ispn.GetExposeType() == ExposeTypeRoute && len(externalServiceName)+len(ispn.Namespace) >= MaxRouteObjectNameLength {
@@ -313,6 +341,69 @@ func (ispn *Infinispan) GetExposeType() ExposeType {
 	return ispn.Spec.Expose.Type
 }
 
+// GetJGroupsDiscoveryType returns the protocol used by cluster members to discover each other, defaulting to DNS
+func (ispn *Infinispan) GetJGroupsDiscoveryType() JGroupsDiscoveryType {
+	if ispn.Spec.JGroups == nil || ispn.Spec.JGroups.Discovery == nil || ispn.Spec.JGroups.Discovery.Type == "" {
+		return JGroupsDiscoveryTypeDNS
+	}
+	return ispn.Spec.JGroups.Discovery.Type
+}
+
+// IsJGroupsKubernetesDiscovery returns true if cluster members are discovered via the Kubernetes API
+func (ispn *Infinispan) IsJGroupsKubernetesDiscovery() bool {
+	return ispn.GetJGroupsDiscoveryType() == JGroupsDiscoveryTypeKubernetes
+}
+
+// GetJGroupsServiceAccountName returns the name of the ServiceAccount used by pods to discover cluster members via the Kubernetes API
+func (ispn *Infinispan) GetJGroupsServiceAccountName() string {
+	return fmt.Sprintf("%s-jgroups", ispn.Name)
+}
+
+// IsJGroupsEncryptionEnabled returns true if intra-cluster traffic is encrypted
+func (ispn *Infinispan) IsJGroupsEncryptionEnabled() bool {
+	return ispn.Spec.JGroups != nil && ispn.Spec.JGroups.Encryption != nil && ispn.Spec.JGroups.Encryption.Type != ""
+}
+
+// IsJGroupsSymmetricEncryption returns true if intra-cluster traffic is encrypted using a shared secret key
+func (ispn *Infinispan) IsJGroupsSymmetricEncryption() bool {
+	return ispn.IsJGroupsEncryptionEnabled() && ispn.Spec.JGroups.Encryption.Type == JGroupsEncryptionTypeSymmetric
+}
+
+// HasEndpoints returns true if additional endpoints are configured in spec.endpoints
+func (ispn *Infinispan) HasEndpoints() bool {
+	return len(ispn.Spec.Endpoints) > 0
+}
+
+// HasExposedEndpoints returns true if an endpoint of spec.endpoints is exposed, or if the resources of previously
+// exposed endpoints have not been removed yet
+func (ispn *Infinispan) HasExposedEndpoints() bool {
+	for idx := range ispn.Spec.Endpoints {
+		if ispn.Spec.Endpoints[idx].IsExposed() {
+			return true
+		}
+	}
+	return len(ispn.Status.ExposedEndpoints) > 0
+}
+
+// IsExposed returns true if the endpoint is exposed outside of the Kubernetes cluster
+func (e *EndpointSpec) IsExposed() bool {
+	return e.Expose != nil && e.Expose.Type != ""
+}
+
+// GetSecurityRealm returns the security realm used to authenticate clients of the endpoint
+func (e *EndpointSpec) GetSecurityRealm() string {
+	return consts.GetWithDefault(e.SecurityRealm, consts.DefaultEndpointSecurityRealm)
+}
+
+// GetEndpointServiceExternalName returns the name of the Service, Route or Ingress used to expose the endpoint
+func (ispn *Infinispan) GetEndpointServiceExternalName(e *EndpointSpec) string {
+	externalServiceName := fmt.Sprintf("%s-%s-external", ispn.Name, e.Name)
+	if e.IsExposed() && e.Expose.Type == ExposeTypeRoute && len(externalServiceName)+len(ispn.Namespace) >= MaxRouteObjectNameLength {
+		return externalServiceName[0:MaxRouteObjectNameLength-len(ispn.Namespace)-2] + "a"
+	}
+	return externalServiceName
+}
+
 func (ispn *Infinispan) GetSiteServiceName() string {
 	return fmt.Sprintf(SiteServiceNameTemplate, ispn.Name)
 }
@@ -372,6 +463,12 @@ func (ispn *Infinispan) GetSecretName() string {
 	return ispn.Spec.Security.EndpointSecretName
 }
 
+// IsPropertiesRealmEnabled returns true if the default security realm authenticates the users defined by the operator
+// in its properties files, which is the case unless client certificates are used to authenticate users
+func (ispn *Infinispan) IsPropertiesRealmEnabled() bool {
+	return ispn.IsAuthenticationEnabled() && !(ispn.IsClientCertEnabled() && ispn.Spec.Security.EndpointEncryption.ClientCert == ClientCertAuthenticate)
+}
+
 func (ispn *Infinispan) GetOperatorUser() string {
 	if ispn.IsClientCertEnabled() && ispn.Spec.Security.EndpointEncryption.ClientCert == ClientCertAuthenticate {
 		return "CN=admin"
@@ -407,10 +504,58 @@ func (ispn *Infinispan) IsAuthenticationEnabled() bool {
 	return ispn.Spec.Security.EndpointAuthentication == nil || *ispn.Spec.Security.EndpointAuthentication
 }
 
+// IsLDAPRealmEnabled returns true if users are authenticated against an LDAP directory
+func (ispn *Infinispan) IsLDAPRealmEnabled() bool {
+	return ispn.IsAuthenticationEnabled() && ispn.Spec.Security.LDAP != nil
+}
+
+// GetLDAPBindCredentialSecretName returns the name of the secret containing the password of the LDAP bind DN
+func (ispn *Infinispan) GetLDAPBindCredentialSecretName() string {
+	if ispn.Spec.Security.LDAP == nil {
+		return ""
+	}
+	return ispn.Spec.Security.LDAP.BindCredentialSecretName
+}
+
+// GetLDAPCASecretName returns the name of the secret containing the CA certificates trusted by LDAPS connections
+func (ispn *Infinispan) GetLDAPCASecretName() string {
+	if ispn.Spec.Security.LDAP == nil {
+		return ""
+	}
+	return ispn.Spec.Security.LDAP.CASecretName
+}
+
+// IsTokenRealmEnabled returns true if users are authenticated with the bearer tokens of an identity provider
+func (ispn *Infinispan) IsTokenRealmEnabled() bool {
+	return ispn.IsAuthenticationEnabled() && ispn.Spec.Security.TokenRealm != nil
+}
+
 func (ispn *Infinispan) IsCredentialStoreSecretDefined() bool {
 	return ispn.Spec.Security.CredentialStoreSecretName != ""
 }
 
+// IsCredentialStoreSourceDefined returns true if the CredentialStore entries are retrieved from an external secret store
+func (ispn *Infinispan) IsCredentialStoreSourceDefined() bool {
+	return ispn.Spec.Security.CredentialStoreSource != nil && ispn.Spec.Security.CredentialStoreSource.Vault != nil
+}
+
+// GetCredentialStoreVaultTokenSecretName returns the name of the secret containing the token used to authenticate with
+// the Vault server of the CredentialStore source
+func (ispn *Infinispan) GetCredentialStoreVaultTokenSecretName() string {
+	if !ispn.IsCredentialStoreSourceDefined() {
+		return ""
+	}
+	return ispn.Spec.Security.CredentialStoreSource.Vault.TokenSecretName
+}
+
+// GetCredentialStoreRefreshInterval returns how often the CredentialStore entries are retrieved from the external secret store
+func (ispn *Infinispan) GetCredentialStoreRefreshInterval() time.Duration {
+	if source := ispn.Spec.Security.CredentialStoreSource; source != nil && source.RefreshInterval != nil {
+		return source.RefreshInterval.Duration
+	}
+	return consts.DefaultCredentialStoreRefreshInterval
+}
+
 func (ispn *Infinispan) IsClientCertEnabled() bool {
 	return ispn.IsEncryptionEnabled() && ispn.Spec.Security.EndpointEncryption.ClientCert != "" && ispn.Spec.Security.EndpointEncryption.ClientCert != ClientCertNone
 }
@@ -596,6 +741,71 @@ func (ispn *Infinispan) IsEncryptionCertFromService() bool {
 	return ee != nil && (ee.Type == CertificateSourceTypeService || ee.Type == CertificateSourceTypeServiceLowCase)
 }
 
+// IsEncryptionCertFromCertManager returns true if encryption certificates are issued by cert-manager
+func (ispn *Infinispan) IsEncryptionCertFromCertManager() bool {
+	ee := ispn.Spec.Security.EndpointEncryption
+	return ee != nil && ee.Type == CertificateSourceTypeCertManager
+}
+
+// GetCertificateName returns the name of the cert-manager Certificate used for endpoint encryption
+func (ispn *Infinispan) GetCertificateName() string {
+	return fmt.Sprintf("%s-cert", ispn.Name)
+}
+
+// IsTLSRotationInProgress returns true if the rotation of updated endpoint certificates has not completed
+func (ispn *Infinispan) IsTLSRotationInProgress() bool {
+	return ispn.Status.TLS != nil && ispn.Status.TLS.RotationStartTime != nil
+}
+
+// IsCredentialRotationRequested returns true if a rotation of the generated passwords has been requested with the
+// infinispan.org/rotate-credentials annotation
+func (ispn *Infinispan) IsCredentialRotationRequested() bool {
+	_, requested := ispn.Annotations[consts.CredentialRotationAnnotation]
+	return requested
+}
+
+// IsCredentialRotationEnabled returns true if the generated passwords can be rotated, either because a rotation is
+// configured or because a rotation has been requested before. The generated identities of such clusters are applied to
+// the running pods, and the realms containing the previous passwords are always defined, so that rotations do not
+// restart the pods
+func (ispn *Infinispan) IsCredentialRotationEnabled() bool {
+	return ispn.Spec.Security.CredentialRotation != nil || ispn.Status.CredentialRotation != nil
+}
+
+// IsCredentialRotationStarted returns true if the rotated passwords have not been applied to every pod yet
+func (ispn *Infinispan) IsCredentialRotationStarted() bool {
+	return ispn.Status.CredentialRotation != nil && ispn.Status.CredentialRotation.RotationStartTime != nil
+}
+
+// IsCredentialRotationInProgress returns true if a credential rotation has started and its previous passwords have not
+// been revoked yet
+func (ispn *Infinispan) IsCredentialRotationInProgress() bool {
+	return ispn.IsCredentialRotationStarted() || ispn.Status.CredentialRotation != nil && ispn.Status.CredentialRotation.GracePeriodEndTime != nil
+}
+
+// GetNextCredentialRotationTime returns the time that the generated passwords are next rotated, or nil if the passwords
+// are only rotated on demand
+func (ispn *Infinispan) GetNextCredentialRotationTime() *time.Time {
+	rotation := ispn.Spec.Security.CredentialRotation
+	if rotation == nil || rotation.Interval == nil {
+		return nil
+	}
+	last := ispn.CreationTimestamp.Time
+	if status := ispn.Status.CredentialRotation; status != nil && status.LastRotationTime != nil {
+		last = status.LastRotationTime.Time
+	}
+	next := last.Add(rotation.Interval.Duration)
+	return &next
+}
+
+// GetCredentialRotationGracePeriod returns how long the previous passwords remain valid after a credential rotation
+func (ispn *Infinispan) GetCredentialRotationGracePeriod() time.Duration {
+	if rotation := ispn.Spec.Security.CredentialRotation; rotation != nil && rotation.GracePeriod != nil {
+		return rotation.GracePeriod.Duration
+	}
+	return consts.DefaultCredentialRotationGracePeriod
+}
+
 // IsEncryptionCertSourceDefined returns true if encryption certificates source is defined
 func (ispn *Infinispan) IsEncryptionCertSourceDefined() bool {
 	ee := ispn.Spec.Security.EndpointEncryption
@@ -671,6 +881,18 @@ func (ispn *Infinispan) ExternalServiceLabels() map[string]string {
 	return ispn.ServiceLabels("infinispan-service-external")
 }
 
+// EndpointExternalServiceLabels returns the labels applied to the resources exposing spec.endpoints, including those
+// defined by the user
+func (ispn *Infinispan) EndpointExternalServiceLabels() map[string]string {
+	return ispn.ServiceLabels("infinispan-service-endpoint-external")
+}
+
+// EndpointExternalServiceSelectorLabels returns the minimum required labels to identify the resources exposing
+// spec.endpoints
+func (ispn *Infinispan) EndpointExternalServiceSelectorLabels() map[string]string {
+	return ispn.Labels("infinispan-service-endpoint-external")
+}
+
 // ExternalServiceSelectorLabels returns the minimum required labels to identify an external service. It does not contain any user
 // defined labels. This should always be used for selectors so that updates to user labels don't break the controller logic.
 func (ispn *Infinispan) ExternalServiceSelectorLabels() map[string]string {
@@ -849,6 +1071,11 @@ func (ispn *Infinispan) IsServiceMonitorEnabled() bool {
 	return false
 }
 
+// IsNativeHttpTransport returns true if the "infinispan.org/httpTransport":"native" annotation is defined
+func (ispn *Infinispan) IsNativeHttpTransport() bool {
+	return strings.EqualFold(ispn.GetAnnotations()[HttpTransportAnnotation], HttpTransportNative)
+}
+
 // GetGossipRouterDeploymentName returns the Gossip Router deployment name
 func (ispn *Infinispan) GetGossipRouterDeploymentName() string {
 	return fmt.Sprintf(GossipRouterDeploymentNameTemplate, ispn.Name)
@@ -859,6 +1086,26 @@ func (ispn *Infinispan) IsSiteTLSEnabled() bool {
 	return ispn.HasSites() && ispn.Spec.Service.Sites.Local.Encryption != nil && ispn.Spec.Service.Sites.Local.Encryption.TransportKeyStore != CrossSiteKeyStore{}
 }
 
+// IsSiteCertFromCertManager returns true if the cross-site transport and router keystores are issued by cert-manager
+func (ispn *Infinispan) IsSiteCertFromCertManager() bool {
+	return ispn.IsSiteTLSEnabled() && ispn.Spec.Service.Sites.Local.Encryption.CertIssuer != nil
+}
+
+// GetSiteTransportCertificateName returns the name of the cert-manager Certificate used for the transport keystore
+func (ispn *Infinispan) GetSiteTransportCertificateName() string {
+	return fmt.Sprintf("%s-transport-cert", ispn.Name)
+}
+
+// GetSiteRouterCertificateName returns the name of the cert-manager Certificate used for the router keystore
+func (ispn *Infinispan) GetSiteRouterCertificateName() string {
+	return fmt.Sprintf("%s-router-cert", ispn.Name)
+}
+
+// GetSiteKeyStorePasswordSecretName returns the name of the secret containing the password of the keystores issued by cert-manager
+func (ispn *Infinispan) GetSiteKeyStorePasswordSecretName() string {
+	return fmt.Sprintf("%s-site-keystore-password", ispn.Name)
+}
+
 // GetSiteTLSProtocol returns the TLS protocol to be used to encrypt cross-site replication communication
 func (ispn *Infinispan) GetSiteTLSProtocol() string {
 	if !ispn.IsSiteTLSEnabled() {
@@ -880,6 +1127,9 @@ func (ispn *Infinispan) GetSiteTransportKeyStoreFileName() string {
 	if !ispn.IsSiteTLSEnabled() {
 		return ""
 	}
+	if ispn.IsSiteCertFromCertManager() {
+		return consts.DefaultSiteKeyStoreFileName
+	}
 	return consts.GetWithDefault(ispn.Spec.Service.Sites.Local.Encryption.TransportKeyStore.Filename, consts.DefaultSiteKeyStoreFileName)
 }
 
@@ -888,6 +1138,9 @@ func (ispn *Infinispan) GetSiteTransportKeyStoreAlias() string {
 	if !ispn.IsSiteTLSEnabled() {
 		return ""
 	}
+	if ispn.IsSiteCertFromCertManager() {
+		return consts.GetWithDefault(ispn.Spec.Service.Sites.Local.Encryption.TransportKeyStore.Alias, consts.DefaultSiteCertManagerKeyStoreAlias)
+	}
 	return consts.GetWithDefault(ispn.Spec.Service.Sites.Local.Encryption.TransportKeyStore.Alias, consts.DefaultSiteTransportKeyStoreAlias)
 }
 
@@ -904,6 +1157,9 @@ func (ispn *Infinispan) GetSiteRouterKeyStoreFileName() string {
 	if !ispn.IsSiteTLSEnabled() {
 		return ""
 	}
+	if ispn.IsSiteCertFromCertManager() {
+		return consts.DefaultSiteKeyStoreFileName
+	}
 	return consts.GetWithDefault(ispn.Spec.Service.Sites.Local.Encryption.RouterKeyStore.Filename, consts.DefaultSiteKeyStoreFileName)
 }
 
@@ -912,12 +1168,23 @@ func (ispn *Infinispan) GetSiteRouterKeyStoreAlias() string {
 	if !ispn.IsSiteTLSEnabled() {
 		return ""
 	}
+	if ispn.IsSiteCertFromCertManager() {
+		return consts.GetWithDefault(ispn.Spec.Service.Sites.Local.Encryption.RouterKeyStore.Alias, consts.DefaultSiteCertManagerKeyStoreAlias)
+	}
 	return consts.GetWithDefault(ispn.Spec.Service.Sites.Local.Encryption.RouterKeyStore.Alias, consts.DefaultSiteRouterKeyStoreAlias)
 }
 
-// GetSiteTrustoreSecretName returns the secret name with the truststore for the transport and router TLS keystore
+// GetSiteTrustoreSecretName returns the secret name with the truststore for the transport and router TLS keystore.
+// When the keystores are issued by cert-manager, the truststore created by cert-manager in the transport secret is
+// used if a truststore is not explicitly configured
 func (ispn *Infinispan) GetSiteTrustoreSecretName() string {
-	if !ispn.IsSiteTLSEnabled() || ispn.Spec.Service.Sites.Local.Encryption.TrustStore == nil {
+	if !ispn.IsSiteTLSEnabled() {
+		return ""
+	}
+	if ispn.Spec.Service.Sites.Local.Encryption.TrustStore == nil {
+		if ispn.IsSiteCertFromCertManager() {
+			return ispn.GetSiteTransportSecretName()
+		}
 		return ""
 	}
 	return ispn.Spec.Service.Sites.Local.Encryption.TrustStore.SecretName
@@ -943,6 +1210,19 @@ func (ispn *Infinispan) GetConfigListenerName() string {
 	return fmt.Sprintf("%s-config-listener", ispn.Name)
 }
 
+// GetUpgradeCanaryStatefulSetName returns the name of the StatefulSet used to verify the target Operand of an upgrade
+func (ispn *Infinispan) GetUpgradeCanaryStatefulSetName() string {
+	return fmt.Sprintf("%s-canary", ispn.Name)
+}
+
+// ConfigListenerReplicas returns the number of ConfigListener pods to deploy
+func (ispn *Infinispan) ConfigListenerReplicas() int32 {
+	if ispn.Spec.ConfigListener != nil && ispn.Spec.ConfigListener.Replicas != nil {
+		return *ispn.Spec.ConfigListener.Replicas
+	}
+	return 1
+}
+
 func (ispn *Infinispan) UserConfigDefined() bool {
 	return ispn.Spec.ConfigMapName != ""
 }
@@ -951,6 +1231,41 @@ func (ispn *Infinispan) GracefulShutdownUpgrades() bool {
 	return ispn.Spec.Upgrades == nil || ispn.Spec.Upgrades.Type == UpgradeTypeShutdown
 }
 
+// IsUpgradePreflightEnabled returns true if preflight checks must pass before a Shutdown upgrade is started
+func (ispn *Infinispan) IsUpgradePreflightEnabled() bool {
+	return ispn.GracefulShutdownUpgrades() && ispn.Spec.Upgrades != nil && ispn.Spec.Upgrades.Preflight != nil
+}
+
+// IsUpgradeRollbackEnabled returns true if Shutdown upgrades that fail to form a cluster are rolled back
+func (ispn *Infinispan) IsUpgradeRollbackEnabled() bool {
+	return ispn.GracefulShutdownUpgrades() && ispn.Spec.Upgrades != nil && ispn.Spec.Upgrades.Rollback != nil
+}
+
+// IsUpgradeRollingBack returns true if a Shutdown upgrade is being rolled back to the source Operand
+func (ispn *Infinispan) IsUpgradeRollingBack() bool {
+	status := ispn.Status.GracefulShutdownUpgrade
+	return status != nil && status.Phase == GracefulShutdownUpgradePhaseRollingBack
+}
+
+// IsUpgradeCanaryEnabled returns true if the target Operand must be verified on a canary pod before an upgrade is started
+func (ispn *Infinispan) IsUpgradeCanaryEnabled() bool {
+	return ispn.Spec.Upgrades != nil && ispn.Spec.Upgrades.Canary != nil
+}
+
+// IsAutoscalingEnabled returns true if spec.autoscale has been explicitly enabled
+func (ispn *Infinispan) IsAutoscalingEnabled() bool {
+	return ispn.Spec.Autoscale != nil && ispn.Spec.Autoscale.Enabled && !ispn.Spec.Autoscale.Disabled
+}
+
+// GetAutoscaleReplicationFactor returns the number of owners of each entry used to calculate the capacity of the
+// cluster when autoscaling, or the server default if not configured
+func (ispn *Infinispan) GetAutoscaleReplicationFactor() int32 {
+	if a := ispn.Spec.Autoscale; a != nil && a.ReplicationFactor > 0 {
+		return a.ReplicationFactor
+	}
+	return consts.DefaultReplicationFactor
+}
+
 func (ispn *Infinispan) HotRodRollingUpgrades() bool {
 	return ispn.Spec.Upgrades != nil && ispn.Spec.Upgrades.Type == UpgradeTypeHotRodRolling
 }
@@ -32,6 +32,37 @@ func TestServiceExternalName(t *testing.T) {
 	assert.Equal(t, "example-infinispan-external", exposeRouteInfinispan.GetServiceExternalName(), "Route expose name")
 }
 
+func TestEndpointServiceExternalName(t *testing.T) {
+	ispn := &Infinispan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "extra-long-cluster-name-d----------------------------d",
+			Namespace: namespace,
+		},
+	}
+	endpoint := &EndpointSpec{Name: "redis", Expose: &ExposeSpec{Type: ExposeTypeRoute}}
+	assert.LessOrEqual(t, MaxRouteObjectNameLength, len(ispn.GetEndpointServiceExternalName(endpoint))+len(namespace)+1, "Route expose name length")
+
+	ispn.Name = "example-infinispan"
+	assert.Equal(t, "example-infinispan-redis-external", ispn.GetEndpointServiceExternalName(endpoint), "Route expose name")
+}
+
+func TestHasExposedEndpoints(t *testing.T) {
+	ispn := &Infinispan{
+		Spec: InfinispanSpec{
+			Endpoints: []EndpointSpec{{Name: "redis"}},
+		},
+	}
+	assert.False(t, ispn.HasExposedEndpoints())
+
+	ispn.Spec.Endpoints[0].Expose = &ExposeSpec{Type: ExposeTypeNodePort}
+	assert.True(t, ispn.HasExposedEndpoints())
+
+	// The resources of the removed endpoint must still be deleted
+	ispn.Spec.Endpoints = nil
+	ispn.Status.ExposedEndpoints = []string{"example-infinispan-redis-external"}
+	assert.True(t, ispn.HasExposedEndpoints())
+}
+
 func TestApplyOperatorLabels(t *testing.T) {
 	testTable := []struct {
 		Labels            string
@@ -131,3 +162,17 @@ func TestApplyOperatorAnnotations(t *testing.T) {
 		assert.True(t, reflect.DeepEqual(ispn.Annotations, annotationPodMap) || len(annotationPodMap) == 0 && ispn.Annotations == nil)
 	}
 }
+
+func TestIsHotRodUpgradePausedBefore(t *testing.T) {
+	ispn := &Infinispan{
+		Spec: InfinispanSpec{
+			Upgrades: &InfinispanUpgradesSpec{Type: UpgradeTypeHotRodRolling},
+		},
+	}
+	assert.False(t, ispn.IsHotRodUpgradePausedBefore(HotRodRollingStageRedirect))
+
+	ispn.Spec.Upgrades.HotRodRolling = &HotRodRollingUpgradeSpec{PauseBeforeStatefulSetReplace: true}
+	assert.False(t, ispn.IsHotRodUpgradePausedBefore(HotRodRollingStageRedirect))
+	assert.True(t, ispn.IsHotRodUpgradePausedBefore(HotRodRollingStageStatefulSetReplace))
+	assert.False(t, ispn.IsHotRodUpgradePausedBefore(HotRodRollingStageSync))
+}
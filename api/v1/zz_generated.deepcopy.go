@@ -6,6 +6,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -54,6 +55,11 @@ func (in *AuthorizationRole) DeepCopy() *AuthorizationRole {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Autoscale) DeepCopyInto(out *Autoscale) {
 	*out = *in
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscale.
@@ -66,6 +72,40 @@ func (in *Autoscale) DeepCopy() *Autoscale {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscaleStatus) DeepCopyInto(out *AutoscaleStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscaleStatus.
+func (in *AutoscaleStatus) DeepCopy() *AutoscaleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscaleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuerRef) DeepCopyInto(out *CertificateIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuerRef.
+func (in *CertificateIssuerRef) DeepCopy() *CertificateIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigListenerLoggingSpec) DeepCopyInto(out *ConfigListenerLoggingSpec) {
 	*out = *in
@@ -89,6 +129,16 @@ func (in *ConfigListenerSpec) DeepCopyInto(out *ConfigListenerSpec) {
 		*out = new(ConfigListenerLoggingSpec)
 		**out = **in
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigListenerSpec.
@@ -141,6 +191,118 @@ func (in *ContainerProbeSpec) DeepCopy() *ContainerProbeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRotationSpec) DeepCopyInto(out *CredentialRotationSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRotationSpec.
+func (in *CredentialRotationSpec) DeepCopy() *CredentialRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialRotationStatus) DeepCopyInto(out *CredentialRotationStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RotationStartTime != nil {
+		in, out := &in.RotationStartTime, &out.RotationStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.GracePeriodEndTime != nil {
+		in, out := &in.GracePeriodEndTime, &out.GracePeriodEndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialRotationStatus.
+func (in *CredentialRotationStatus) DeepCopy() *CredentialRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialStoreSourceSpec) DeepCopyInto(out *CredentialStoreSourceSpec) {
+	*out = *in
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultCredentialStoreSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialStoreSourceSpec.
+func (in *CredentialStoreSourceSpec) DeepCopy() *CredentialStoreSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialStoreSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrossSiteBackupStatus) DeepCopyInto(out *CrossSiteBackupStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossSiteBackupStatus.
+func (in *CrossSiteBackupStatus) DeepCopy() *CrossSiteBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CrossSiteBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrossSiteCacheStatus) DeepCopyInto(out *CrossSiteCacheStatus) {
+	*out = *in
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]CrossSiteBackupStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossSiteCacheStatus.
+func (in *CrossSiteCacheStatus) DeepCopy() *CrossSiteCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CrossSiteCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CrossSiteExposeSpec) DeepCopyInto(out *CrossSiteExposeSpec) {
 	*out = *in
@@ -178,6 +340,57 @@ func (in *CrossSiteKeyStore) DeepCopy() *CrossSiteKeyStore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrossSiteLocationStatus) DeepCopyInto(out *CrossSiteLocationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossSiteLocationStatus.
+func (in *CrossSiteLocationStatus) DeepCopy() *CrossSiteLocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CrossSiteLocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrossSiteStatus) DeepCopyInto(out *CrossSiteStatus) {
+	*out = *in
+	if in.Sites != nil {
+		in, out := &in.Sites, &out.Sites
+		*out = make([]CrossSiteLocationStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.RelayNodes != nil {
+		in, out := &in.RelayNodes, &out.RelayNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Caches != nil {
+		in, out := &in.Caches, &out.Caches
+		*out = make([]CrossSiteCacheStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastObservedTime != nil {
+		in, out := &in.LastObservedTime, &out.LastObservedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossSiteStatus.
+func (in *CrossSiteStatus) DeepCopy() *CrossSiteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CrossSiteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CrossSiteTrustStore) DeepCopyInto(out *CrossSiteTrustStore) {
 	*out = *in
@@ -258,6 +471,11 @@ func (in *EncryptionSiteSpec) DeepCopyInto(out *EncryptionSiteSpec) {
 		*out = new(CrossSiteTrustStore)
 		**out = **in
 	}
+	if in.CertIssuer != nil {
+		in, out := &in.CertIssuer, &out.CertIssuer
+		*out = new(CertificateIssuerRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionSiteSpec.
@@ -273,6 +491,11 @@ func (in *EncryptionSiteSpec) DeepCopy() *EncryptionSiteSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointEncryption) DeepCopyInto(out *EndpointEncryption) {
 	*out = *in
+	if in.CertIssuer != nil {
+		in, out := &in.CertIssuer, &out.CertIssuer
+		*out = new(CertificateIssuerRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointEncryption.
@@ -285,6 +508,26 @@ func (in *EndpointEncryption) DeepCopy() *EndpointEncryption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EndpointSpec) DeepCopyInto(out *EndpointSpec) {
+	*out = *in
+	if in.Expose != nil {
+		in, out := &in.Expose, &out.Expose
+		*out = new(ExposeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSpec.
+func (in *EndpointSpec) DeepCopy() *EndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExposeSpec) DeepCopyInto(out *ExposeSpec) {
 	*out = *in
@@ -337,9 +580,64 @@ func (in *GossipRouterHeartbeatSpec) DeepCopy() *GossipRouterHeartbeatSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GracefulShutdownUpgradeStatus) DeepCopyInto(out *GracefulShutdownUpgradeStatus) {
+	*out = *in
+	out.Source = in.Source
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GracefulShutdownUpgradeStatus.
+func (in *GracefulShutdownUpgradeStatus) DeepCopy() *GracefulShutdownUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GracefulShutdownUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HotRodRollingUpgradeCacheStatus) DeepCopyInto(out *HotRodRollingUpgradeCacheStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HotRodRollingUpgradeCacheStatus.
+func (in *HotRodRollingUpgradeCacheStatus) DeepCopy() *HotRodRollingUpgradeCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HotRodRollingUpgradeCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HotRodRollingUpgradeSpec) DeepCopyInto(out *HotRodRollingUpgradeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HotRodRollingUpgradeSpec.
+func (in *HotRodRollingUpgradeSpec) DeepCopy() *HotRodRollingUpgradeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HotRodRollingUpgradeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HotRodRollingUpgradeStatus) DeepCopyInto(out *HotRodRollingUpgradeStatus) {
 	*out = *in
+	if in.Caches != nil {
+		in, out := &in.Caches, &out.Caches
+		*out = make([]HotRodRollingUpgradeCacheStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HotRodRollingUpgradeStatus.
@@ -522,6 +820,11 @@ func (in *InfinispanSecurity) DeepCopyInto(out *InfinispanSecurity) {
 		*out = new(Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CredentialStoreSource != nil {
+		in, out := &in.CredentialStoreSource, &out.CredentialStoreSource
+		*out = new(CredentialStoreSourceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EndpointAuthentication != nil {
 		in, out := &in.EndpointAuthentication, &out.EndpointAuthentication
 		*out = new(bool)
@@ -530,8 +833,23 @@ func (in *InfinispanSecurity) DeepCopyInto(out *InfinispanSecurity) {
 	if in.EndpointEncryption != nil {
 		in, out := &in.EndpointEncryption, &out.EndpointEncryption
 		*out = new(EndpointEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LDAP != nil {
+		in, out := &in.LDAP, &out.LDAP
+		*out = new(LDAPRealmSpec)
 		**out = **in
 	}
+	if in.TokenRealm != nil {
+		in, out := &in.TokenRealm, &out.TokenRealm
+		*out = new(TokenRealmSpec)
+		**out = **in
+	}
+	if in.CredentialRotation != nil {
+		in, out := &in.CredentialRotation, &out.CredentialRotation
+		*out = new(CredentialRotationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfinispanSecurity.
@@ -687,10 +1005,17 @@ func (in *InfinispanSpec) DeepCopyInto(out *InfinispanSpec) {
 		*out = new(ExposeSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]EndpointSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Autoscale != nil {
 		in, out := &in.Autoscale, &out.Autoscale
 		*out = new(Autoscale)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
@@ -710,7 +1035,7 @@ func (in *InfinispanSpec) DeepCopyInto(out *InfinispanSpec) {
 	if in.Upgrades != nil {
 		in, out := &in.Upgrades, &out.Upgrades
 		*out = new(InfinispanUpgradesSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ConfigListener != nil {
 		in, out := &in.ConfigListener, &out.ConfigListener
@@ -727,6 +1052,11 @@ func (in *InfinispanSpec) DeepCopyInto(out *InfinispanSpec) {
 		*out = new(SchedulingSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.JGroups != nil {
+		in, out := &in.JGroups, &out.JGroups
+		*out = new(JGroupsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfinispanSpec.
@@ -766,7 +1096,42 @@ func (in *InfinispanStatus) DeepCopyInto(out *InfinispanStatus) {
 	if in.HotRodRollingUpgradeStatus != nil {
 		in, out := &in.HotRodRollingUpgradeStatus, &out.HotRodRollingUpgradeStatus
 		*out = new(HotRodRollingUpgradeStatus)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GracefulShutdownUpgrade != nil {
+		in, out := &in.GracefulShutdownUpgrade, &out.GracefulShutdownUpgrade
+		*out = new(GracefulShutdownUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeCanary != nil {
+		in, out := &in.UpgradeCanary, &out.UpgradeCanary
+		*out = new(UpgradeCanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscale != nil {
+		in, out := &in.Autoscale, &out.Autoscale
+		*out = new(AutoscaleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CrossSite != nil {
+		in, out := &in.CrossSite, &out.CrossSite
+		*out = new(CrossSiteStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialRotation != nil {
+		in, out := &in.CredentialRotation, &out.CredentialRotation
+		*out = new(CredentialRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExposedEndpoints != nil {
+		in, out := &in.ExposedEndpoints, &out.ExposedEndpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	out.Operand = in.Operand
 	out.Operator = in.Operator
@@ -785,6 +1150,26 @@ func (in *InfinispanStatus) DeepCopy() *InfinispanStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfinispanUpgradesSpec) DeepCopyInto(out *InfinispanUpgradesSpec) {
 	*out = *in
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(UpgradePreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpgradeRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HotRodRolling != nil {
+		in, out := &in.HotRodRolling, &out.HotRodRolling
+		*out = new(HotRodRollingUpgradeSpec)
+		**out = **in
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(UpgradeCanarySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfinispanUpgradesSpec.
@@ -812,6 +1197,126 @@ func (in *InitDependenciesContainerSpec) DeepCopy() *InitDependenciesContainerSp
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JGroupsDiscoverySpec) DeepCopyInto(out *JGroupsDiscoverySpec) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JGroupsDiscoverySpec.
+func (in *JGroupsDiscoverySpec) DeepCopy() *JGroupsDiscoverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JGroupsDiscoverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JGroupsEncryptionSpec) DeepCopyInto(out *JGroupsEncryptionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JGroupsEncryptionSpec.
+func (in *JGroupsEncryptionSpec) DeepCopy() *JGroupsEncryptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JGroupsEncryptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JGroupsFailureDetectionSpec) DeepCopyInto(out *JGroupsFailureDetectionSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JGroupsFailureDetectionSpec.
+func (in *JGroupsFailureDetectionSpec) DeepCopy() *JGroupsFailureDetectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JGroupsFailureDetectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JGroupsMergeSpec) DeepCopyInto(out *JGroupsMergeSpec) {
+	*out = *in
+	if in.MinInterval != nil {
+		in, out := &in.MinInterval, &out.MinInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JGroupsMergeSpec.
+func (in *JGroupsMergeSpec) DeepCopy() *JGroupsMergeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JGroupsMergeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JGroupsSpec) DeepCopyInto(out *JGroupsSpec) {
+	*out = *in
+	if in.Discovery != nil {
+		in, out := &in.Discovery, &out.Discovery
+		*out = new(JGroupsDiscoverySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureDetection != nil {
+		in, out := &in.FailureDetection, &out.FailureDetection
+		*out = new(JGroupsFailureDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Merge != nil {
+		in, out := &in.Merge, &out.Merge
+		*out = new(JGroupsMergeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(JGroupsEncryptionSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JGroupsSpec.
+func (in *JGroupsSpec) DeepCopy() *JGroupsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JGroupsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JmxSpec) DeepCopyInto(out *JmxSpec) {
 	*out = *in
@@ -827,6 +1332,21 @@ func (in *JmxSpec) DeepCopy() *JmxSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPRealmSpec) DeepCopyInto(out *LDAPRealmSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPRealmSpec.
+func (in *LDAPRealmSpec) DeepCopy() *LDAPRealmSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPRealmSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperandStatus) DeepCopyInto(out *OperandStatus) {
 	*out = *in
@@ -890,3 +1410,144 @@ func (in *SchedulingSpec) DeepCopy() *SchedulingSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSStatus) DeepCopyInto(out *TLSStatus) {
+	*out = *in
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RotationStartTime != nil {
+		in, out := &in.RotationStartTime, &out.RotationStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSStatus.
+func (in *TLSStatus) DeepCopy() *TLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRealmSpec) DeepCopyInto(out *TokenRealmSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRealmSpec.
+func (in *TokenRealmSpec) DeepCopy() *TokenRealmSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRealmSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeCanarySpec) DeepCopyInto(out *UpgradeCanarySpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeCanarySpec.
+func (in *UpgradeCanarySpec) DeepCopy() *UpgradeCanarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeCanarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeCanaryStatus) DeepCopyInto(out *UpgradeCanaryStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeCanaryStatus.
+func (in *UpgradeCanaryStatus) DeepCopy() *UpgradeCanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeCanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePreflightSpec) DeepCopyInto(out *UpgradePreflightSpec) {
+	*out = *in
+	if in.VolatileCaches != nil {
+		in, out := &in.VolatileCaches, &out.VolatileCaches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinFreeStoragePercent != nil {
+		in, out := &in.MinFreeStoragePercent, &out.MinFreeStoragePercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePreflightSpec.
+func (in *UpgradePreflightSpec) DeepCopy() *UpgradePreflightSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePreflightSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeRollbackSpec) DeepCopyInto(out *UpgradeRollbackSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeRollbackSpec.
+func (in *UpgradeRollbackSpec) DeepCopy() *UpgradeRollbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeRollbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultCredentialStoreSpec) DeepCopyInto(out *VaultCredentialStoreSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultCredentialStoreSpec.
+func (in *VaultCredentialStoreSpec) DeepCopy() *VaultCredentialStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultCredentialStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
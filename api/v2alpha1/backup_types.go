@@ -25,6 +25,31 @@ type BackupVolumeSpec struct {
 	// Names the storage class object for persistent volume claims.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage Class Name",xDescriptors="urn:alm:descriptor:io.kubernetes:StorageClass"
 	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Uploads the backup archive to S3 compatible object storage instead of retaining it on a PersistentVolumeClaim.
+	// The archive is stored with the key "<prefix>/<backup-name>.zip". The archive is transferred by the backup pod with
+	// curl, which must be available in the server image
+	// +optional
+	ObjectStore *ObjectStoreSpec `json:"objectStore,omitempty"`
+}
+
+// ObjectStoreSpec defines an S3 compatible object storage bucket
+type ObjectStoreSpec struct {
+	// The endpoint of the object store, e.g. "https://s3.us-east-1.amazonaws.com" or "http://minio.minio.svc:9000".
+	// Objects are accessed using path-style requests
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Endpoint",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	Endpoint string `json:"endpoint"`
+	// The region of the bucket, defaults to "us-east-1"
+	// +optional
+	Region string `json:"region,omitempty"`
+	// The name of the bucket
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Bucket",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	Bucket string `json:"bucket"`
+	// The prefix prepended to object keys
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// The name of the secret containing the "AWS_ACCESS_KEY_ID" and "AWS_SECRET_ACCESS_KEY" used to access the bucket
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Credentials Secret",xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
+	CredentialsSecret string `json:"credentialsSecret"`
 }
 
 type BackupResources struct {
@@ -77,6 +102,9 @@ type BackupStatus struct {
 	// The name of the created PersistentVolumeClaim used to store the backup
 	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Persistent Volume Claim"
 	PVC string `json:"pvc,omitempty"`
+	// The key of the backup archive in the configured object store
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Object Key"
+	ObjectKey string `json:"objectKey,omitempty"`
 }
 
 // +kubebuilder:object:root=true
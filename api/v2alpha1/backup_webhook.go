@@ -1,6 +1,8 @@
 package v2alpha1
 
 import (
+	"fmt"
+	"net/url"
 	"reflect"
 
 	"github.com/infinispan/infinispan-operator/controllers/constants"
@@ -53,6 +55,7 @@ func (b *Backup) ValidateCreate() error {
 	if b.Spec.Cluster == "" {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("cluster"), "'spec.cluster' must be configured"))
 	}
+	allErrs = append(allErrs, b.Spec.Volume.validate(field.NewPath("spec").Child("volume"))...)
 	return b.StatusError(allErrs)
 }
 
@@ -80,3 +83,34 @@ func (b *Backup) StatusError(allErrs field.ErrorList) error {
 	}
 	return nil
 }
+
+func (v *BackupVolumeSpec) validate(path *field.Path) field.ErrorList {
+	if v.ObjectStore == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if v.StorageClassName != nil {
+		allErrs = append(allErrs, field.Forbidden(path.Child("storageClassName"), "storageClassName cannot be configured when the backup is stored in an object store"))
+	}
+	return append(allErrs, v.ObjectStore.validate(path.Child("objectStore"))...)
+}
+
+func (o *ObjectStoreSpec) validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	required := func(name, value string) {
+		if value == "" {
+			p := path.Child(name)
+			allErrs = append(allErrs, field.Required(p, fmt.Sprintf("'%s' must be configured", p)))
+		}
+	}
+	required("endpoint", o.Endpoint)
+	required("bucket", o.Bucket)
+	required("credentialsSecret", o.CredentialsSecret)
+
+	if o.Endpoint != "" {
+		if u, err := url.Parse(o.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("endpoint"), o.Endpoint, "endpoint must be a valid http or https URL"))
+		}
+	}
+	return allErrs
+}
@@ -87,6 +87,32 @@ var _ = Describe("Backup Webhook", func() {
 			expectInvalidErrStatus(err, statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.cluster", "'spec.cluster' must be configured"})
 		})
 
+		It("Should return error if the object store is invalid", func() {
+
+			storageClass := "some-class"
+			rejected := &Backup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: BackupSpec{
+					Cluster: "some-cluster",
+					Volume: BackupVolumeSpec{
+						StorageClassName: &storageClass,
+						ObjectStore:      &ObjectStoreSpec{},
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, []statusDetailCause{
+				{"FieldValueForbidden", "spec.volume.storageClassName", "storageClassName cannot be configured when the backup is stored in an object store"},
+				{metav1.CauseTypeFieldValueRequired, "spec.volume.objectStore.endpoint", "'spec.volume.objectStore.endpoint' must be configured"},
+				{metav1.CauseTypeFieldValueRequired, "spec.volume.objectStore.bucket", "'spec.volume.objectStore.bucket' must be configured"},
+				{metav1.CauseTypeFieldValueRequired, "spec.volume.objectStore.credentialsSecret", "'spec.volume.objectStore.credentialsSecret' must be configured"},
+			}...)
+		})
+
 		It("Should return error if any spec value is updated", func() {
 
 			created := &Backup{
@@ -0,0 +1,94 @@
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BackupScheduleSpec defines the desired state of BackupSchedule
+type BackupScheduleSpec struct {
+	// The schedule in Cron format, evaluated in UTC, e.g. "0 2 * * *". The @yearly, @monthly, @weekly, @daily and @hourly
+	// descriptors are also supported
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Schedule",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	Schedule string `json:"schedule"`
+	// If true, subsequent backups are not created. Backups that are already running are not affected
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Suspend",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	Suspend bool `json:"suspend,omitempty"`
+	// The template used to create the Backup CRs of each scheduled run
+	Template BackupSpec `json:"template"`
+	// The policy used to determine when completed Backup CRs, and their PersistentVolumeClaims, are removed. Archives
+	// uploaded to an object store are not removed
+	// +optional
+	Retention *BackupScheduleRetentionSpec `json:"retention,omitempty"`
+}
+
+type BackupScheduleRetentionSpec struct {
+	// The number of most recent successful backups to retain. Failed backups are retained up to the same limit
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Keep Last",xDescriptors="urn:alm:descriptor:com.tectonic.ui:podCount"
+	KeepLast *int32 `json:"keepLast,omitempty"`
+	// The maximum age of a completed backup before it is removed, e.g. "168h"
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Age",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// BackupScheduleRun describes a Backup created by a BackupSchedule
+type BackupScheduleRun struct {
+	// The name of the Backup CR
+	Name string `json:"name"`
+	// The time that the Backup was scheduled
+	Time metav1.Time `json:"time"`
+	// Reason indicates the reason for any backup related failures.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// BackupScheduleStatus defines the observed state of BackupSchedule
+type BackupScheduleStatus struct {
+	// The last time that a backup was scheduled
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// The name of the Backup CR that is currently in progress, if any
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Active Backup"
+	Active string `json:"active,omitempty"`
+	// The most recent Backup that completed successfully
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Last Successful Backup"
+	LastSuccessful *BackupScheduleRun `json:"lastSuccessful,omitempty"`
+	// The most recent Backup that failed
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Last Failed Backup"
+	LastFailed *BackupScheduleRun `json:"lastFailed,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=backupschedules,scope=Namespaced
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule"
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.template.cluster"
+// +kubebuilder:printcolumn:name="Suspend",type="boolean",JSONPath=".spec.suspend"
+// +kubebuilder:printcolumn:name="Last Schedule",type="date",JSONPath=".status.lastScheduleTime"
+// BackupSchedule is the Schema for the backupschedules API
+type BackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupScheduleSpec   `json:"spec,omitempty"`
+	Status BackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupScheduleList contains a list of BackupSchedule
+type BackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BackupSchedule{}, &BackupScheduleList{})
+}
@@ -0,0 +1,100 @@
+package v2alpha1
+
+import (
+	"fmt"
+
+	"github.com/infinispan/infinispan-operator/pkg/cron"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// The Backup CRs created by a BackupSchedule are named "<schedule-name>-<unix-timestamp>". The Backup name is used as a
+// label value on the backup pod, so the schedule name must leave room for the timestamp suffix.
+const maxBackupScheduleNameLength = validation.LabelValueMaxLength - 11
+
+func (b *BackupSchedule) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(b).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-infinispan-org-v2alpha1-backupschedule,mutating=true,failurePolicy=fail,sideEffects=None,groups=infinispan.org,resources=backupschedules,verbs=create;update,versions=v2alpha1,name=mbackupschedule.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Defaulter = &BackupSchedule{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (b *BackupSchedule) Default() {
+	// Apply the same defaults to the template as the Backup webhook applies to created Backup CRs
+	backup := &Backup{Spec: b.Spec.Template}
+	backup.Default()
+	b.Spec.Template = backup.Spec
+}
+
+// +kubebuilder:webhook:path=/validate-infinispan-org-v2alpha1-backupschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=infinispan.org,resources=backupschedules,verbs=create;update,versions=v2alpha1,name=vbackupschedule.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &BackupSchedule{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (b *BackupSchedule) ValidateCreate() error {
+	var allErrs field.ErrorList
+	if len(b.Name) > maxBackupScheduleNameLength {
+		allErrs = append(allErrs, field.TooLong(field.NewPath("metadata").Child("name"), b.Name, maxBackupScheduleNameLength))
+	}
+	allErrs = append(allErrs, b.validate()...)
+	return b.StatusError(allErrs)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (b *BackupSchedule) ValidateUpdate(old runtime.Object) error {
+	var allErrs field.ErrorList
+	oldSchedule := old.(*BackupSchedule)
+	if oldSchedule.Spec.Template.Cluster != b.Spec.Template.Cluster {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("template").Child("cluster"), "BackupSchedule cluster is immutable and cannot be updated after initial BackupSchedule creation"))
+	}
+	allErrs = append(allErrs, b.validate()...)
+	return b.StatusError(allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (b *BackupSchedule) ValidateDelete() error {
+	return nil
+}
+
+func (b *BackupSchedule) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	spec := field.NewPath("spec")
+	if b.Spec.Schedule == "" {
+		allErrs = append(allErrs, field.Required(spec.Child("schedule"), "'spec.schedule' must be configured"))
+	} else if _, err := cron.Parse(b.Spec.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(spec.Child("schedule"), b.Spec.Schedule, fmt.Sprintf("invalid cron expression: %v", err)))
+	}
+
+	if b.Spec.Template.Cluster == "" {
+		allErrs = append(allErrs, field.Required(spec.Child("template").Child("cluster"), "'spec.template.cluster' must be configured"))
+	}
+	allErrs = append(allErrs, b.Spec.Template.Volume.validate(spec.Child("template").Child("volume"))...)
+
+	if retention := b.Spec.Retention; retention != nil {
+		if retention.KeepLast != nil && *retention.KeepLast < 1 {
+			allErrs = append(allErrs, field.Invalid(spec.Child("retention").Child("keepLast"), *retention.KeepLast, "keepLast must be greater than 0"))
+		}
+		if retention.MaxAge != nil && retention.MaxAge.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(spec.Child("retention").Child("maxAge"), retention.MaxAge.Duration.String(), "maxAge must be greater than 0"))
+		}
+	}
+	return allErrs
+}
+
+func (b *BackupSchedule) StatusError(allErrs field.ErrorList) error {
+	if len(allErrs) != 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "BackupSchedule"},
+			b.Name, allErrs)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package v2alpha1
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	"k8s.io/utils/pointer"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("BackupSchedule Webhook", func() {
+
+	const timeout = time.Second * 30
+	const interval = time.Second * 1
+
+	key := types.NamespacedName{
+		Name:      "backupschedule-envtest",
+		Namespace: "default",
+	}
+
+	AfterEach(func() {
+		// Delete created BackupSchedule resources
+		By("Expecting to delete successfully")
+		Eventually(func() error {
+			f := &BackupSchedule{}
+			if err := k8sClient.Get(ctx, key, f); err != nil {
+				var statusError *k8serrors.StatusError
+				if !errors.As(err, &statusError) {
+					return err
+				}
+				// If the BackupSchedule does not exist, do nothing
+				if statusError.ErrStatus.Code == 404 {
+					return nil
+				}
+			}
+			return k8sClient.Delete(ctx, f)
+		}, timeout, interval).Should(Succeed())
+
+		By("Expecting to delete finish")
+		Eventually(func() error {
+			f := &BackupSchedule{}
+			return k8sClient.Get(ctx, key, f)
+		}, timeout, interval).ShouldNot(Succeed())
+	})
+
+	Context("BackupSchedule", func() {
+		It("Should initiate template defaults", func() {
+
+			created := &BackupSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: BackupScheduleSpec{
+					Schedule: "@daily",
+					Template: BackupSpec{
+						Cluster: "some-cluster",
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+			Expect(k8sClient.Get(ctx, key, created)).Should(Succeed())
+			Expect(created.Spec.Template.Container.Memory).Should(Equal(constants.DefaultMemorySize.String()))
+		})
+
+		It("Should return error if required fields not provided", func() {
+
+			rejected := &BackupSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.schedule", "'spec.schedule' must be configured"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.template.cluster", "'spec.template.cluster' must be configured"},
+			)
+		})
+
+		It("Should return error if the schedule or retention policy is invalid", func() {
+
+			rejected := &BackupSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: BackupScheduleSpec{
+					Schedule: "60 * * * *",
+					Template: BackupSpec{
+						Cluster: "some-cluster",
+					},
+					Retention: &BackupScheduleRetentionSpec{
+						KeepLast: pointer.Int32(0),
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.schedule", "invalid cron expression: invalid minute field: value 60 out of range [0, 59]"},
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.retention.keepLast", "keepLast must be greater than 0"},
+			)
+		})
+
+		It("Should return error if the name is too long", func() {
+
+			rejected := &BackupSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      strings.Repeat("a", maxBackupScheduleNameLength+1),
+					Namespace: key.Namespace,
+				},
+				Spec: BackupScheduleSpec{
+					Schedule: "@daily",
+					Template: BackupSpec{
+						Cluster: "some-cluster",
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, statusDetailCause{"FieldValueTooLong", "metadata.name", "must have at most 52 bytes"})
+		})
+
+		It("Should return error if the cluster is updated", func() {
+
+			created := &BackupSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: BackupScheduleSpec{
+					Schedule: "@daily",
+					Template: BackupSpec{
+						Cluster: "some-cluster",
+					},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+
+			// Ensure that the schedule can be updated
+			updated := &BackupSchedule{}
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.Schedule = "@hourly"
+			updated.Spec.Suspend = true
+			Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.Template.Cluster = "new-cluster"
+			cause := statusDetailCause{"FieldValueForbidden", "spec.template.cluster", "BackupSchedule cluster is immutable and cannot be updated after initial BackupSchedule creation"}
+			expectInvalidErrStatus(k8sClient.Update(ctx, updated), cause)
+		})
+	})
+})
@@ -34,15 +34,304 @@ type CacheUpdateStrategyType string
 const (
 	CacheUpdateRecreate CacheUpdateStrategyType = "recreate"
 	CacheUpdateRetain   CacheUpdateStrategyType = "retain"
+	CacheUpdateMigrate  CacheUpdateStrategyType = "migrate"
 )
 
 type CacheUpdateSpec struct {
 	// How updates to Cache CR template should be applied on the Infinispan server
 	// +optional
-	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Update Strategy",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:recreate", "urn:alm:descriptor:com.tectonic.ui:select:retain"}
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Update Strategy",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:recreate", "urn:alm:descriptor:com.tectonic.ui:select:retain", "urn:alm:descriptor:com.tectonic.ui:select:migrate"}
 	Strategy CacheUpdateStrategyType `json:"strategy,omitempty"`
 }
 
+type CacheDependencies struct {
+	// Names of the Schema CRs that must be Ready before the cache is created on the server. Schemas defined directly
+	// on the server are imported as Schema CRs named <cluster>-<schema name without the .proto suffix>
+	// +optional
+	Schemas []string `json:"schemas,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Distributed;Replicated
+type CacheType string
+
+const (
+	CacheTypeDistributed CacheType = "Distributed"
+	CacheTypeReplicated  CacheType = "Replicated"
+)
+
+// +kubebuilder:validation:Enum=SYNC;ASYNC
+type CacheMode string
+
+const (
+	CacheModeSync  CacheMode = "SYNC"
+	CacheModeAsync CacheMode = "ASYNC"
+)
+
+// CacheConfigurationSpec a typed definition of the common distributed and replicated cache settings
+type CacheConfigurationSpec struct {
+	// The type of the cache
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cache Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:Distributed","urn:alm:descriptor:com.tectonic.ui:select:Replicated"}
+	Type CacheType `json:"type"`
+	// Whether writes are replicated synchronously or asynchronously. Defaults to SYNC
+	// +optional
+	Mode CacheMode `json:"mode,omitempty"`
+	// The number of copies of each entry stored across the cluster. Only applicable to Distributed caches
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Owners *int32 `json:"owners,omitempty"`
+	// If true, cache statistics are collected
+	// +optional
+	Statistics bool `json:"statistics,omitempty"`
+	// +optional
+	Encoding *CacheEncodingSpec `json:"encoding,omitempty"`
+	// +optional
+	Memory *CacheMemorySpec `json:"memory,omitempty"`
+	// +optional
+	Expiration *CacheExpirationSpec `json:"expiration,omitempty"`
+	// +optional
+	Locking *CacheLockingSpec `json:"locking,omitempty"`
+	// +optional
+	Indexing *CacheIndexingSpec `json:"indexing,omitempty"`
+	// The remote sites that cache entries are backed up to
+	// +optional
+	// +listType=map
+	// +listMapKey=site
+	Backups []CacheBackupSpec `json:"backups,omitempty"`
+}
+
+// CacheEncodingSpec the media types used to store keys and values
+type CacheEncodingSpec struct {
+	// The media type of keys, e.g. application/x-protostream
+	// +optional
+	Key string `json:"key,omitempty"`
+	// The media type of values, e.g. application/x-protostream
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=HEAP;OFF_HEAP
+type CacheStorageType string
+
+// +kubebuilder:validation:Enum=REMOVE;EXCEPTION
+type CacheEvictionStrategy string
+
+// CacheMemorySpec configures how entries are stored in memory and when they are evicted
+type CacheMemorySpec struct {
+	// Where entries are stored in memory
+	// +optional
+	Storage CacheStorageType `json:"storage,omitempty"`
+	// The maximum number of entries held in memory
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxCount *int64 `json:"maxCount,omitempty"`
+	// The maximum amount of memory used by entries, e.g. 100MB. Requires a binary encoding
+	// +optional
+	MaxSize string `json:"maxSize,omitempty"`
+	// The action taken when the maximum count or size is reached
+	// +optional
+	WhenFull CacheEvictionStrategy `json:"whenFull,omitempty"`
+}
+
+// CacheExpirationSpec configures when entries expire
+type CacheExpirationSpec struct {
+	// The time in milliseconds that entries are stored, -1 disables expiration
+	// +optional
+	// +kubebuilder:validation:Minimum=-1
+	Lifespan *int64 `json:"lifespan,omitempty"`
+	// The time in milliseconds that entries are stored without being accessed, -1 disables expiration
+	// +optional
+	// +kubebuilder:validation:Minimum=-1
+	MaxIdle *int64 `json:"maxIdle,omitempty"`
+	// The interval in milliseconds between removals of expired entries, -1 disables the expiration reaper
+	// +optional
+	// +kubebuilder:validation:Minimum=-1
+	Interval *int64 `json:"interval,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=READ_COMMITTED;REPEATABLE_READ
+type CacheIsolationLevel string
+
+// CacheLockingSpec configures entry locking
+type CacheLockingSpec struct {
+	// The isolation level of the cache
+	// +optional
+	Isolation CacheIsolationLevel `json:"isolation,omitempty"`
+	// If true, a shared pool of locks is used for all entries
+	// +optional
+	Striping bool `json:"striping,omitempty"`
+	// The maximum time in milliseconds to wait to acquire a lock
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	AcquireTimeout *int64 `json:"acquireTimeout,omitempty"`
+	// The size of the lock pool when striping is enabled
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ConcurrencyLevel *int32 `json:"concurrencyLevel,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=filesystem;local-heap
+type CacheIndexStorage string
+
+// CacheIndexingSpec configures the indexing of values for queries
+type CacheIndexingSpec struct {
+	// If true, values are indexed
+	Enabled bool `json:"enabled"`
+	// Where the indexes are stored. Defaults to filesystem
+	// +optional
+	Storage CacheIndexStorage `json:"storage,omitempty"`
+	// The fully qualified names of the Protobuf message types that are indexed
+	// +optional
+	IndexedEntities []string `json:"indexedEntities,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=IGNORE;WARN;FAIL
+type CacheBackupFailurePolicy string
+
+// CacheBackupSpec configures the backup of cache entries to a remote site
+type CacheBackupSpec struct {
+	// The name of the remote site
+	Site string `json:"site"`
+	// Whether entries are backed up synchronously or asynchronously. Defaults to ASYNC
+	// +optional
+	Strategy CacheMode `json:"strategy,omitempty"`
+	// The action taken when a backup operation fails
+	// +optional
+	FailurePolicy CacheBackupFailurePolicy `json:"failurePolicy,omitempty"`
+	// The time in milliseconds to wait for a backup operation to complete
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Timeout *int64 `json:"timeout,omitempty"`
+}
+
+// CachePersistenceSpec configures the store used to persist cache entries. Exactly one store must be configured
+type CachePersistenceSpec struct {
+	// If true, entries are only written to the store when they are evicted from memory
+	// +optional
+	Passivation bool `json:"passivation,omitempty"`
+	// A JDBC string-keyed store that persists entries in a table managed by the server
+	// +optional
+	JDBC *JDBCStoreSpec `json:"jdbc,omitempty"`
+	// A SQL store that maps entries to an existing database table or set of queries
+	// +optional
+	SQL *SQLStoreSpec `json:"sql,omitempty"`
+	// A file store that persists entries to the local filesystem of each pod
+	// +optional
+	File *FileStoreSpec `json:"file,omitempty"`
+}
+
+// CacheStoreSpec configuration common to all cache stores
+type CacheStoreSpec struct {
+	// If true, the store is shared by all cluster members
+	// +optional
+	Shared bool `json:"shared,omitempty"`
+	// If true, the cache is populated with the contents of the store on startup
+	// +optional
+	Preload bool `json:"preload,omitempty"`
+	// If true, the store is cleared on startup
+	// +optional
+	Purge bool `json:"purge,omitempty"`
+}
+
+// JDBCConnectionSpec configures the connection to a database
+type JDBCConnectionSpec struct {
+	// The JDBC connection URL
+	URL string `json:"url"`
+	// The class name of the JDBC driver
+	Driver string `json:"driver"`
+	// The Secret containing the database credentials in the 'username' and 'password' keys. The password is added to
+	// the server credential store and referenced by the connection pool of the store, which is updated when the Secret changes
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Database Credentials Secret",xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// JDBCColumnSpec configures a table column
+type JDBCColumnSpec struct {
+	// The column name
+	// +optional
+	Name string `json:"name,omitempty"`
+	// The column type, which is specific to the database
+	Type string `json:"type"`
+}
+
+// JDBCTableSpec configures the table managed by a JDBC string-keyed store
+type JDBCTableSpec struct {
+	// The prefix prepended to the cache name to create the table name
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// If true, the table is dropped when the cache is stopped
+	// +optional
+	DropOnExit bool `json:"dropOnExit,omitempty"`
+	// The column used to store the entry key
+	IDColumn JDBCColumnSpec `json:"idColumn"`
+	// The column used to store the entry value
+	DataColumn JDBCColumnSpec `json:"dataColumn"`
+	// The column used to store the entry expiration timestamp
+	TimestampColumn JDBCColumnSpec `json:"timestampColumn"`
+	// The column used to store the entry segment
+	// +optional
+	SegmentColumn *JDBCColumnSpec `json:"segmentColumn,omitempty"`
+}
+
+// JDBCStoreSpec configures a JDBC string-keyed store
+type JDBCStoreSpec struct {
+	CacheStoreSpec `json:",inline"`
+	Connection     JDBCConnectionSpec `json:"connection"`
+	// The database dialect. If not specified the server detects the dialect from the connection
+	// +optional
+	Dialect string        `json:"dialect,omitempty"`
+	Table   JDBCTableSpec `json:"table"`
+}
+
+// SQLQueriesSpec the queries used by a SQL store to load and store entries
+type SQLQueriesSpec struct {
+	// Loads a single entry by key
+	Select string `json:"select"`
+	// Loads all entries
+	SelectAll string `json:"selectAll"`
+	// Counts the number of entries
+	Size string `json:"size"`
+	// Inserts or updates an entry. Omit to make the store read-only
+	// +optional
+	Upsert string `json:"upsert,omitempty"`
+	// Deletes a single entry by key. Omit to make the store read-only
+	// +optional
+	Delete string `json:"delete,omitempty"`
+	// Deletes all entries. Omit to make the store read-only
+	// +optional
+	DeleteAll string `json:"deleteAll,omitempty"`
+}
+
+// SQLStoreSpec configures a SQL store backed by either an existing table or a set of queries
+type SQLStoreSpec struct {
+	CacheStoreSpec `json:",inline"`
+	Connection     JDBCConnectionSpec `json:"connection"`
+	// The database dialect
+	// +optional
+	Dialect string `json:"dialect,omitempty"`
+	// The name of the database table that entries are loaded from and stored to
+	// +optional
+	Table string `json:"table,omitempty"`
+	// The queries used to load and store entries, as an alternative to a table
+	// +optional
+	Queries *SQLQueriesSpec `json:"queries,omitempty"`
+	// A comma-separated list of the columns that form the key. Required when queries are configured
+	// +optional
+	KeyColumns string `json:"keyColumns,omitempty"`
+}
+
+// FileStoreSpec configures a file store
+type FileStoreSpec struct {
+	// If true, the cache is populated with the contents of the store on startup
+	// +optional
+	Preload bool `json:"preload,omitempty"`
+	// If true, the store is cleared on startup
+	// +optional
+	Purge bool `json:"purge,omitempty"`
+	// The directory in which the data is stored, relative to the server data directory
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
 // CacheSpec defines the desired state of Cache
 type CacheSpec struct {
 	// Deprecated. This no longer has any effect. The operator's admin credentials are now used to perform cache operations
@@ -56,12 +345,21 @@ type CacheSpec struct {
 	// Cache template in XML format
 	// +optional
 	Template string `json:"template,omitempty"`
+	// A typed cache configuration, as an alternative to spec.template
+	// +optional
+	Configuration *CacheConfigurationSpec `json:"configuration,omitempty"`
 	// Name of the template to be used to create this cache
 	// +optional
 	TemplateName string `json:"templateName,omitempty"`
 	// How updates to Cache CR template should be reconciled on the Infinispan server
 	// +optional
 	Updates *CacheUpdateSpec `json:"updates,omitempty"`
+	// Resources that must be available on the server before the cache is created
+	// +optional
+	Dependencies *CacheDependencies `json:"dependencies,omitempty"`
+	// The store used to persist cache entries. Applied to the cache configuration defined in spec.template or spec.configuration
+	// +optional
+	Persistence *CachePersistenceSpec `json:"persistence,omitempty"`
 }
 
 // CacheCondition define a condition of the cluster
@@ -75,6 +373,35 @@ type CacheCondition struct {
 	Message string `json:"message,omitempty"`
 }
 
+type CacheMigrationPhase string
+
+const (
+	// CacheMigrationSyncing indicates that the cache alias has been moved to the target cache and entries are being
+	// copied from the existing cache to the target cache
+	CacheMigrationSyncing CacheMigrationPhase = "Syncing"
+	// CacheMigrationSwapping indicates that all entries have been copied to the target cache and the existing cache is
+	// being removed
+	CacheMigrationSwapping CacheMigrationPhase = "Swapping"
+	// CacheMigrationSucceeded indicates that the target cache has replaced the existing cache
+	CacheMigrationSucceeded CacheMigrationPhase = "Succeeded"
+)
+
+// CacheMigrationStatus describes the progress of a data migration triggered by the "migrate" update strategy
+type CacheMigrationStatus struct {
+	// The current phase of the migration
+	Phase CacheMigrationPhase `json:"phase"`
+	// The name of the server cache that the data is migrated from
+	SourceCache string `json:"sourceCache"`
+	// The name of the server cache created with the updated configuration
+	TargetCache string `json:"targetCache"`
+	// The number of entries copied to the target cache
+	// +optional
+	EntriesMigrated int64 `json:"entriesMigrated,omitempty"`
+	// Human-readable message indicating details about the last migration error
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // CacheStatus defines the observed state of Cache
 type CacheStatus struct {
 	// Conditions list for this cache
@@ -83,6 +410,19 @@ type CacheStatus struct {
 	// Deprecated. This is no longer set. Service name that exposes the cache inside the cluster
 	// +optional
 	ServiceName string `json:"serviceName,omitempty"`
+	// The most recent generation of the Cache CR applied to the server
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// The name of the server cache that backs this resource, when it differs from the cache name as a result of a
+	// migration. Clients continue to access the cache by its original name, which is an alias of this cache
+	// +optional
+	ServerCacheName string `json:"serverCacheName,omitempty"`
+	// The progress of the most recent data migration
+	// +optional
+	Migration *CacheMigrationStatus `json:"migration,omitempty"`
+	// The roles of cache scoped Role CRs that were last applied to the cache configuration
+	// +optional
+	AuthorizationRoles []string `json:"authorizationRoles,omitempty"`
 }
 
 // +kubebuilder:object:root=true
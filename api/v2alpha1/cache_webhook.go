@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	v1 "k8s.io/api/admission/v1"
@@ -21,6 +22,9 @@ import (
 
 var log = ctrl.Log.WithName("webhook").WithName("Cache")
 
+// maxSizePattern matches the memory sizes accepted by the server, e.g. 1000, 100MB or 1.5GiB
+var maxSizePattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?([KMGT]i?)?B?$`)
+
 func (c *Cache) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(c).
@@ -142,6 +146,8 @@ func (cv *cacheValidator) Create(ctx context.Context, c *Cache) error {
 			}
 		}
 	}
+	validateConfiguration(c, &allErrs)
+	validatePersistence(c, &allErrs)
 	return StatusError(c, allErrs)
 }
 
@@ -153,9 +159,78 @@ func (cv *cacheValidator) Update(c *Cache, oldCache *Cache) error {
 	if oldCache.Spec.Name != c.Spec.Name {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("name"), "Cache name is immutable and cannot be updated after initial Cache creation"))
 	}
+	validateConfiguration(c, &allErrs)
+	validatePersistence(c, &allErrs)
 	return StatusError(c, allErrs)
 }
 
+func validateConfiguration(c *Cache, allErrs *field.ErrorList) {
+	conf := c.Spec.Configuration
+	if conf == nil {
+		return
+	}
+
+	path := field.NewPath("spec").Child("configuration")
+	if c.Spec.Template != "" || c.Spec.TemplateName != "" {
+		*allErrs = append(*allErrs, field.Forbidden(path, "'spec.configuration' cannot be configured with 'spec.template' or 'spec.templateName'"))
+	}
+
+	if conf.Owners != nil && conf.Type != CacheTypeDistributed {
+		*allErrs = append(*allErrs, field.Forbidden(path.Child("owners"), "Owners can only be configured for Distributed caches"))
+	}
+
+	if memory := conf.Memory; memory != nil {
+		if memory.MaxCount != nil && memory.MaxSize != "" {
+			*allErrs = append(*allErrs, field.Forbidden(path.Child("memory").Child("maxSize"), "Only one of 'maxCount' or 'maxSize' can be configured"))
+		}
+		if memory.MaxSize != "" {
+			if !maxSizePattern.MatchString(memory.MaxSize) {
+				*allErrs = append(*allErrs, field.Invalid(path.Child("memory").Child("maxSize"), memory.MaxSize, "Max size must be a quantity such as 100MB"))
+			}
+		}
+	}
+
+	if indexing := conf.Indexing; indexing != nil && indexing.Enabled && len(indexing.IndexedEntities) == 0 {
+		*allErrs = append(*allErrs, field.Required(path.Child("indexing").Child("indexedEntities"), "At least one indexed entity must be configured when indexing is enabled"))
+	}
+}
+
+func validatePersistence(c *Cache, allErrs *field.ErrorList) {
+	p := c.Spec.Persistence
+	if p == nil {
+		return
+	}
+
+	path := field.NewPath("spec").Child("persistence")
+	if c.Spec.Template == "" && c.Spec.Configuration == nil {
+		*allErrs = append(*allErrs, field.Forbidden(path, "Persistence can only be configured with 'spec.template' or 'spec.configuration'"))
+	}
+
+	var stores int
+	for _, configured := range []bool{p.JDBC != nil, p.SQL != nil, p.File != nil} {
+		if configured {
+			stores++
+		}
+	}
+	if stores == 0 {
+		*allErrs = append(*allErrs, field.Required(path, "One of 'jdbc', 'sql' or 'file' must be configured"))
+	} else if stores > 1 {
+		*allErrs = append(*allErrs, field.Forbidden(path, "Only one of 'jdbc', 'sql' or 'file' can be configured"))
+	}
+
+	if sql := p.SQL; sql != nil {
+		sqlPath := path.Child("sql")
+		if sql.Table == "" && sql.Queries == nil {
+			*allErrs = append(*allErrs, field.Required(sqlPath, "One of 'table' or 'queries' must be configured"))
+		} else if sql.Table != "" && sql.Queries != nil {
+			*allErrs = append(*allErrs, field.Forbidden(sqlPath.Child("queries"), "Queries cannot be configured with 'table'"))
+		}
+		if sql.Queries != nil && sql.KeyColumns == "" {
+			*allErrs = append(*allErrs, field.Required(sqlPath.Child("keyColumns"), "The key columns must be configured with 'queries'"))
+		}
+	}
+}
+
 func StatusError(c *Cache, allErrs field.ErrorList) error {
 	if len(allErrs) != 0 {
 		return apierrors.NewInvalid(
@@ -12,6 +12,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 )
 
 // These tests use Ginkgo (BDD-style Go testing framework). Refer to
@@ -207,5 +208,74 @@ var _ = Describe("Cache Webhook", func() {
 			Expect(k8sClient.Create(ctx, namespace1Cache)).Should(Succeed())
 			Expect(k8sClient.Create(ctx, namespace2Cache)).Should(Succeed())
 		})
+
+		It("Should prevent invalid cache configuration", func() {
+
+			rejected := &Cache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: CacheSpec{
+					ClusterName: "some-cluster",
+					Template:    "<distributed-cache/>",
+					Configuration: &CacheConfigurationSpec{
+						Type:   CacheTypeReplicated,
+						Owners: pointer.Int32(2),
+						Memory: &CacheMemorySpec{
+							MaxCount: pointer.Int64(100),
+							MaxSize:  "100MB",
+						},
+						Indexing: &CacheIndexingSpec{
+							Enabled: true,
+						},
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{"FieldValueForbidden", "spec.configuration", "'spec.configuration' cannot be configured with 'spec.template' or 'spec.templateName'"},
+				statusDetailCause{"FieldValueForbidden", "spec.configuration.owners", "Owners can only be configured for Distributed caches"},
+				statusDetailCause{"FieldValueForbidden", "spec.configuration.memory.maxSize", "Only one of 'maxCount' or 'maxSize' can be configured"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.configuration.indexing.indexedEntities", "At least one indexed entity must be configured when indexing is enabled"},
+			)
+		})
+
+		It("Should prevent invalid persistence configuration", func() {
+
+			connection := JDBCConnectionSpec{
+				URL:               "jdbc:postgresql://postgres:5432/infinispan",
+				Driver:            "org.postgresql.Driver",
+				CredentialsSecret: "db-credentials",
+			}
+			rejected := &Cache{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: CacheSpec{
+					ClusterName: "some-cluster",
+					Persistence: &CachePersistenceSpec{
+						SQL: &SQLStoreSpec{
+							Connection: connection,
+							Queries: &SQLQueriesSpec{
+								Select:    "SELECT * FROM books WHERE isbn = :isbn",
+								SelectAll: "SELECT * FROM books",
+								Size:      "SELECT COUNT(*) FROM books",
+							},
+						},
+						File: &FileStoreSpec{},
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{"FieldValueForbidden", "spec.persistence", "Persistence can only be configured with 'spec.template' or 'spec.configuration'"},
+				statusDetailCause{"FieldValueForbidden", "spec.persistence", "Only one of 'jdbc', 'sql' or 'file' can be configured"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.persistence.sql.keyColumns", "The key columns must be configured with 'queries'"},
+			)
+		})
 	})
 })
@@ -0,0 +1,111 @@
+package v2alpha1
+
+// IMPORTANT: run "make codegen" or "operator-sdk generate k8s" to regenerate code after modifying this file
+// NOTE: json tags are required. Any new fields you add must have json tags for the fields to be serialized.
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type CounterConditionType string
+
+const (
+	CounterConditionReady CounterConditionType = "Ready"
+)
+
+// +kubebuilder:validation:Enum=strong;weak
+type CounterType string
+
+const (
+	CounterTypeStrong CounterType = "strong"
+	CounterTypeWeak   CounterType = "weak"
+)
+
+// +kubebuilder:validation:Enum=Volatile;Persistent
+type CounterStorage string
+
+const (
+	CounterStorageVolatile   CounterStorage = "Volatile"
+	CounterStoragePersistent CounterStorage = "Persistent"
+)
+
+// CounterSpec defines the desired state of Counter
+type CounterSpec struct {
+	// Infinispan cluster name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Name",xDescriptors="urn:alm:descriptor:io.kubernetes:infinispan.org:v1:Infinispan"
+	ClusterName string `json:"clusterName"`
+	// Name of the counter to be created. If empty ObjectMeta.Name will be used
+	// +optional
+	Name string `json:"name,omitempty"`
+	// The type of the counter. Strong counters provide atomic updates and optional bounds, weak counters provide
+	// better performance for write heavy workloads at the cost of eventual consistency of the value
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Counter Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:strong", "urn:alm:descriptor:com.tectonic.ui:select:weak"}
+	Type CounterType `json:"type,omitempty"`
+	// The value of the counter when it is created or reset
+	// +optional
+	InitialValue int64 `json:"initialValue,omitempty"`
+	// The lower bound of a strong counter
+	// +optional
+	LowerBound *int64 `json:"lowerBound,omitempty"`
+	// The upper bound of a strong counter
+	// +optional
+	UpperBound *int64 `json:"upperBound,omitempty"`
+	// The number of concurrent updates supported by a weak counter
+	// +optional
+	ConcurrencyLevel *int32 `json:"concurrencyLevel,omitempty"`
+	// Whether the counter value is only held in memory or persisted so that it survives a cluster restart
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Storage",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:Volatile", "urn:alm:descriptor:com.tectonic.ui:select:Persistent"}
+	Storage CounterStorage `json:"storage,omitempty"`
+}
+
+// CounterCondition define a condition of the counter
+type CounterCondition struct {
+	// Type is the type of the condition.
+	Type CounterConditionType `json:"type"`
+	// Status is the status of the condition.
+	Status metav1.ConditionStatus `json:"status"`
+	// Human-readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CounterStatus defines the observed state of Counter
+type CounterStatus struct {
+	// Conditions list for this counter
+	// +optional
+	Conditions []CounterCondition `json:"conditions,omitempty"`
+	// The value of the counter on the server when it was last observed by the operator
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Value"
+	Value *int64 `json:"value,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Counter is the Schema for the counters API
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=counters,scope=Namespaced
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Value",type="integer",JSONPath=".status.value"
+type Counter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CounterSpec   `json:"spec,omitempty"`
+	Status CounterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// CounterList contains a list of Counter
+type CounterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Counter `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Counter{}, &CounterList{})
+}
@@ -0,0 +1,102 @@
+package v2alpha1
+
+import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (c *Counter) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(c).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-infinispan-org-v2alpha1-counter,mutating=true,failurePolicy=fail,sideEffects=None,groups=infinispan.org,resources=counters,verbs=create;update,versions=v2alpha1,name=mcounter.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Defaulter = &Counter{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (c *Counter) Default() {
+	if c.Spec.Type == "" {
+		c.Spec.Type = CounterTypeStrong
+	}
+	if c.Spec.Storage == "" {
+		c.Spec.Storage = CounterStorageVolatile
+	}
+}
+
+// +kubebuilder:webhook:path=/validate-infinispan-org-v2alpha1-counter,mutating=false,failurePolicy=fail,sideEffects=None,groups=infinispan.org,resources=counters,verbs=create;update,versions=v2alpha1,name=vcounter.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &Counter{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (c *Counter) ValidateCreate() error {
+	var allErrs field.ErrorList
+	if c.Spec.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("clusterName"), "'spec.clusterName' must be configured"))
+	}
+	allErrs = append(allErrs, c.validate()...)
+	return c.StatusError(allErrs)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (c *Counter) ValidateUpdate(old runtime.Object) error {
+	var allErrs field.ErrorList
+	oldCounter := old.(*Counter)
+	if !reflect.DeepEqual(c.Spec, oldCounter.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "The Counter spec is immutable and cannot be updated after initial Counter creation"))
+	}
+	return c.StatusError(allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (c *Counter) ValidateDelete() error {
+	return nil
+}
+
+func (c *Counter) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	spec := c.Spec
+	path := field.NewPath("spec")
+	if spec.Type == CounterTypeWeak {
+		if spec.LowerBound != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("lowerBound"), "Bounds can only be configured on strong counters"))
+		}
+		if spec.UpperBound != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("upperBound"), "Bounds can only be configured on strong counters"))
+		}
+		if spec.ConcurrencyLevel != nil && *spec.ConcurrencyLevel < 1 {
+			allErrs = append(allErrs, field.Invalid(path.Child("concurrencyLevel"), *spec.ConcurrencyLevel, "concurrencyLevel must be greater than 0"))
+		}
+		return allErrs
+	}
+
+	if spec.ConcurrencyLevel != nil {
+		allErrs = append(allErrs, field.Forbidden(path.Child("concurrencyLevel"), "concurrencyLevel can only be configured on weak counters"))
+	}
+	if spec.LowerBound != nil && spec.InitialValue < *spec.LowerBound {
+		msg := fmt.Sprintf("initialValue must be greater than or equal to lowerBound '%d'", *spec.LowerBound)
+		allErrs = append(allErrs, field.Invalid(path.Child("initialValue"), spec.InitialValue, msg))
+	}
+	if spec.UpperBound != nil && spec.InitialValue > *spec.UpperBound {
+		msg := fmt.Sprintf("initialValue must be less than or equal to upperBound '%d'", *spec.UpperBound)
+		allErrs = append(allErrs, field.Invalid(path.Child("initialValue"), spec.InitialValue, msg))
+	}
+	return allErrs
+}
+
+func (c *Counter) StatusError(allErrs field.ErrorList) error {
+	if len(allErrs) != 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "Counter"},
+			c.Name, allErrs)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package v2alpha1
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/utils/pointer"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Counter Webhook", func() {
+
+	const timeout = time.Second * 30
+	const interval = time.Second * 1
+
+	key := types.NamespacedName{
+		Name:      "counter-envtest",
+		Namespace: "default",
+	}
+
+	AfterEach(func() {
+		// Delete created Counter resources
+		By("Expecting to delete successfully")
+		Eventually(func() error {
+			f := &Counter{}
+			if err := k8sClient.Get(ctx, key, f); err != nil {
+				var statusError *k8serrors.StatusError
+				if !errors.As(err, &statusError) {
+					return err
+				}
+				// If the Counter does not exist, do nothing
+				if statusError.ErrStatus.Code == 404 {
+					return nil
+				}
+			}
+			return k8sClient.Delete(ctx, f)
+		}, timeout, interval).Should(Succeed())
+
+		By("Expecting to delete finish")
+		Eventually(func() error {
+			f := &Counter{}
+			return k8sClient.Get(ctx, key, f)
+		}, timeout, interval).ShouldNot(Succeed())
+	})
+
+	Context("Counter", func() {
+		It("Should initiate defaults", func() {
+
+			created := &Counter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: CounterSpec{
+					ClusterName: "some-cluster",
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+			Expect(k8sClient.Get(ctx, key, created)).Should(Succeed())
+			Expect(created.Spec.Type).Should(Equal(CounterTypeStrong))
+			Expect(created.Spec.Storage).Should(Equal(CounterStorageVolatile))
+		})
+
+		It("Should return error if required fields not provided", func() {
+
+			rejected := &Counter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.clusterName", "'spec.clusterName' must be configured"})
+		})
+
+		It("Should return error if the initial value is outside of the configured bounds", func() {
+
+			rejected := &Counter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: CounterSpec{
+					ClusterName:  "some-cluster",
+					InitialValue: 11,
+					LowerBound:   pointer.Int64(0),
+					UpperBound:   pointer.Int64(10),
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.initialValue", "initialValue must be less than or equal to upperBound '10'"})
+		})
+
+		It("Should return error if strong and weak counter fields are mixed", func() {
+
+			rejected := &Counter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: CounterSpec{
+					ClusterName: "some-cluster",
+					Type:        CounterTypeWeak,
+					LowerBound:  pointer.Int64(0),
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, statusDetailCause{"FieldValueForbidden", "spec.lowerBound", "Bounds can only be configured on strong counters"})
+
+			rejected.Spec = CounterSpec{
+				ClusterName:      "some-cluster",
+				Type:             CounterTypeStrong,
+				ConcurrencyLevel: pointer.Int32(2),
+			}
+			err = k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, statusDetailCause{"FieldValueForbidden", "spec.concurrencyLevel", "concurrencyLevel can only be configured on weak counters"})
+		})
+
+		It("Should return error if any spec value is updated", func() {
+
+			created := &Counter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: CounterSpec{
+					ClusterName:  "some-cluster",
+					InitialValue: 1,
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+
+			// Ensure Spec is immutable on update
+			updated := &Counter{}
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.InitialValue = 2
+
+			cause := statusDetailCause{"FieldValueForbidden", "spec", "The Counter spec is immutable and cannot be updated after initial Counter creation"}
+			expectInvalidErrStatus(k8sClient.Update(ctx, updated), cause)
+		})
+	})
+})
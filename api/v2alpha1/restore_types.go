@@ -10,15 +10,27 @@ type RestoreSpec struct {
 	// Infinispan cluster name
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Name",xDescriptors="urn:alm:descriptor:io.kubernetes:infinispan.org:v1:Infinispan"
 	Cluster string `json:"cluster"`
-	// The Infinispan Backup to restore
+	// The Infinispan Backup to restore. Either backup or objectStore must be configured
+	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Backup Name",xDescriptors="urn:alm:descriptor:io.kubernetes:infinispan.org:v2alpha1:Backup"
-	Backup string `json:"backup"`
+	Backup string `json:"backup,omitempty"`
+	// Restores a backup archive stored in S3 compatible object storage. Either backup or objectStore must be configured.
+	// The archive is transferred by the restore pod with curl, which must be available in the server image
+	// +optional
+	ObjectStore *RestoreObjectStoreSpec `json:"objectStore,omitempty"`
 	// +optional
 	Resources *RestoreResources `json:"resources,omitempty"`
 	// +optional
 	Container v1.InfinispanContainerSpec `json:"container,omitempty"`
 }
 
+type RestoreObjectStoreSpec struct {
+	ObjectStoreSpec `json:",inline"`
+	// The key of the backup archive to restore, e.g. the "status.objectKey" of the Backup. The prefix is not applied to the key
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Object Key",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	Key string `json:"key"`
+}
+
 type RestoreResources struct {
 	// +optional
 	Caches []string `json:"caches,omitempty"`
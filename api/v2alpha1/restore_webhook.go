@@ -53,8 +53,17 @@ func (b *Restore) ValidateCreate() error {
 	if b.Spec.Cluster == "" {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("cluster"), "'spec.cluster' must be configured"))
 	}
-	if b.Spec.Backup == "" {
-		allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("backup"), "'spec.backup' must be configured"))
+	spec := field.NewPath("spec")
+	if objectStore := b.Spec.ObjectStore; objectStore != nil {
+		if b.Spec.Backup != "" {
+			allErrs = append(allErrs, field.Forbidden(spec.Child("backup"), "'spec.backup' and 'spec.objectStore' cannot both be configured"))
+		}
+		allErrs = append(allErrs, objectStore.ObjectStoreSpec.validate(spec.Child("objectStore"))...)
+		if objectStore.Key == "" {
+			allErrs = append(allErrs, field.Required(spec.Child("objectStore").Child("key"), "'spec.objectStore.key' must be configured"))
+		}
+	} else if b.Spec.Backup == "" {
+		allErrs = append(allErrs, field.Required(spec.Child("backup"), "'spec.backup' or 'spec.objectStore' must be configured"))
 	}
 	return b.StatusError(allErrs)
 }
@@ -87,7 +87,35 @@ var _ = Describe("Restore Webhook", func() {
 			err := k8sClient.Create(ctx, rejected)
 			expectInvalidErrStatus(err, []statusDetailCause{
 				{metav1.CauseTypeFieldValueRequired, "spec.cluster", "'spec.cluster' must be configured"},
-				{metav1.CauseTypeFieldValueRequired, "spec.backup", "'spec.backup' must be configured"},
+				{metav1.CauseTypeFieldValueRequired, "spec.backup", "'spec.backup' or 'spec.objectStore' must be configured"},
+			}...)
+		})
+
+		It("Should return error if the object store is invalid", func() {
+
+			rejected := &Restore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: RestoreSpec{
+					Cluster: "some-cluster",
+					Backup:  "some-backup",
+					ObjectStore: &RestoreObjectStoreSpec{
+						ObjectStoreSpec: ObjectStoreSpec{
+							Endpoint: "minio:9000",
+						},
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, []statusDetailCause{
+				{"FieldValueForbidden", "spec.backup", "'spec.backup' and 'spec.objectStore' cannot both be configured"},
+				{metav1.CauseTypeFieldValueRequired, "spec.objectStore.bucket", "'spec.objectStore.bucket' must be configured"},
+				{metav1.CauseTypeFieldValueRequired, "spec.objectStore.credentialsSecret", "'spec.objectStore.credentialsSecret' must be configured"},
+				{metav1.CauseTypeFieldValueInvalid, "spec.objectStore.endpoint", "endpoint must be a valid http or https URL"},
+				{metav1.CauseTypeFieldValueRequired, "spec.objectStore.key", "'spec.objectStore.key' must be configured"},
 			}...)
 		})
 
@@ -0,0 +1,110 @@
+package v2alpha1
+
+// IMPORTANT: run "make codegen" or "operator-sdk generate k8s" to regenerate code after modifying this file
+// NOTE: json tags are required. Any new fields you add must have json tags for the fields to be serialized.
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type RoleConditionType string
+
+const (
+	RoleConditionReady RoleConditionType = "Ready"
+)
+
+// +kubebuilder:validation:Enum=Cluster;Cache
+type RoleScope string
+
+const (
+	// RoleScopeCluster the permissions of the role apply to all caches
+	RoleScopeCluster RoleScope = "Cluster"
+	// RoleScopeCache the role is only added to the authorization configuration of the caches listed in spec.caches
+	RoleScopeCache RoleScope = "Cache"
+)
+
+// +kubebuilder:validation:Enum=ALL;ALL_READ;ALL_WRITE;LIFECYCLE;READ;WRITE;EXEC;LISTEN;BULK_READ;BULK_WRITE;ADMIN;CREATE;MONITOR;NONE
+type RolePermission string
+
+// ReservedRoleNames the predefined server roles that cannot be redefined by a Role CR
+var ReservedRoleNames = []string{"admin", "application", "deployer", "monitor", "observer"}
+
+// RoleSpec defines the desired state of Role
+type RoleSpec struct {
+	// Infinispan cluster name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Name",xDescriptors="urn:alm:descriptor:io.kubernetes:infinispan.org:v1:Infinispan"
+	ClusterName string `json:"clusterName"`
+	// Name of the role to be created. If empty ObjectMeta.Name will be used
+	// +optional
+	Name string `json:"name,omitempty"`
+	// The permissions granted by the role
+	// +kubebuilder:validation:MinItems=1
+	Permissions []RolePermission `json:"permissions"`
+	// Whether the role applies to all caches or only to the caches listed in spec.caches
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Scope",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:select:Cluster", "urn:alm:descriptor:com.tectonic.ui:select:Cache"}
+	Scope RoleScope `json:"scope,omitempty"`
+	// The caches that the role applies to when spec.scope=Cache
+	// +optional
+	Caches []string `json:"caches,omitempty"`
+	// The principals that the role is granted to, in addition to the users that are members of a group with the
+	// same name as the role
+	// +optional
+	Principals []string `json:"principals,omitempty"`
+}
+
+// RoleCondition define a condition of the role
+type RoleCondition struct {
+	// Type is the type of the condition.
+	Type RoleConditionType `json:"type"`
+	// Status is the status of the condition.
+	Status metav1.ConditionStatus `json:"status"`
+	// Human-readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// RoleStatus defines the observed state of Role
+type RoleStatus struct {
+	// Conditions list for this role
+	// +optional
+	Conditions []RoleCondition `json:"conditions,omitempty"`
+	// The permissions of the role that were last applied to the server
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Permissions"
+	Permissions []RolePermission `json:"permissions,omitempty"`
+	// The caches whose Cache CR has added the role to the cache configuration
+	// +optional
+	Caches []string `json:"caches,omitempty"`
+	// The principals that the role was last granted to
+	// +optional
+	Principals []string `json:"principals,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Role is the Schema for the roles API
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=roles,scope=Namespaced
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName"
+// +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope"
+// +kubebuilder:printcolumn:name="Permissions",type="string",JSONPath=".status.permissions"
+type Role struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RoleSpec   `json:"spec,omitempty"`
+	Status RoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// RoleList contains a list of Role
+type RoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Role `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Role{}, &RoleList{})
+}
@@ -0,0 +1,92 @@
+package v2alpha1
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *Role) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-infinispan-org-v2alpha1-role,mutating=true,failurePolicy=fail,sideEffects=None,groups=infinispan.org,resources=roles,verbs=create;update,versions=v2alpha1,name=mrole.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Defaulter = &Role{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *Role) Default() {
+	if r.Spec.Scope == "" {
+		r.Spec.Scope = RoleScopeCluster
+	}
+}
+
+// +kubebuilder:webhook:path=/validate-infinispan-org-v2alpha1-role,mutating=false,failurePolicy=fail,sideEffects=None,groups=infinispan.org,resources=roles,verbs=create;update,versions=v2alpha1,name=vrole.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &Role{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Role) ValidateCreate() error {
+	var allErrs field.ErrorList
+	if r.Spec.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("clusterName"), "'spec.clusterName' must be configured"))
+	}
+	allErrs = append(allErrs, r.validate()...)
+	return r.StatusError(allErrs)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *Role) ValidateUpdate(old runtime.Object) error {
+	var allErrs field.ErrorList
+	oldRole := old.(*Role)
+	if oldRole.Spec.ClusterName != r.Spec.ClusterName {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("clusterName"), "Role clusterName is immutable and cannot be updated after initial Role creation"))
+	}
+	if oldRole.GetRoleName() != r.GetRoleName() {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("name"), "Role name is immutable and cannot be updated after initial Role creation"))
+	}
+	allErrs = append(allErrs, r.validate()...)
+	return r.StatusError(allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Role) ValidateDelete() error {
+	return nil
+}
+
+func (r *Role) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	path := field.NewPath("spec")
+	for _, reserved := range ReservedRoleNames {
+		if r.GetRoleName() == reserved {
+			msg := fmt.Sprintf("Role name '%s' is reserved for a predefined server role", reserved)
+			allErrs = append(allErrs, field.Invalid(path.Child("name"), r.GetRoleName(), msg))
+		}
+	}
+	if r.Spec.Scope == RoleScopeCache {
+		if len(r.Spec.Caches) == 0 {
+			msg := fmt.Sprintf("At least one cache must be configured with 'spec.scope=%s'", RoleScopeCache)
+			allErrs = append(allErrs, field.Required(path.Child("caches"), msg))
+		}
+	} else if len(r.Spec.Caches) > 0 {
+		msg := fmt.Sprintf("Caches can only be configured with 'spec.scope=%s'", RoleScopeCache)
+		allErrs = append(allErrs, field.Forbidden(path.Child("caches"), msg))
+	}
+	return allErrs
+}
+
+func (r *Role) StatusError(allErrs field.ErrorList) error {
+	if len(allErrs) != 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "Role"},
+			r.Name, allErrs)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package v2alpha1
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Role Webhook", func() {
+
+	const timeout = time.Second * 30
+	const interval = time.Second * 1
+
+	key := types.NamespacedName{
+		Name:      "role-envtest",
+		Namespace: "default",
+	}
+
+	AfterEach(func() {
+		// Delete created Role resources
+		By("Expecting to delete successfully")
+		Eventually(func() error {
+			f := &Role{}
+			if err := k8sClient.Get(ctx, key, f); err != nil {
+				var statusError *k8serrors.StatusError
+				if !errors.As(err, &statusError) {
+					return err
+				}
+				// If the Role does not exist, do nothing
+				if statusError.ErrStatus.Code == 404 {
+					return nil
+				}
+			}
+			return k8sClient.Delete(ctx, f)
+		}, timeout, interval).Should(Succeed())
+
+		By("Expecting to delete finish")
+		Eventually(func() error {
+			f := &Role{}
+			return k8sClient.Get(ctx, key, f)
+		}, timeout, interval).ShouldNot(Succeed())
+	})
+
+	Context("Role", func() {
+		It("Should initiate defaults", func() {
+
+			created := &Role{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: RoleSpec{
+					ClusterName: "some-cluster",
+					Permissions: []RolePermission{"ALL_READ"},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+			Expect(k8sClient.Get(ctx, key, created)).Should(Succeed())
+			Expect(created.Spec.Scope).Should(Equal(RoleScopeCluster))
+		})
+
+		It("Should return error if required fields not provided", func() {
+
+			rejected := &Role{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: RoleSpec{
+					Permissions: []RolePermission{"ALL_READ"},
+					Scope:       RoleScopeCache,
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.clusterName", "'spec.clusterName' must be configured"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.caches", "At least one cache must be configured with 'spec.scope=Cache'"},
+			)
+		})
+
+		It("Should return error if a reserved name or caches with Cluster scope are configured", func() {
+
+			rejected := &Role{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: RoleSpec{
+					ClusterName: "some-cluster",
+					Name:        "admin",
+					Permissions: []RolePermission{"ALL"},
+					Caches:      []string{"some-cache"},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.name", "Role name 'admin' is reserved for a predefined server role"},
+				statusDetailCause{"FieldValueForbidden", "spec.caches", "Caches can only be configured with 'spec.scope=Cache'"},
+			)
+		})
+
+		It("Should return error if clusterName or name are updated", func() {
+
+			created := &Role{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: RoleSpec{
+					ClusterName: "some-cluster",
+					Permissions: []RolePermission{"ALL_READ"},
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+
+			// Ensure that the permissions and scope can be updated
+			updated := &Role{}
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.Permissions = []RolePermission{"ALL_READ", "ALL_WRITE"}
+			updated.Spec.Scope = RoleScopeCache
+			updated.Spec.Caches = []string{"some-cache"}
+			Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+
+			// Ensure clusterName and name are immutable
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.ClusterName = "new-cluster"
+			updated.Spec.Name = "new-role"
+			expectInvalidErrStatus(k8sClient.Update(ctx, updated),
+				statusDetailCause{"FieldValueForbidden", "spec.clusterName", "Role clusterName is immutable and cannot be updated after initial Role creation"},
+				statusDetailCause{"FieldValueForbidden", "spec.name", "Role name is immutable and cannot be updated after initial Role creation"},
+			)
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package v2alpha1
+
+import (
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (s *Schema) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(s).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infinispan-org-v2alpha1-schema,mutating=false,failurePolicy=fail,sideEffects=None,groups=infinispan.org,resources=schemas,verbs=create;update,versions=v2alpha1,name=vschema.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &Schema{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (s *Schema) ValidateCreate() error {
+	var allErrs field.ErrorList
+	if s.Spec.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("clusterName"), "'spec.clusterName' must be configured"))
+	}
+	allErrs = append(allErrs, s.validate()...)
+	return s.StatusError(allErrs)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (s *Schema) ValidateUpdate(old runtime.Object) error {
+	var allErrs field.ErrorList
+	oldSchema := old.(*Schema)
+	if oldSchema.Spec.ClusterName != s.Spec.ClusterName {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("clusterName"), "Schema clusterName is immutable and cannot be updated after initial Schema creation"))
+	}
+	if oldSchema.GetSchemaName() != s.GetSchemaName() {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("name"), "Schema name is immutable and cannot be updated after initial Schema creation"))
+	}
+	allErrs = append(allErrs, s.validate()...)
+	return s.StatusError(allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (s *Schema) ValidateDelete() error {
+	return nil
+}
+
+func (s *Schema) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	path := field.NewPath("spec")
+	if s.Spec.Schema == "" && s.Spec.ConfigMap == nil {
+		allErrs = append(allErrs, field.Required(path.Child("schema"), "'spec.schema' OR 'spec.configMap' must be configured"))
+	} else if s.Spec.Schema != "" && s.Spec.ConfigMap != nil {
+		allErrs = append(allErrs, field.Forbidden(path.Child("schema"), "At most one of ['spec.schema', 'spec.configMap'] must be configured"))
+	}
+
+	if s.Spec.ConfigMap != nil && (s.Spec.ConfigMap.Name == "" || s.Spec.ConfigMap.Key == "") {
+		allErrs = append(allErrs, field.Required(path.Child("configMap"), "'spec.configMap.name' and 'spec.configMap.key' must be configured"))
+	}
+
+	if s.Spec.Name != "" && !strings.HasSuffix(s.Spec.Name, ".proto") {
+		allErrs = append(allErrs, field.Invalid(path.Child("name"), s.Spec.Name, "Schema name must end with '.proto'"))
+	}
+	return allErrs
+}
+
+func (s *Schema) StatusError(allErrs field.ErrorList) error {
+	if len(allErrs) != 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "Schema"},
+			s.Name, allErrs)
+	}
+	return nil
+}
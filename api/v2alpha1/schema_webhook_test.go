@@ -0,0 +1,138 @@
+package v2alpha1
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Schema Webhook", func() {
+
+	const timeout = time.Second * 30
+	const interval = time.Second * 1
+
+	key := types.NamespacedName{
+		Name:      "schema-envtest",
+		Namespace: "default",
+	}
+
+	AfterEach(func() {
+		// Delete created Schema resources
+		By("Expecting to delete successfully")
+		Eventually(func() error {
+			f := &Schema{}
+			if err := k8sClient.Get(ctx, key, f); err != nil {
+				var statusError *k8serrors.StatusError
+				if !errors.As(err, &statusError) {
+					return err
+				}
+				// If the Schema does not exist, do nothing
+				if statusError.ErrStatus.Code == 404 {
+					return nil
+				}
+			}
+			return k8sClient.Delete(ctx, f)
+		}, timeout, interval).Should(Succeed())
+
+		By("Expecting to delete finish")
+		Eventually(func() error {
+			f := &Schema{}
+			return k8sClient.Get(ctx, key, f)
+		}, timeout, interval).ShouldNot(Succeed())
+	})
+
+	Context("Schema", func() {
+		It("Should return error if required fields not provided", func() {
+
+			rejected := &Schema{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.clusterName", "'spec.clusterName' must be configured"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.schema", "'spec.schema' OR 'spec.configMap' must be configured"},
+			)
+		})
+
+		It("Should return error if both schema and configMap are configured", func() {
+
+			rejected := &Schema{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: SchemaSpec{
+					ClusterName: "some-cluster",
+					Schema:      "package example;",
+					ConfigMap: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "some-configmap"},
+						Key:                  "example.proto",
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, statusDetailCause{"FieldValueForbidden", "spec.schema", "At most one of ['spec.schema', 'spec.configMap'] must be configured"})
+		})
+
+		It("Should return error if the schema name does not end with .proto", func() {
+
+			rejected := &Schema{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: SchemaSpec{
+					ClusterName: "some-cluster",
+					Name:        "example",
+					Schema:      "package example;",
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.name", "Schema name must end with '.proto'"})
+		})
+
+		It("Should return error if clusterName or name are updated", func() {
+
+			created := &Schema{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: SchemaSpec{
+					ClusterName: "some-cluster",
+					Schema:      "package example;",
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+
+			// Ensure that the schema content can be updated
+			updated := &Schema{}
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.Schema = "package updated;"
+			Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+
+			// Ensure clusterName and name are immutable
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.ClusterName = "new-cluster"
+			updated.Spec.Name = "new.proto"
+			expectInvalidErrStatus(k8sClient.Update(ctx, updated),
+				statusDetailCause{"FieldValueForbidden", "spec.clusterName", "Schema clusterName is immutable and cannot be updated after initial Schema creation"},
+				statusDetailCause{"FieldValueForbidden", "spec.name", "Schema name is immutable and cannot be updated after initial Schema creation"},
+			)
+		})
+	})
+})
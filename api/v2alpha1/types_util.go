@@ -48,6 +48,195 @@ func (cache *Cache) GetCacheName() string {
 	return cache.Name
 }
 
+// GetServerCacheName returns the name of the server cache that backs the resource. This only differs from the cache
+// name once the cache has been migrated, in which case the cache name is an alias of the returned cache
+func (cache *Cache) GetServerCacheName() string {
+	if cache.Status.ServerCacheName != "" {
+		return cache.Status.ServerCacheName
+	}
+	return cache.GetCacheName()
+}
+
+// IsMigrating returns true if data is being migrated to a new server cache
+func (cache *Cache) IsMigrating() bool {
+	m := cache.Status.Migration
+	return m != nil && m.Phase != CacheMigrationSucceeded
+}
+
+// SetCondition set condition to status
+func (c *Counter) SetCondition(condition CounterConditionType, status metav1.ConditionStatus, message string) bool {
+	for idx := range c.Status.Conditions {
+		cond := &c.Status.Conditions[idx]
+		if cond.Type == condition {
+			changed := cond.Status != status || cond.Message != message
+			cond.Status = status
+			cond.Message = message
+			return changed
+		}
+	}
+	c.Status.Conditions = append(c.Status.Conditions, CounterCondition{Type: condition, Status: status, Message: message})
+	return true
+}
+
+// GetCondition return the Status of the given condition or nil if condition is not present
+func (c *Counter) GetCondition(condition CounterConditionType) CounterCondition {
+	for _, cond := range c.Status.Conditions {
+		if strings.EqualFold(string(cond.Type), string(condition)) {
+			return cond
+		}
+	}
+	// Absence of condition means `False` value
+	return CounterCondition{Type: condition, Status: metav1.ConditionFalse}
+}
+
+func (c *Counter) GetCounterName() string {
+	if c.Spec.Name != "" {
+		return c.Spec.Name
+	}
+	return c.Name
+}
+
+// SetCondition set condition to status
+func (s *Schema) SetCondition(condition SchemaConditionType, status metav1.ConditionStatus, message string) bool {
+	for idx := range s.Status.Conditions {
+		c := &s.Status.Conditions[idx]
+		if c.Type == condition {
+			changed := c.Status != status || c.Message != message
+			c.Status = status
+			c.Message = message
+			return changed
+		}
+	}
+	s.Status.Conditions = append(s.Status.Conditions, SchemaCondition{Type: condition, Status: status, Message: message})
+	return true
+}
+
+// GetCondition return the Status of the given condition or nil if condition is not present
+func (s *Schema) GetCondition(condition SchemaConditionType) SchemaCondition {
+	for _, c := range s.Status.Conditions {
+		if strings.EqualFold(string(c.Type), string(condition)) {
+			return c
+		}
+	}
+	// Absence of condition means `False` value
+	return SchemaCondition{Type: condition, Status: metav1.ConditionFalse}
+}
+
+// IsReady returns true if the schema has been registered on the server without errors
+func (s *Schema) IsReady() bool {
+	return s.GetCondition(SchemaConditionReady).Status == metav1.ConditionTrue
+}
+
+func (s *Schema) GetSchemaName() string {
+	if s.Spec.Name != "" {
+		return s.Spec.Name
+	}
+	return s.Name + ".proto"
+}
+
+// SetCondition set condition to status
+func (u *User) SetCondition(condition UserConditionType, status metav1.ConditionStatus, message string) bool {
+	for idx := range u.Status.Conditions {
+		c := &u.Status.Conditions[idx]
+		if c.Type == condition {
+			changed := c.Status != status || c.Message != message
+			c.Status = status
+			c.Message = message
+			return changed
+		}
+	}
+	u.Status.Conditions = append(u.Status.Conditions, UserCondition{Type: condition, Status: status, Message: message})
+	return true
+}
+
+// GetCondition return the Status of the given condition or nil if condition is not present
+func (u *User) GetCondition(condition UserConditionType) UserCondition {
+	for _, c := range u.Status.Conditions {
+		if strings.EqualFold(string(c.Type), string(condition)) {
+			return c
+		}
+	}
+	// Absence of condition means `False` value
+	return UserCondition{Type: condition, Status: metav1.ConditionFalse}
+}
+
+func (u *User) GetUsername() string {
+	if u.Spec.Username != "" {
+		return u.Spec.Username
+	}
+	return u.Name
+}
+
+// SetCondition set condition to status
+func (r *Role) SetCondition(condition RoleConditionType, status metav1.ConditionStatus, message string) bool {
+	for idx := range r.Status.Conditions {
+		c := &r.Status.Conditions[idx]
+		if c.Type == condition {
+			changed := c.Status != status || c.Message != message
+			c.Status = status
+			c.Message = message
+			return changed
+		}
+	}
+	r.Status.Conditions = append(r.Status.Conditions, RoleCondition{Type: condition, Status: status, Message: message})
+	return true
+}
+
+// GetCondition return the Status of the given condition or nil if condition is not present
+func (r *Role) GetCondition(condition RoleConditionType) RoleCondition {
+	for _, c := range r.Status.Conditions {
+		if strings.EqualFold(string(c.Type), string(condition)) {
+			return c
+		}
+	}
+	// Absence of condition means `False` value
+	return RoleCondition{Type: condition, Status: metav1.ConditionFalse}
+}
+
+func (r *Role) GetRoleName() string {
+	if r.Spec.Name != "" {
+		return r.Spec.Name
+	}
+	return r.Name
+}
+
+// GetScopedCaches returns the caches that the role is restricted to, or nil if the role applies to all caches
+func (r *Role) GetScopedCaches() []string {
+	if r.Spec.Scope != RoleScopeCache {
+		return nil
+	}
+	return r.Spec.Caches
+}
+
+// SchemaDependencies returns the names of the Schema CRs that the Cache depends on
+func (cache *Cache) SchemaDependencies() []string {
+	if cache.Spec.Dependencies == nil {
+		return nil
+	}
+	return cache.Spec.Dependencies.Schemas
+}
+
+// PersistenceConnection returns the database connection used by the Cache store, or nil if the store does not require one
+func (cache *Cache) PersistenceConnection() *JDBCConnectionSpec {
+	p := cache.Spec.Persistence
+	if p == nil {
+		return nil
+	}
+	if p.JDBC != nil {
+		return &p.JDBC.Connection
+	}
+	if p.SQL != nil {
+		return &p.SQL.Connection
+	}
+	return nil
+}
+
+// GetStoreCredentialAlias returns the alias of the Cache store password in the server credential store. The alias
+// includes the version of the credentials Secret, so that the store configuration changes when the password is updated
+func (cache *Cache) GetStoreCredentialAlias(secretVersion string) string {
+	return cache.Name + "-store-" + secretVersion
+}
+
 func (b *Batch) ConfigMapName() string {
 	if b.Spec.ConfigMap != nil {
 		return *b.Spec.ConfigMap
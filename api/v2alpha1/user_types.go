@@ -0,0 +1,82 @@
+package v2alpha1
+
+// IMPORTANT: run "make codegen" or "operator-sdk generate k8s" to regenerate code after modifying this file
+// NOTE: json tags are required. Any new fields you add must have json tags for the fields to be serialized.
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type UserConditionType string
+
+const (
+	UserConditionReady UserConditionType = "Ready"
+)
+
+// UserSpec defines the desired state of User
+type UserSpec struct {
+	// Infinispan cluster name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Name",xDescriptors="urn:alm:descriptor:io.kubernetes:infinispan.org:v1:Infinispan"
+	ClusterName string `json:"clusterName"`
+	// Name of the user to be created. If empty ObjectMeta.Name will be used
+	// +optional
+	Username string `json:"username,omitempty"`
+	// The secret containing the password of the user in the 'password' key
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Password Secret",xDescriptors="urn:alm:descriptor:io.kubernetes:Secret"
+	PasswordSecretName string `json:"passwordSecretName"`
+	// The groups that the user is a member of. Each group is mapped to the role with the same name
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// UserCondition define a condition of the user
+type UserCondition struct {
+	// Type is the type of the condition.
+	Type UserConditionType `json:"type"`
+	// Status is the status of the condition.
+	Status metav1.ConditionStatus `json:"status"`
+	// Human-readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// UserStatus defines the observed state of User
+type UserStatus struct {
+	// Conditions list for this user
+	// +optional
+	Conditions []UserCondition `json:"conditions,omitempty"`
+	// The groups of the user that were last applied to the server
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Groups"
+	Groups []string `json:"groups,omitempty"`
+	// The resource version of the password secret that was last applied to the server
+	// +optional
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// User is the Schema for the users API
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=users,scope=Namespaced
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".spec.clusterName"
+// +kubebuilder:printcolumn:name="Groups",type="string",JSONPath=".status.groups"
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserSpec   `json:"spec,omitempty"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// UserList contains a list of User
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&User{}, &UserList{})
+}
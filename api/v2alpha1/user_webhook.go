@@ -0,0 +1,77 @@
+package v2alpha1
+
+import (
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (u *User) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(u).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infinispan-org-v2alpha1-user,mutating=false,failurePolicy=fail,sideEffects=None,groups=infinispan.org,resources=users,verbs=create;update,versions=v2alpha1,name=vuser.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &User{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (u *User) ValidateCreate() error {
+	var allErrs field.ErrorList
+	if u.Spec.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec").Child("clusterName"), "'spec.clusterName' must be configured"))
+	}
+	allErrs = append(allErrs, u.validate()...)
+	return u.StatusError(allErrs)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (u *User) ValidateUpdate(old runtime.Object) error {
+	var allErrs field.ErrorList
+	oldUser := old.(*User)
+	if oldUser.Spec.ClusterName != u.Spec.ClusterName {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("clusterName"), "User clusterName is immutable and cannot be updated after initial User creation"))
+	}
+	if oldUser.GetUsername() != u.GetUsername() {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("username"), "User username is immutable and cannot be updated after initial User creation"))
+	}
+	allErrs = append(allErrs, u.validate()...)
+	return u.StatusError(allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (u *User) ValidateDelete() error {
+	return nil
+}
+
+func (u *User) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	path := field.NewPath("spec")
+	if u.Spec.PasswordSecretName == "" {
+		allErrs = append(allErrs, field.Required(path.Child("passwordSecretName"), "'spec.passwordSecretName' must be configured"))
+	}
+	if strings.ContainsAny(u.GetUsername(), " :=") {
+		allErrs = append(allErrs, field.Invalid(path.Child("username"), u.GetUsername(), "Username must not contain whitespace, ':' or '='"))
+	}
+	for i, group := range u.Spec.Groups {
+		if group == "" || strings.ContainsAny(group, " ,") {
+			allErrs = append(allErrs, field.Invalid(path.Child("groups").Index(i), group, "Group names must not be empty or contain whitespace or ','"))
+		}
+	}
+	return allErrs
+}
+
+func (u *User) StatusError(allErrs field.ErrorList) error {
+	if len(allErrs) != 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "User"},
+			u.Name, allErrs)
+	}
+	return nil
+}
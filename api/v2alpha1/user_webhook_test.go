@@ -0,0 +1,121 @@
+package v2alpha1
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("User Webhook", func() {
+
+	const timeout = time.Second * 30
+	const interval = time.Second * 1
+
+	key := types.NamespacedName{
+		Name:      "user-envtest",
+		Namespace: "default",
+	}
+
+	AfterEach(func() {
+		// Delete created User resources
+		By("Expecting to delete successfully")
+		Eventually(func() error {
+			f := &User{}
+			if err := k8sClient.Get(ctx, key, f); err != nil {
+				var statusError *k8serrors.StatusError
+				if !errors.As(err, &statusError) {
+					return err
+				}
+				// If the User does not exist, do nothing
+				if statusError.ErrStatus.Code == 404 {
+					return nil
+				}
+			}
+			return k8sClient.Delete(ctx, f)
+		}, timeout, interval).Should(Succeed())
+
+		By("Expecting to delete finish")
+		Eventually(func() error {
+			f := &User{}
+			return k8sClient.Get(ctx, key, f)
+		}, timeout, interval).ShouldNot(Succeed())
+	})
+
+	Context("User", func() {
+		It("Should return error if required fields not provided", func() {
+
+			rejected := &User{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.clusterName", "'spec.clusterName' must be configured"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.passwordSecretName", "'spec.passwordSecretName' must be configured"},
+			)
+		})
+
+		It("Should return error if the username or groups are invalid", func() {
+
+			rejected := &User{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: UserSpec{
+					ClusterName:        "some-cluster",
+					Username:           "some:user",
+					PasswordSecretName: "some-secret",
+					Groups:             []string{"admin", "some group"},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.username", "Username must not contain whitespace, ':' or '='"},
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.groups[1]", "Group names must not be empty or contain whitespace or ','"},
+			)
+		})
+
+		It("Should return error if clusterName or username are updated", func() {
+
+			created := &User{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: UserSpec{
+					ClusterName:        "some-cluster",
+					PasswordSecretName: "some-secret",
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+
+			// Ensure that the password secret and groups can be updated
+			updated := &User{}
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.PasswordSecretName = "new-secret"
+			updated.Spec.Groups = []string{"admin"}
+			Expect(k8sClient.Update(ctx, updated)).Should(Succeed())
+
+			// Ensure clusterName and username are immutable
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.ClusterName = "new-cluster"
+			updated.Spec.Username = "new-user"
+			expectInvalidErrStatus(k8sClient.Update(ctx, updated),
+				statusDetailCause{"FieldValueForbidden", "spec.clusterName", "User clusterName is immutable and cannot be updated after initial User creation"},
+				statusDetailCause{"FieldValueForbidden", "spec.username", "User username is immutable and cannot be updated after initial User creation"},
+			)
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package v2alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// XSiteOperationType is the cross-site operation to be executed
+// +kubebuilder:validation:Enum=TakeOffline;BringOnline;PushState;CancelPushState;ClearPushStateStatus;ConfigureTakeOffline
+type XSiteOperationType string
+
+const (
+	// XSiteOperationTakeOffline stops backing up data to the site
+	XSiteOperationTakeOffline XSiteOperationType = "TakeOffline"
+	// XSiteOperationBringOnline resumes backing up data to the site
+	XSiteOperationBringOnline XSiteOperationType = "BringOnline"
+	// XSiteOperationPushState transfers the state of the caches to the site. The operation only completes once the
+	// state transfer has finished on all caches
+	XSiteOperationPushState XSiteOperationType = "PushState"
+	// XSiteOperationCancelPushState cancels an in-progress state transfer to the site
+	XSiteOperationCancelPushState XSiteOperationType = "CancelPushState"
+	// XSiteOperationClearPushStateStatus clears the state transfer status of the caches
+	XSiteOperationClearPushStateStatus XSiteOperationType = "ClearPushStateStatus"
+	// XSiteOperationConfigureTakeOffline updates the conditions under which the site is automatically taken offline
+	XSiteOperationConfigureTakeOffline XSiteOperationType = "ConfigureTakeOffline"
+)
+
+// XSiteOperationSpec defines the desired state of XSiteOperation
+type XSiteOperationSpec struct {
+	// Infinispan cluster name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Name",xDescriptors="urn:alm:descriptor:io.kubernetes:infinispan.org:v1:Infinispan"
+	Cluster string `json:"cluster"`
+	// The cross-site operation to execute
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Operation"
+	Operation XSiteOperationType `json:"operation"`
+	// The name of the backup site that the operation applies to. Not required for ClearPushStateStatus
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Site"
+	Site string `json:"site,omitempty"`
+	// The caches that the operation applies to. If empty, the operation applies to all caches that backup to the site
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Caches"
+	Caches []string `json:"caches,omitempty"`
+	// The take offline configuration applied by the ConfigureTakeOffline operation
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Take Offline"
+	TakeOffline *XSiteTakeOfflineSpec `json:"takeOffline,omitempty"`
+}
+
+// XSiteTakeOfflineSpec determines when a backup site is automatically taken offline
+type XSiteTakeOfflineSpec struct {
+	// The number of consecutive failed requests after which the site is taken offline. Disabled if 0
+	// +optional
+	AfterFailures int32 `json:"afterFailures,omitempty"`
+	// The minimum time in milliseconds to wait after the first failure before the site is taken offline. Disabled if 0
+	// +optional
+	MinWait int64 `json:"minWait,omitempty"`
+}
+
+type XSiteOperationPhase string
+
+const (
+	// XSiteOperationInitializing means the request has been accepted by the system, but the Infinispan cluster has
+	// not yet been verified.
+	XSiteOperationInitializing XSiteOperationPhase = "Initializing"
+	// XSiteOperationInitialized means that the Infinispan cluster is ready for the operation to be executed
+	XSiteOperationInitialized XSiteOperationPhase = "Initialized"
+	// XSiteOperationRunning means that a state transfer has been started and is still in progress.
+	XSiteOperationRunning XSiteOperationPhase = "Running"
+	// XSiteOperationSucceeded means that the operation has completed successfully on all caches.
+	XSiteOperationSucceeded XSiteOperationPhase = "Succeeded"
+	// XSiteOperationFailed means that the operation has failed on one or more caches.
+	XSiteOperationFailed XSiteOperationPhase = "Failed"
+)
+
+// XSiteCacheResult is the outcome of the operation on a single cache
+type XSiteCacheResult struct {
+	// The name of the cache
+	Name string `json:"name"`
+	// The status of the site for the cache after the operation, or the state transfer status for PushState
+	// +optional
+	Status string `json:"status,omitempty"`
+	// The error returned by the server, if the operation failed
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// XSiteOperationStatus defines the observed state of XSiteOperation
+type XSiteOperationStatus struct {
+	// Current phase of the cross-site operation
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Phase"
+	Phase XSiteOperationPhase `json:"phase"`
+	// The reason for any operation related failures
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Reason"
+	Reason string `json:"reason,omitempty"`
+	// The UUID of the Infinispan instance that the operation is associated with
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Cluster UUID"
+	ClusterUID *types.UID `json:"clusterUID,omitempty"`
+	// The result of the operation on each cache
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Caches"
+	Caches []XSiteCacheResult `json:"caches,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=xsiteoperations,scope=Namespaced
+// XSiteOperation is the Schema for the xsiteoperations API
+type XSiteOperation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   XSiteOperationSpec   `json:"spec,omitempty"`
+	Status XSiteOperationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// XSiteOperationList contains a list of XSiteOperation
+type XSiteOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []XSiteOperation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&XSiteOperation{}, &XSiteOperationList{})
+}
@@ -0,0 +1,90 @@
+package v2alpha1
+
+import (
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (x *XSiteOperation) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(x).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-infinispan-org-v2alpha1-xsiteoperation,mutating=false,failurePolicy=fail,sideEffects=None,groups=infinispan.org,resources=xsiteoperations,verbs=create;update,versions=v2alpha1,name=vxsiteoperation.kb.io,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &XSiteOperation{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (x *XSiteOperation) ValidateCreate() error {
+	return x.StatusError(x.validate())
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (x *XSiteOperation) ValidateUpdate(old runtime.Object) error {
+	var allErrs field.ErrorList
+	oldOperation := old.(*XSiteOperation)
+	if !reflect.DeepEqual(x.Spec, oldOperation.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "The XSiteOperation spec is immutable and cannot be updated after initial XSiteOperation creation"))
+	}
+	return x.StatusError(allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (x *XSiteOperation) ValidateDelete() error {
+	return nil
+}
+
+func (x *XSiteOperation) validate() field.ErrorList {
+	var allErrs field.ErrorList
+	spec := x.Spec
+	path := field.NewPath("spec")
+	if spec.Cluster == "" {
+		allErrs = append(allErrs, field.Required(path.Child("cluster"), "'spec.cluster' must be configured"))
+	}
+
+	if spec.Site == "" && spec.Operation != XSiteOperationClearPushStateStatus {
+		allErrs = append(allErrs, field.Required(path.Child("site"), "'spec.site' must be configured"))
+	}
+
+	if spec.Operation == XSiteOperationConfigureTakeOffline {
+		if spec.TakeOffline == nil {
+			allErrs = append(allErrs, field.Required(path.Child("takeOffline"), "'spec.takeOffline' must be configured for the ConfigureTakeOffline operation"))
+		} else {
+			if spec.TakeOffline.AfterFailures < 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("takeOffline").Child("afterFailures"), spec.TakeOffline.AfterFailures, "afterFailures must not be negative"))
+			}
+			if spec.TakeOffline.MinWait < 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("takeOffline").Child("minWait"), spec.TakeOffline.MinWait, "minWait must not be negative"))
+			}
+		}
+	} else if spec.TakeOffline != nil {
+		allErrs = append(allErrs, field.Forbidden(path.Child("takeOffline"), "'spec.takeOffline' can only be configured for the ConfigureTakeOffline operation"))
+	}
+
+	caches := make(map[string]struct{}, len(spec.Caches))
+	for i, cache := range spec.Caches {
+		if cache == "" {
+			allErrs = append(allErrs, field.Required(path.Child("caches").Index(i), "cache name must not be empty"))
+		} else if _, exists := caches[cache]; exists {
+			allErrs = append(allErrs, field.Duplicate(path.Child("caches").Index(i), cache))
+		}
+		caches[cache] = struct{}{}
+	}
+	return allErrs
+}
+
+func (x *XSiteOperation) StatusError(allErrs field.ErrorList) error {
+	if len(allErrs) != 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "XSiteOperation"},
+			x.Name, allErrs)
+	}
+	return nil
+}
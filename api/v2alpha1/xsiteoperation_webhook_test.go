@@ -0,0 +1,155 @@
+package v2alpha1
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("XSiteOperation Webhook", func() {
+
+	const timeout = time.Second * 30
+	const interval = time.Second * 1
+
+	key := types.NamespacedName{
+		Name:      "xsite-operation-envtest",
+		Namespace: "default",
+	}
+
+	AfterEach(func() {
+		// Delete created XSiteOperation resources
+		By("Expecting to delete successfully")
+		Eventually(func() error {
+			x := &XSiteOperation{}
+			if err := k8sClient.Get(ctx, key, x); err != nil {
+				var statusError *k8serrors.StatusError
+				if !errors.As(err, &statusError) {
+					return err
+				}
+				// If the XSiteOperation does not exist, do nothing
+				if statusError.ErrStatus.Code == 404 {
+					return nil
+				}
+			}
+			return k8sClient.Delete(ctx, x)
+		}, timeout, interval).Should(Succeed())
+
+		By("Expecting to delete finish")
+		Eventually(func() error {
+			x := &XSiteOperation{}
+			return k8sClient.Get(ctx, key, x)
+		}, timeout, interval).ShouldNot(Succeed())
+	})
+
+	Context("XSiteOperation", func() {
+		It("Should return error if required fields not provided", func() {
+
+			rejected := &XSiteOperation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: XSiteOperationSpec{
+					Operation: XSiteOperationTakeOffline,
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.cluster", "'spec.cluster' must be configured"},
+				statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.site", "'spec.site' must be configured"},
+			)
+
+			rejected.Spec = XSiteOperationSpec{
+				Cluster:   "some-cluster",
+				Operation: XSiteOperationConfigureTakeOffline,
+				Site:      "site-b",
+			}
+			err = k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, statusDetailCause{metav1.CauseTypeFieldValueRequired, "spec.takeOffline", "'spec.takeOffline' must be configured for the ConfigureTakeOffline operation"})
+		})
+
+		It("Should not require a site to clear the push state status", func() {
+
+			created := &XSiteOperation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: XSiteOperationSpec{
+					Cluster:   "some-cluster",
+					Operation: XSiteOperationClearPushStateStatus,
+					Caches:    []string{"cache-a", "cache-b"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+		})
+
+		It("Should return error if fields are invalid", func() {
+
+			rejected := &XSiteOperation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: XSiteOperationSpec{
+					Cluster:   "some-cluster",
+					Operation: XSiteOperationConfigureTakeOffline,
+					Site:      "site-b",
+					Caches:    []string{"cache-a", "cache-a"},
+					TakeOffline: &XSiteTakeOfflineSpec{
+						AfterFailures: -1,
+						MinWait:       -1,
+					},
+				},
+			}
+
+			err := k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err,
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.takeOffline.afterFailures", "afterFailures must not be negative"},
+				statusDetailCause{metav1.CauseTypeFieldValueInvalid, "spec.takeOffline.minWait", "minWait must not be negative"},
+				statusDetailCause{metav1.CauseTypeFieldValueDuplicate, "spec.caches[1]", "Duplicate value"},
+			)
+
+			rejected.Spec = XSiteOperationSpec{
+				Cluster:     "some-cluster",
+				Operation:   XSiteOperationTakeOffline,
+				Site:        "site-b",
+				TakeOffline: &XSiteTakeOfflineSpec{AfterFailures: 1},
+			}
+			err = k8sClient.Create(ctx, rejected)
+			expectInvalidErrStatus(err, statusDetailCause{"FieldValueForbidden", "spec.takeOffline", "'spec.takeOffline' can only be configured for the ConfigureTakeOffline operation"})
+		})
+
+		It("Should return error if any spec value is updated", func() {
+
+			created := &XSiteOperation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Spec: XSiteOperationSpec{
+					Cluster:   "some-cluster",
+					Operation: XSiteOperationTakeOffline,
+					Site:      "site-b",
+				},
+			}
+
+			Expect(k8sClient.Create(ctx, created)).Should(Succeed())
+
+			// Ensure Spec is immutable on update
+			updated := &XSiteOperation{}
+			Expect(k8sClient.Get(ctx, key, updated)).Should(Succeed())
+			updated.Spec.Operation = XSiteOperationBringOnline
+
+			cause := statusDetailCause{"FieldValueForbidden", "spec", "The XSiteOperation spec is immutable and cannot be updated after initial XSiteOperation creation"}
+			expectInvalidErrStatus(k8sClient.Update(ctx, updated), cause)
+		})
+	})
+})
@@ -5,6 +5,8 @@
 package v2alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -135,6 +137,156 @@ func (in *BackupResources) DeepCopy() *BackupResources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSchedule) DeepCopyInto(out *BackupSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSchedule.
+func (in *BackupSchedule) DeepCopy() *BackupSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleList) DeepCopyInto(out *BackupScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BackupSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleList.
+func (in *BackupScheduleList) DeepCopy() *BackupScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleRetentionSpec) DeepCopyInto(out *BackupScheduleRetentionSpec) {
+	*out = *in
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleRetentionSpec.
+func (in *BackupScheduleRetentionSpec) DeepCopy() *BackupScheduleRetentionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleRetentionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleRun) DeepCopyInto(out *BackupScheduleRun) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleRun.
+func (in *BackupScheduleRun) DeepCopy() *BackupScheduleRun {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleSpec) DeepCopyInto(out *BackupScheduleSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupScheduleRetentionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleSpec.
+func (in *BackupScheduleSpec) DeepCopy() *BackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScheduleStatus) DeepCopyInto(out *BackupScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessful != nil {
+		in, out := &in.LastSuccessful, &out.LastSuccessful
+		*out = new(BackupScheduleRun)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastFailed != nil {
+		in, out := &in.LastFailed, &out.LastFailed
+		*out = new(BackupScheduleRun)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleStatus.
+func (in *BackupScheduleStatus) DeepCopy() *BackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 	*out = *in
@@ -185,6 +337,11 @@ func (in *BackupVolumeSpec) DeepCopyInto(out *BackupVolumeSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ObjectStore != nil {
+		in, out := &in.ObjectStore, &out.ObjectStore
+		*out = new(ObjectStoreSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeSpec.
@@ -348,6 +505,26 @@ func (in *Cache) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheBackupSpec) DeepCopyInto(out *CacheBackupSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheBackupSpec.
+func (in *CacheBackupSpec) DeepCopy() *CacheBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheCondition) DeepCopyInto(out *CacheCondition) {
 	*out = *in
@@ -364,150 +541,168 @@ func (in *CacheCondition) DeepCopy() *CacheCondition {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CacheList) DeepCopyInto(out *CacheList) {
+func (in *CacheConfigurationSpec) DeepCopyInto(out *CacheConfigurationSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Cache, len(*in))
+	if in.Owners != nil {
+		in, out := &in.Owners, &out.Owners
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(CacheEncodingSpec)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(CacheMemorySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(CacheExpirationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Locking != nil {
+		in, out := &in.Locking, &out.Locking
+		*out = new(CacheLockingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Indexing != nil {
+		in, out := &in.Indexing, &out.Indexing
+		*out = new(CacheIndexingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]CacheBackupSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheList.
-func (in *CacheList) DeepCopy() *CacheList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheConfigurationSpec.
+func (in *CacheConfigurationSpec) DeepCopy() *CacheConfigurationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CacheList)
+	out := new(CacheConfigurationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CacheList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CacheSpec) DeepCopyInto(out *CacheSpec) {
+func (in *CacheDependencies) DeepCopyInto(out *CacheDependencies) {
 	*out = *in
-	if in.AdminAuth != nil {
-		in, out := &in.AdminAuth, &out.AdminAuth
-		*out = new(AdminAuth)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Updates != nil {
-		in, out := &in.Updates, &out.Updates
-		*out = new(CacheUpdateSpec)
-		**out = **in
+	if in.Schemas != nil {
+		in, out := &in.Schemas, &out.Schemas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSpec.
-func (in *CacheSpec) DeepCopy() *CacheSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheDependencies.
+func (in *CacheDependencies) DeepCopy() *CacheDependencies {
 	if in == nil {
 		return nil
 	}
-	out := new(CacheSpec)
+	out := new(CacheDependencies)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CacheStatus) DeepCopyInto(out *CacheStatus) {
+func (in *CacheEncodingSpec) DeepCopyInto(out *CacheEncodingSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]CacheCondition, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheStatus.
-func (in *CacheStatus) DeepCopy() *CacheStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheEncodingSpec.
+func (in *CacheEncodingSpec) DeepCopy() *CacheEncodingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CacheStatus)
+	out := new(CacheEncodingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CacheUpdateSpec) DeepCopyInto(out *CacheUpdateSpec) {
+func (in *CacheExpirationSpec) DeepCopyInto(out *CacheExpirationSpec) {
 	*out = *in
+	if in.Lifespan != nil {
+		in, out := &in.Lifespan, &out.Lifespan
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxIdle != nil {
+		in, out := &in.MaxIdle, &out.MaxIdle
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(int64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheUpdateSpec.
-func (in *CacheUpdateSpec) DeepCopy() *CacheUpdateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheExpirationSpec.
+func (in *CacheExpirationSpec) DeepCopy() *CacheExpirationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CacheUpdateSpec)
+	out := new(CacheExpirationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Restore) DeepCopyInto(out *Restore) {
+func (in *CacheIndexingSpec) DeepCopyInto(out *CacheIndexingSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.IndexedEntities != nil {
+		in, out := &in.IndexedEntities, &out.IndexedEntities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Restore.
-func (in *Restore) DeepCopy() *Restore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheIndexingSpec.
+func (in *CacheIndexingSpec) DeepCopy() *CacheIndexingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Restore)
+	out := new(CacheIndexingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Restore) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreList) DeepCopyInto(out *RestoreList) {
+func (in *CacheList) DeepCopyInto(out *CacheList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Restore, len(*in))
+		*out = make([]Cache, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreList.
-func (in *RestoreList) DeepCopy() *RestoreList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheList.
+func (in *CacheList) DeepCopy() *CacheList {
 	if in == nil {
 		return nil
 	}
-	out := new(RestoreList)
+	out := new(CacheList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RestoreList) DeepCopyObject() runtime.Object {
+func (in *CacheList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -515,87 +710,1155 @@ func (in *RestoreList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreResources) DeepCopyInto(out *RestoreResources) {
+func (in *CacheLockingSpec) DeepCopyInto(out *CacheLockingSpec) {
 	*out = *in
-	if in.Caches != nil {
-		in, out := &in.Caches, &out.Caches
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Templates != nil {
-		in, out := &in.Templates, &out.Templates
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Counters != nil {
-		in, out := &in.Counters, &out.Counters
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ProtoSchemas != nil {
-		in, out := &in.ProtoSchemas, &out.ProtoSchemas
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Tasks != nil {
-		in, out := &in.Tasks, &out.Tasks
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.CacheConfigs != nil {
-		in, out := &in.CacheConfigs, &out.CacheConfigs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.AcquireTimeout != nil {
+		in, out := &in.AcquireTimeout, &out.AcquireTimeout
+		*out = new(int64)
+		**out = **in
 	}
-	if in.Scripts != nil {
-		in, out := &in.Scripts, &out.Scripts
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.ConcurrencyLevel != nil {
+		in, out := &in.ConcurrencyLevel, &out.ConcurrencyLevel
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreResources.
-func (in *RestoreResources) DeepCopy() *RestoreResources {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheLockingSpec.
+func (in *CacheLockingSpec) DeepCopy() *CacheLockingSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RestoreResources)
+	out := new(CacheLockingSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
+func (in *CacheMemorySpec) DeepCopyInto(out *CacheMemorySpec) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(RestoreResources)
-		(*in).DeepCopyInto(*out)
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheMemorySpec.
+func (in *CacheMemorySpec) DeepCopy() *CacheMemorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheMemorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheMigrationStatus) DeepCopyInto(out *CacheMigrationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheMigrationStatus.
+func (in *CacheMigrationStatus) DeepCopy() *CacheMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePersistenceSpec) DeepCopyInto(out *CachePersistenceSpec) {
+	*out = *in
+	if in.JDBC != nil {
+		in, out := &in.JDBC, &out.JDBC
+		*out = new(JDBCStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLStoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileStoreSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePersistenceSpec.
+func (in *CachePersistenceSpec) DeepCopy() *CachePersistenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePersistenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheSpec) DeepCopyInto(out *CacheSpec) {
+	*out = *in
+	if in.AdminAuth != nil {
+		in, out := &in.AdminAuth, &out.AdminAuth
+		*out = new(AdminAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(CacheConfigurationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Updates != nil {
+		in, out := &in.Updates, &out.Updates
+		*out = new(CacheUpdateSpec)
+		**out = **in
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = new(CacheDependencies)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(CachePersistenceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSpec.
+func (in *CacheSpec) DeepCopy() *CacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheStatus) DeepCopyInto(out *CacheStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CacheCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(CacheMigrationStatus)
+		**out = **in
+	}
+	if in.AuthorizationRoles != nil {
+		in, out := &in.AuthorizationRoles, &out.AuthorizationRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheStatus.
+func (in *CacheStatus) DeepCopy() *CacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheStoreSpec) DeepCopyInto(out *CacheStoreSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheStoreSpec.
+func (in *CacheStoreSpec) DeepCopy() *CacheStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheUpdateSpec) DeepCopyInto(out *CacheUpdateSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheUpdateSpec.
+func (in *CacheUpdateSpec) DeepCopy() *CacheUpdateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheUpdateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Counter) DeepCopyInto(out *Counter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Counter.
+func (in *Counter) DeepCopy() *Counter {
+	if in == nil {
+		return nil
+	}
+	out := new(Counter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Counter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CounterCondition) DeepCopyInto(out *CounterCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CounterCondition.
+func (in *CounterCondition) DeepCopy() *CounterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(CounterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CounterList) DeepCopyInto(out *CounterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Counter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CounterList.
+func (in *CounterList) DeepCopy() *CounterList {
+	if in == nil {
+		return nil
+	}
+	out := new(CounterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CounterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CounterSpec) DeepCopyInto(out *CounterSpec) {
+	*out = *in
+	if in.LowerBound != nil {
+		in, out := &in.LowerBound, &out.LowerBound
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UpperBound != nil {
+		in, out := &in.UpperBound, &out.UpperBound
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ConcurrencyLevel != nil {
+		in, out := &in.ConcurrencyLevel, &out.ConcurrencyLevel
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CounterSpec.
+func (in *CounterSpec) DeepCopy() *CounterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CounterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CounterStatus) DeepCopyInto(out *CounterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CounterCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CounterStatus.
+func (in *CounterStatus) DeepCopy() *CounterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CounterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileStoreSpec) DeepCopyInto(out *FileStoreSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileStoreSpec.
+func (in *FileStoreSpec) DeepCopy() *FileStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FileStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JDBCColumnSpec) DeepCopyInto(out *JDBCColumnSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JDBCColumnSpec.
+func (in *JDBCColumnSpec) DeepCopy() *JDBCColumnSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JDBCColumnSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JDBCConnectionSpec) DeepCopyInto(out *JDBCConnectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JDBCConnectionSpec.
+func (in *JDBCConnectionSpec) DeepCopy() *JDBCConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JDBCConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JDBCStoreSpec) DeepCopyInto(out *JDBCStoreSpec) {
+	*out = *in
+	out.CacheStoreSpec = in.CacheStoreSpec
+	out.Connection = in.Connection
+	in.Table.DeepCopyInto(&out.Table)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JDBCStoreSpec.
+func (in *JDBCStoreSpec) DeepCopy() *JDBCStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JDBCStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JDBCTableSpec) DeepCopyInto(out *JDBCTableSpec) {
+	*out = *in
+	out.IDColumn = in.IDColumn
+	out.DataColumn = in.DataColumn
+	out.TimestampColumn = in.TimestampColumn
+	if in.SegmentColumn != nil {
+		in, out := &in.SegmentColumn, &out.SegmentColumn
+		*out = new(JDBCColumnSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JDBCTableSpec.
+func (in *JDBCTableSpec) DeepCopy() *JDBCTableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JDBCTableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStoreSpec) DeepCopyInto(out *ObjectStoreSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStoreSpec.
+func (in *ObjectStoreSpec) DeepCopy() *ObjectStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Restore) DeepCopyInto(out *Restore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Restore.
+func (in *Restore) DeepCopy() *Restore {
+	if in == nil {
+		return nil
+	}
+	out := new(Restore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Restore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreList) DeepCopyInto(out *RestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Restore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreList.
+func (in *RestoreList) DeepCopy() *RestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreObjectStoreSpec) DeepCopyInto(out *RestoreObjectStoreSpec) {
+	*out = *in
+	out.ObjectStoreSpec = in.ObjectStoreSpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreObjectStoreSpec.
+func (in *RestoreObjectStoreSpec) DeepCopy() *RestoreObjectStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreObjectStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreResources) DeepCopyInto(out *RestoreResources) {
+	*out = *in
+	if in.Caches != nil {
+		in, out := &in.Caches, &out.Caches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Counters != nil {
+		in, out := &in.Counters, &out.Counters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProtoSchemas != nil {
+		in, out := &in.ProtoSchemas, &out.ProtoSchemas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CacheConfigs != nil {
+		in, out := &in.CacheConfigs, &out.CacheConfigs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scripts != nil {
+		in, out := &in.Scripts, &out.Scripts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreResources.
+func (in *RestoreResources) DeepCopy() *RestoreResources {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
+	*out = *in
+	if in.ObjectStore != nil {
+		in, out := &in.ObjectStore, &out.ObjectStore
+		*out = new(RestoreObjectStoreSpec)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(RestoreResources)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Container = in.Container
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
+func (in *RestoreSpec) DeepCopy() *RestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
+func (in *RestoreStatus) DeepCopy() *RestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Role) DeepCopyInto(out *Role) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
+func (in *Role) DeepCopy() *Role {
+	if in == nil {
+		return nil
+	}
+	out := new(Role)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Role) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleCondition) DeepCopyInto(out *RoleCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleCondition.
+func (in *RoleCondition) DeepCopy() *RoleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleList) DeepCopyInto(out *RoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Role, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleList.
+func (in *RoleList) DeepCopy() *RoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]RolePermission, len(*in))
+		copy(*out, *in)
+	}
+	if in.Caches != nil {
+		in, out := &in.Caches, &out.Caches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
+func (in *RoleSpec) DeepCopy() *RoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]RoleCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]RolePermission, len(*in))
+		copy(*out, *in)
+	}
+	if in.Caches != nil {
+		in, out := &in.Caches, &out.Caches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
+func (in *RoleStatus) DeepCopy() *RoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLQueriesSpec) DeepCopyInto(out *SQLQueriesSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLQueriesSpec.
+func (in *SQLQueriesSpec) DeepCopy() *SQLQueriesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLQueriesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLStoreSpec) DeepCopyInto(out *SQLStoreSpec) {
+	*out = *in
+	out.CacheStoreSpec = in.CacheStoreSpec
+	out.Connection = in.Connection
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = new(SQLQueriesSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLStoreSpec.
+func (in *SQLStoreSpec) DeepCopy() *SQLStoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLStoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schema) DeepCopyInto(out *Schema) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schema.
+func (in *Schema) DeepCopy() *Schema {
+	if in == nil {
+		return nil
+	}
+	out := new(Schema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Schema) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaCondition) DeepCopyInto(out *SchemaCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaCondition.
+func (in *SchemaCondition) DeepCopy() *SchemaCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaList) DeepCopyInto(out *SchemaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Schema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out.Container = in.Container
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
-func (in *RestoreSpec) DeepCopy() *RestoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaList.
+func (in *SchemaList) DeepCopy() *SchemaList {
 	if in == nil {
 		return nil
 	}
-	out := new(RestoreSpec)
+	out := new(SchemaList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchemaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
+func (in *SchemaSpec) DeepCopyInto(out *SchemaSpec) {
 	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
-func (in *RestoreStatus) DeepCopy() *RestoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaSpec.
+func (in *SchemaSpec) DeepCopy() *SchemaSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RestoreStatus)
+	out := new(SchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaStatus) DeepCopyInto(out *SchemaStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]SchemaCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValidationError != nil {
+		in, out := &in.ValidationError, &out.ValidationError
+		*out = new(SchemaValidationError)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaStatus.
+func (in *SchemaStatus) DeepCopy() *SchemaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaValidationError) DeepCopyInto(out *SchemaValidationError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaValidationError.
+func (in *SchemaValidationError) DeepCopy() *SchemaValidationError {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaValidationError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *User) DeepCopyInto(out *User) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
+func (in *User) DeepCopy() *User {
+	if in == nil {
+		return nil
+	}
+	out := new(User)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *User) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserCondition) DeepCopyInto(out *UserCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserCondition.
+func (in *UserCondition) DeepCopy() *UserCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(UserCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserList) DeepCopyInto(out *UserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]User, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
+func (in *UserList) DeepCopy() *UserList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserSpec) DeepCopyInto(out *UserSpec) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
+func (in *UserSpec) DeepCopy() *UserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserStatus) DeepCopyInto(out *UserStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]UserCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
+func (in *UserStatus) DeepCopy() *UserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XSiteCacheResult) DeepCopyInto(out *XSiteCacheResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XSiteCacheResult.
+func (in *XSiteCacheResult) DeepCopy() *XSiteCacheResult {
+	if in == nil {
+		return nil
+	}
+	out := new(XSiteCacheResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XSiteOperation) DeepCopyInto(out *XSiteOperation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XSiteOperation.
+func (in *XSiteOperation) DeepCopy() *XSiteOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(XSiteOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *XSiteOperation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XSiteOperationList) DeepCopyInto(out *XSiteOperationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]XSiteOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XSiteOperationList.
+func (in *XSiteOperationList) DeepCopy() *XSiteOperationList {
+	if in == nil {
+		return nil
+	}
+	out := new(XSiteOperationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *XSiteOperationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XSiteOperationSpec) DeepCopyInto(out *XSiteOperationSpec) {
+	*out = *in
+	if in.Caches != nil {
+		in, out := &in.Caches, &out.Caches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TakeOffline != nil {
+		in, out := &in.TakeOffline, &out.TakeOffline
+		*out = new(XSiteTakeOfflineSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XSiteOperationSpec.
+func (in *XSiteOperationSpec) DeepCopy() *XSiteOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(XSiteOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XSiteOperationStatus) DeepCopyInto(out *XSiteOperationStatus) {
+	*out = *in
+	if in.ClusterUID != nil {
+		in, out := &in.ClusterUID, &out.ClusterUID
+		*out = new(types.UID)
+		**out = **in
+	}
+	if in.Caches != nil {
+		in, out := &in.Caches, &out.Caches
+		*out = make([]XSiteCacheResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XSiteOperationStatus.
+func (in *XSiteOperationStatus) DeepCopy() *XSiteOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(XSiteOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XSiteTakeOfflineSpec) DeepCopyInto(out *XSiteTakeOfflineSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XSiteTakeOfflineSpec.
+func (in *XSiteTakeOfflineSpec) DeepCopy() *XSiteTakeOfflineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(XSiteTakeOfflineSpec)
 	in.DeepCopyInto(out)
 	return out
 }
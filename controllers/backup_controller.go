@@ -7,6 +7,7 @@ import (
 	"github.com/infinispan/infinispan-operator/api/v2alpha1"
 	"github.com/infinispan/infinispan-operator/controllers/constants"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -19,7 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-// +kubebuilder:rbac:groups=infinispan.org,namespace=infinispan-operator-system,resources=backups;backups/status;backups/finalizers,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=infinispan.org,namespace=infinispan-operator-system,resources=backups;backups/status;backups/finalizers,verbs=get;list;watch;create;update;patch;delete
 
 const (
 	BackupDataMountPath = "/opt/infinispan/backups"
@@ -34,6 +35,7 @@ type backupResource struct {
 	instance *v2alpha1.Backup
 	client   client.Client
 	scheme   *runtime.Scheme
+	kube     *kube.Kubernetes
 	ctx      context.Context
 }
 
@@ -52,6 +54,7 @@ func (r *BackupReconciler) ResourceInstance(ctx context.Context, key types.Names
 		instance: instance,
 		client:   r.Client,
 		scheme:   ctrl.Scheme,
+		kube:     ctrl.Kube,
 		ctx:      ctx,
 	}, nil
 }
@@ -98,6 +101,10 @@ func (r *backupResource) update(mutate func()) (bool, error) {
 }
 
 func (r *backupResource) Init() (*zeroCapacitySpec, error) {
+	if r.instance.Spec.Volume.ObjectStore != nil {
+		return r.objectStoreSpec()
+	}
+
 	err := r.getOrCreatePvc()
 	if err != nil {
 		return nil, err
@@ -120,6 +127,29 @@ func (r *backupResource) Init() (*zeroCapacitySpec, error) {
 	}, nil
 }
 
+// objectStoreSpec returns a zeroCapacitySpec that writes the backup archive to an ephemeral volume, as the archive is
+// uploaded to the object store once the backup has completed
+func (r *backupResource) objectStoreSpec() (*zeroCapacitySpec, error) {
+	emptyDir := &corev1.EmptyDirVolumeSource{}
+	if storage := r.instance.Spec.Volume.Storage; storage != nil {
+		sizeLimit, err := resource.ParseQuantity(*storage)
+		if err != nil {
+			return nil, err
+		}
+		emptyDir.SizeLimit = &sizeLimit
+	}
+	return &zeroCapacitySpec{
+		Volume: zeroCapacityVolumeSpec{
+			MountPath: BackupDataMountPath,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: emptyDir,
+			},
+		},
+		Container: r.instance.Spec.Container,
+		PodLabels: map[string]string{"backup_cr": r.instance.Name},
+	}, nil
+}
+
 func (r *backupResource) getOrCreatePvc() error {
 	pvc := &corev1.PersistentVolumeClaim{}
 	err := r.client.Get(r.ctx, types.NamespacedName{
@@ -213,5 +243,29 @@ func (r *backupResource) ExecStatus(client api.Infinispan) (zeroCapacityPhase, e
 	if err != nil {
 		return ZeroUnknown, err
 	}
+
+	objectStore := r.instance.Spec.Volume.ObjectStore
+	if status == api.StatusSucceeded && objectStore != nil && r.instance.Status.ObjectKey == "" {
+		if err := r.upload(objectStore); err != nil {
+			return ZeroFailed, err
+		}
+	}
 	return zeroCapacityPhase(status), nil
 }
+
+func (r *backupResource) upload(objectStore *v2alpha1.ObjectStoreSpec) error {
+	backup := r.instance
+	store, err := newObjectStoreClient(r.ctx, r.kube, objectStore, backup.Namespace)
+	if err != nil {
+		return err
+	}
+
+	key := objectStoreKey(objectStore, backup.Name)
+	if err := uploadArchive(r.kube, store, backup.Name, backup.Namespace, archivePath(backup.Name), key); err != nil {
+		return err
+	}
+	_, err = r.update(func() {
+		backup.Status.ObjectKey = key
+	})
+	return err
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	"github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan/handler/provision"
+	"github.com/infinispan/infinispan-operator/pkg/s3"
+	"k8s.io/client-go/util/exec"
+)
+
+// objectStoreURLExpiry is the time that a presigned object store URL is valid for. It only needs to cover the start
+// of the transfer, as an in-flight request is not interrupted once the URL expires
+const objectStoreURLExpiry = 15 * time.Minute
+
+// archivePath returns the location of the archive created by the server for the backup or restore with the given name
+func archivePath(name string) string {
+	return fmt.Sprintf("%[1]s/%[2]s/%[2]s.zip", BackupDataMountPath, name)
+}
+
+// objectStoreKey returns the key of the archive of the named Backup in the object store
+func objectStoreKey(spec *v2alpha1.ObjectStoreSpec, name string) string {
+	return path.Join(spec.Prefix, name+".zip")
+}
+
+func newObjectStoreClient(ctx context.Context, k *kube.Kubernetes, spec *v2alpha1.ObjectStoreSpec, namespace string) (*s3.Client, error) {
+	secret, err := k.GetSecret(spec.CredentialsSecret, namespace, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load object store credentials secret '%s': %w", spec.CredentialsSecret, err)
+	}
+	credentials := s3.Credentials{
+		AccessKeyID:     string(secret.Data[constants.ObjectStoreAccessKeyID]),
+		SecretAccessKey: string(secret.Data[constants.ObjectStoreSecretAccessKey]),
+	}
+	if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("object store credentials secret '%s' must contain the keys '%s' and '%s'", spec.CredentialsSecret,
+			constants.ObjectStoreAccessKeyID, constants.ObjectStoreSecretAccessKey)
+	}
+	return s3.New(s3.Config{
+		Credentials: credentials,
+		Endpoint:    spec.Endpoint,
+		Region:      spec.Region,
+		Bucket:      spec.Bucket,
+	})
+}
+
+// uploadArchive uploads the file on the zero-capacity pod to the object store. The transfer is executed by the pod with
+// curl and a presigned URL, so that the archive is not streamed through the operator and the object store only has to
+// be reachable from the cluster network. The server image must therefore provide curl.
+func uploadArchive(k *kube.Kubernetes, store *s3.Client, pod, namespace, file, key string) error {
+	url, err := store.PresignPut(key, objectStoreURLExpiry)
+	if err != nil {
+		return err
+	}
+	if err := execCurl(k, pod, namespace, "--upload-file", file, url); err != nil {
+		return fmt.Errorf("unable to upload backup archive to object store key '%s': %w", key, err)
+	}
+	return nil
+}
+
+// downloadArchive downloads the object from the object store to the file on the zero-capacity pod
+func downloadArchive(k *kube.Kubernetes, store *s3.Client, pod, namespace, file, key string) error {
+	url, err := store.PresignGet(key, objectStoreURLExpiry)
+	if err != nil {
+		return err
+	}
+	if err := execCurl(k, pod, namespace, "--create-dirs", "--output", file, url); err != nil {
+		return fmt.Errorf("unable to download backup archive from object store key '%s': %w", key, err)
+	}
+	return nil
+}
+
+func execCurl(k *kube.Kubernetes, pod, namespace string, args ...string) error {
+	_, err := k.ExecWithOptions(kube.ExecOptions{
+		Container: provision.InfinispanContainer,
+		Command:   append([]string{"curl", "--fail", "--silent", "--show-error"}, args...),
+		Namespace: namespace,
+		PodName:   pod,
+	})
+	if isExecutableNotFound(err) {
+		return fmt.Errorf("curl is not available in the image of pod '%s', it is required to transfer backup archives to and from object storage: %w", pod, err)
+	}
+	return err
+}
+
+// isExecutableNotFound returns true if the command executed in a container does not exist, in which case the shell
+// exit code 127 is returned, or the container runtime fails to start the process
+func isExecutableNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	var exitErr exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitStatus() == 127 {
+		return true
+	}
+	return strings.Contains(err.Error(), "executable file not found")
+}
@@ -0,0 +1,326 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/cron"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// BackupScheduleReconciler reconciles a BackupSchedule object
+type BackupScheduleReconciler struct {
+	client.Client
+	log        logr.Logger
+	scheme     *runtime.Scheme
+	kubernetes *kube.Kubernetes
+	eventRec   record.EventRecorder
+}
+
+type backupScheduleRequest struct {
+	*BackupScheduleReconciler
+	ctx       context.Context
+	schedule  *v2alpha1.BackupSchedule
+	reqLogger logr.Logger
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BackupScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Client = mgr.GetClient()
+	r.log = ctrl.Log.WithName("controllers").WithName("BackupSchedule")
+	r.scheme = mgr.GetScheme()
+	r.kubernetes = kube.NewKubernetesFromController(mgr)
+	r.eventRec = mgr.GetEventRecorderFor("backupschedule-controller")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v2alpha1.BackupSchedule{}).
+		Owns(&v2alpha1.Backup{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=infinispan.org,namespace=infinispan-operator-system,resources=backupschedules;backupschedules/status;backupschedules/finalizers,verbs=get;list;watch;create;update;patch
+
+func (r *BackupScheduleReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("+++++ Reconciling BackupSchedule.")
+	defer reqLogger.Info("----- End Reconciling BackupSchedule.")
+
+	// Fetch the BackupSchedule instance
+	instance := &v2alpha1.BackupSchedule{}
+	if err := r.Client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Info("BackupSchedule resource not found. Ignoring it since it has been deleted")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if instance.GetDeletionTimestamp() != nil {
+		// Child Backup CRs are removed by the garbage collector
+		return ctrl.Result{}, nil
+	}
+
+	schedule := &backupScheduleRequest{
+		BackupScheduleReconciler: r,
+		ctx:                      ctx,
+		schedule:                 instance,
+		reqLogger:                reqLogger,
+	}
+
+	cronSchedule, err := cron.Parse(instance.Spec.Schedule)
+	if err != nil {
+		// The schedule is validated by the webhook, so this should only happen if webhooks are disabled
+		reqLogger.Error(err, "Unable to parse schedule")
+		r.eventRec.Event(instance, corev1.EventTypeWarning, "InvalidSchedule", err.Error())
+		return ctrl.Result{}, nil
+	}
+
+	backups, err := schedule.backups()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := schedule.removeExpired(backups); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := time.Now().UTC()
+	lastScheduled := instance.CreationTimestamp.UTC()
+	if instance.Status.LastScheduleTime != nil {
+		lastScheduled = instance.Status.LastScheduleTime.UTC()
+	}
+
+	next := cronSchedule.Next(lastScheduled)
+	var scheduled *time.Time
+	if !next.IsZero() && !next.After(now) {
+		// Missed runs are coalesced, so only the most recent missed run is executed
+		for n := cronSchedule.Next(next); !n.IsZero() && !n.After(now); n = cronSchedule.Next(n) {
+			next = n
+		}
+		scheduled = &next
+	}
+
+	var requeueAfter time.Duration
+	switch {
+	case scheduled == nil:
+		// Nothing to do until the next scheduled run
+	case instance.Spec.Suspend:
+		reqLogger.Info("BackupSchedule suspended, skipping scheduled backup", "time", scheduled)
+		scheduled = nil
+	default:
+		active, err := schedule.activeBackupOnCluster()
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if active != "" {
+			// Backups must not overlap on the same cluster, so wait for the active backup to complete before creating
+			// the scheduled backup
+			reqLogger.Info("Backup already in progress on cluster, delaying scheduled backup", "active", active)
+			scheduled = nil
+			requeueAfter = constants.DefaultWaitOnCluster
+		} else if err := schedule.createBackup(*scheduled); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := schedule.updateStatus(scheduled); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if requeueAfter == 0 {
+		if next = cronSchedule.Next(now); next.IsZero() {
+			reqLogger.Info("BackupSchedule has no future runs")
+			return ctrl.Result{}, nil
+		}
+		requeueAfter = next.Sub(now)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// backups returns all Backup CRs created by the BackupSchedule, ordered from the most recent to the oldest
+func (r *backupScheduleRequest) backups() ([]v2alpha1.Backup, error) {
+	backupList := &v2alpha1.BackupList{}
+	labels := map[string]string{constants.BackupScheduleLabel: r.schedule.Name}
+	if err := r.kubernetes.ResourcesList(r.schedule.Namespace, labels, backupList, r.ctx); err != nil {
+		return nil, fmt.Errorf("unable to list Backup CRs: %w", err)
+	}
+
+	var backups []v2alpha1.Backup
+	for _, b := range backupList.Items {
+		if metav1.IsControlledBy(&b, r.schedule) && b.DeletionTimestamp.IsZero() {
+			backups = append(backups, b)
+		}
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return scheduledTime(&backups[j]).Before(scheduledTime(&backups[i]))
+	})
+	return backups, nil
+}
+
+// removeExpired deletes completed Backup CRs that fall outside of the retention policy. The PersistentVolumeClaim of each
+// Backup is owned by the Backup CR, so it's removed by the garbage collector.
+func (r *backupScheduleRequest) removeExpired(backups []v2alpha1.Backup) error {
+	retention := r.schedule.Spec.Retention
+	if retention == nil {
+		return nil
+	}
+
+	now := time.Now()
+	var succeeded, failed int32
+	for i := range backups {
+		backup := &backups[i]
+		var count int32
+		switch backup.Status.Phase {
+		case v2alpha1.BackupSucceeded:
+			succeeded++
+			count = succeeded
+			// Always retain the most recent successful backup, so that it's possible to restore the cluster
+			if succeeded == 1 {
+				continue
+			}
+		case v2alpha1.BackupFailed:
+			failed++
+			count = failed
+		default:
+			continue
+		}
+
+		expired := retention.KeepLast != nil && count > *retention.KeepLast
+		expired = expired || (retention.MaxAge != nil && now.Sub(scheduledTime(backup)) > retention.MaxAge.Duration)
+		if !expired {
+			continue
+		}
+
+		r.reqLogger.Info("Removing expired Backup", "backup", backup.Name)
+		if err := r.Client.Delete(r.ctx, backup, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to remove expired Backup '%s': %w", backup.Name, err)
+		}
+	}
+	return nil
+}
+
+// activeBackupOnCluster returns the name of a Backup CR targeting the same cluster that has not yet completed, if any
+func (r *backupScheduleRequest) activeBackupOnCluster() (string, error) {
+	backupList := &v2alpha1.BackupList{}
+	if err := r.Client.List(r.ctx, backupList, client.InNamespace(r.schedule.Namespace)); err != nil {
+		return "", fmt.Errorf("unable to list Backup CRs: %w", err)
+	}
+
+	for _, b := range backupList.Items {
+		if b.Spec.Cluster == r.schedule.Spec.Template.Cluster && !backupCompleted(&b) {
+			return b.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func (r *backupScheduleRequest) createBackup(scheduled time.Time) error {
+	labels := map[string]string{}
+	for k, v := range r.schedule.Labels {
+		labels[k] = v
+	}
+	labels[constants.BackupScheduleLabel] = r.schedule.Name
+
+	backup := &v2alpha1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", r.schedule.Name, scheduled.Unix()),
+			Namespace: r.schedule.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				constants.BackupScheduleAnnotationTime: scheduled.Format(time.RFC3339),
+			},
+		},
+		Spec: *r.schedule.Spec.Template.DeepCopy(),
+	}
+	if err := controllerutil.SetControllerReference(r.schedule, backup, r.scheme); err != nil {
+		return err
+	}
+
+	r.reqLogger.Info("Creating scheduled Backup", "backup", backup.Name)
+	if err := r.Client.Create(r.ctx, backup); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to create Backup '%s': %w", backup.Name, err)
+	}
+	r.eventRec.Event(r.schedule, corev1.EventTypeNormal, "BackupCreated", fmt.Sprintf("Created Backup '%s'", backup.Name))
+	return nil
+}
+
+func (r *backupScheduleRequest) updateStatus(scheduled *time.Time) error {
+	backups, err := r.backups()
+	if err != nil {
+		return err
+	}
+
+	schedule := r.schedule
+	_, err = controllerutil.CreateOrPatch(r.ctx, r.Client, schedule, func() error {
+		if schedule.CreationTimestamp.IsZero() {
+			return errors.NewNotFound(schema.ParseGroupResource("backupschedule.infinispan.org"), schedule.Name)
+		}
+		status := &schedule.Status
+		if scheduled != nil {
+			status.LastScheduleTime = &metav1.Time{Time: *scheduled}
+		}
+
+		status.Active = ""
+		status.LastSuccessful = nil
+		status.LastFailed = nil
+		// Backups are ordered from the most recent to the oldest
+		for i := range backups {
+			backup := &backups[i]
+			run := &v2alpha1.BackupScheduleRun{
+				Name: backup.Name,
+				Time: metav1.Time{Time: scheduledTime(backup)},
+			}
+			switch {
+			case !backupCompleted(backup):
+				if status.Active == "" {
+					status.Active = backup.Name
+				}
+			case backup.Status.Phase == v2alpha1.BackupSucceeded:
+				if status.LastSuccessful == nil {
+					status.LastSuccessful = run
+				}
+			default:
+				if status.LastFailed == nil {
+					run.Reason = backup.Status.Reason
+					status.LastFailed = run
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update BackupSchedule status: %w", err)
+	}
+	return nil
+}
+
+func backupCompleted(b *v2alpha1.Backup) bool {
+	return b.Status.Phase == v2alpha1.BackupSucceeded || b.Status.Phase == v2alpha1.BackupFailed
+}
+
+// scheduledTime returns the time that the Backup was scheduled, falling back to its creation time
+func scheduledTime(b *v2alpha1.Backup) time.Time {
+	if val, exists := b.Annotations[constants.BackupScheduleAnnotationTime]; exists {
+		if t, err := time.Parse(time.RFC3339, val); err == nil {
+			return t
+		}
+	}
+	return b.CreationTimestamp.Time
+}
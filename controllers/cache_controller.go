@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -17,15 +18,19 @@ import (
 	"github.com/infinispan/infinispan-operator/api/v2alpha1"
 	"github.com/infinispan/infinispan-operator/controllers/constants"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/configuration/container"
+	users "github.com/infinispan/infinispan-operator/pkg/infinispan/security"
 	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
 	"github.com/infinispan/infinispan-operator/pkg/mime"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -55,11 +60,23 @@ type CacheListener struct {
 
 type cacheRequest struct {
 	*CacheReconciler
-	ctx        context.Context
-	cache      *v2alpha1.Cache
-	infinispan *v1.Infinispan
-	ispnClient api.Infinispan
-	reqLogger  logr.Logger
+	ctx              context.Context
+	cache            *v2alpha1.Cache
+	infinispan       *v1.Infinispan
+	ispnClient       api.Infinispan
+	reqLogger        logr.Logger
+	storeCredentials *storeCredentials
+	// authorizationRoles the roles of the cache scoped Role CRs that apply to the cache
+	authorizationRoles []string
+	// rolesApplied is true if the authorizationRoles have been applied to the cache configuration
+	rolesApplied bool
+}
+
+// storeCredentials the database credentials of a Cache CR store. The password is referenced by the store's connection
+// pool from the server credential store, to which it is added by the Infinispan controller
+type storeCredentials struct {
+	username string
+	alias    string
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -81,6 +98,23 @@ func (r *CacheReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager
 		return
 	}
 
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &v2alpha1.Cache{}, "spec.dependencies.schemas", func(obj client.Object) []string {
+		return obj.(*v2alpha1.Cache).SchemaDependencies()
+	}); err != nil {
+		return
+	}
+
+	// Add the Secret name of Cache CR store credentials to the index, so that the cache and the credential store of its
+	// cluster are updated on change
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &v2alpha1.Cache{}, "spec.persistence.connection.credentialsSecret", func(obj client.Object) []string {
+		if connection := obj.(*v2alpha1.Cache).PersistenceConnection(); connection != nil {
+			return []string{connection.CredentialsSecret}
+		}
+		return nil
+	}); err != nil {
+		return
+	}
+
 	builder := ctrl.NewControllerManagedBy(mgr).For(&v2alpha1.Cache{})
 	builder.Watches(
 		&source.Kind{Type: &v1.Infinispan{}},
@@ -104,6 +138,63 @@ func (r *CacheReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager
 				return requests
 			}),
 	)
+	builder.Watches(
+		&source.Kind{Type: &v2alpha1.Schema{}},
+		handler.EnqueueRequestsFromMapFunc(
+			func(a client.Object) []reconcile.Request {
+				// Only enqueue dependent caches once the Schema is Ready
+				if !a.(*v2alpha1.Schema).IsReady() {
+					return nil
+				}
+
+				var requests []reconcile.Request
+				cacheList := &v2alpha1.CacheList{}
+				if err := r.kubernetes.ResourcesListByField(a.GetNamespace(), "spec.dependencies.schemas", a.GetName(), cacheList, ctx); err != nil {
+					r.log.Error(err, "watches failed to list Cache CRs")
+				}
+
+				for _, item := range cacheList.Items {
+					requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+				}
+				return requests
+			}),
+	)
+	builder.Watches(
+		&source.Kind{Type: &v2alpha1.Role{}},
+		handler.EnqueueRequestsFromMapFunc(
+			func(a client.Object) []reconcile.Request {
+				role := a.(*v2alpha1.Role)
+				var requests []reconcile.Request
+				cacheList := &v2alpha1.CacheList{}
+				if err := r.kubernetes.ResourcesListByField(a.GetNamespace(), "spec.clusterName", role.Spec.ClusterName, cacheList, ctx); err != nil {
+					r.log.Error(err, "watches failed to list Cache CRs")
+				}
+
+				// Enqueue the caches that the role is scoped to, or was previously applied to
+				for _, item := range cacheList.Items {
+					if contains(role.GetScopedCaches(), item.GetCacheName()) || contains(item.Status.AuthorizationRoles, role.GetRoleName()) {
+						requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+					}
+				}
+				return requests
+			}),
+	)
+	builder.Watches(
+		&source.Kind{Type: &corev1.Secret{}},
+		handler.EnqueueRequestsFromMapFunc(
+			func(a client.Object) []reconcile.Request {
+				var requests []reconcile.Request
+				cacheList := &v2alpha1.CacheList{}
+				if err := r.kubernetes.ResourcesListByField(a.GetNamespace(), "spec.persistence.connection.credentialsSecret", a.GetName(), cacheList, ctx); err != nil {
+					r.log.Error(err, "watches failed to list Cache CRs")
+				}
+
+				for _, item := range cacheList.Items {
+					requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+				}
+				return requests
+			}),
+	)
 	return builder.Complete(r)
 }
 
@@ -206,17 +297,34 @@ func (r *CacheReconciler) Reconcile(ctx context.Context, request ctrl.Request) (
 	if crDeleted {
 		if controllerutil.ContainsFinalizer(instance, constants.InfinispanFinalizer) {
 			// Remove Deleted caches from the server before removing the Finalizer
-			cacheName := instance.GetCacheName()
+			cacheName := instance.GetServerCacheName()
 			if err := ispnClient.Cache(cacheName).Delete(); err != nil {
 				return ctrl.Result{}, err
 			}
+			// Remove the target of an incomplete migration so that it is not orphaned on the server
+			if instance.IsMigrating() {
+				if err := ispnClient.Cache(instance.Status.Migration.TargetCache).Delete(); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
 			return ctrl.Result{}, cache.removeFinalizer()
 		}
 		return ctrl.Result{}, nil
 	}
 
-	// Don't contact the Infinispan server for resources created by the ConfigListener
-	if cache.reconcileOnServer() {
+	if cache.authorizationRoles, err = cache.scopedRoles(); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Don't contact the Infinispan server for resources created by the ConfigListener, unless the roles of cache scoped
+	// Role CRs have changed
+	reconcileOnServer := cache.reconcileOnServer() || !reflect.DeepEqual(cache.authorizationRoles, instance.Status.AuthorizationRoles)
+	if reconcileOnServer {
+		if ready, err := cache.dependenciesReady(); err != nil || !ready {
+			// No need to requeue request here as the Schema watch ensures that a request is queued when a dependency is Ready
+			return ctrl.Result{}, err
+		}
+
 		if result, err := cache.ispnCreateOrUpdate(); result != nil {
 			if err != nil {
 				return *result, cache.update(func() error {
@@ -230,6 +338,14 @@ func (r *CacheReconciler) Reconcile(ctx context.Context, request ctrl.Request) (
 
 	err = cache.update(func() error {
 		instance.SetCondition(v2alpha1.CacheConditionReady, metav1.ConditionTrue, "")
+		instance.Status.ObservedGeneration = instance.GetGeneration()
+		if reconcileOnServer {
+			if cache.rolesApplied {
+				instance.Status.AuthorizationRoles = cache.authorizationRoles
+			} else {
+				instance.Status.AuthorizationRoles = nil
+			}
+		}
 		// Add finalizer so that the Cache is removed on the server when the Cache CR is deleted
 		if !controllerutil.ContainsFinalizer(instance, constants.InfinispanFinalizer) {
 			controllerutil.AddFinalizer(instance, constants.InfinispanFinalizer)
@@ -239,6 +355,48 @@ func (r *CacheReconciler) Reconcile(ctx context.Context, request ctrl.Request) (
 	return ctrl.Result{}, err
 }
 
+// dependenciesReady returns true if all Schema CRs that the cache depends on are Ready. If a dependency is not Ready, the
+// Cache CR Ready condition is set to false with a message detailing the outstanding dependency
+func (r *cacheRequest) dependenciesReady() (bool, error) {
+	for _, name := range r.cache.SchemaDependencies() {
+		schema := &v2alpha1.Schema{}
+		if err := r.Client.Get(r.ctx, types.NamespacedName{Namespace: r.cache.Namespace, Name: name}, schema); client.IgnoreNotFound(err) != nil {
+			return false, err
+		}
+
+		if !schema.IsReady() || schema.Spec.ClusterName != r.cache.Spec.ClusterName {
+			msg := fmt.Sprintf("waiting for Schema '%s' to be Ready", name)
+			r.reqLogger.Info(msg)
+			return false, r.update(func() error {
+				r.cache.SetCondition(v2alpha1.CacheConditionReady, metav1.ConditionFalse, msg)
+				return nil
+			})
+		}
+	}
+	return true, nil
+}
+
+// scopedRoles returns the sorted names of the cache scoped Role CRs that apply to the cache. Only roles that have been
+// created on the server are returned, as the server rejects cache configurations that reference an undefined role
+func (r *cacheRequest) scopedRoles() ([]string, error) {
+	if !r.infinispan.IsAuthorizationEnabled() {
+		return nil, nil
+	}
+
+	roleList := &v2alpha1.RoleList{}
+	if err := r.kubernetes.ResourcesListByField(r.cache.Namespace, "spec.clusterName", r.cache.Spec.ClusterName, roleList, r.ctx); err != nil {
+		return nil, fmt.Errorf("unable to list Role CRs: %w", err)
+	}
+
+	var roles []string
+	for _, role := range roleList.Items {
+		if role.GetDeletionTimestamp().IsZero() && len(role.Status.Permissions) > 0 && contains(role.GetScopedCaches(), r.cache.GetCacheName()) {
+			roles = append(roles, role.GetRoleName())
+		}
+	}
+	return sortedCopy(roles), nil
+}
+
 func (r *cacheRequest) update(mutate func() error) error {
 	return updateCache(r.cache, r.ctx, r.Client, mutate)
 }
@@ -268,7 +426,7 @@ func (r *cacheRequest) removeFinalizer() error {
 }
 
 func (r *cacheRequest) ispnCreateOrUpdate() (*ctrl.Result, error) {
-	cacheName := r.cache.GetCacheName()
+	cacheName := r.cache.GetServerCacheName()
 	cacheClient := r.ispnClient.Cache(cacheName)
 
 	cacheExists, err := cacheClient.Exists()
@@ -288,21 +446,50 @@ func (r *cacheRequest) ispnCreateOrUpdate() (*ctrl.Result, error) {
 func (r *cacheRequest) reconcileDataGrid(cacheExists bool, cache api.Cache) error {
 	spec := r.cache.Spec
 
-	if spec.Template == "" && spec.TemplateName == "" {
-		return fmt.Errorf("unable to reconcile Cache as both 'spec.template' and 'spec.templateName' are undefined")
+	if spec.Template == "" && spec.TemplateName == "" && spec.Configuration == nil {
+		return fmt.Errorf("unable to reconcile Cache as 'spec.template', 'spec.templateName' and 'spec.configuration' are undefined")
+	}
+
+	// Caches created from a server template have no configuration to reconcile
+	serverTemplate := spec.Template == "" && spec.Configuration == nil
+	var config string
+	var markup mime.MimeType
+	if !serverTemplate {
+		if err := r.loadStoreCredentials(); err != nil {
+			return err
+		}
+		var err error
+		if config, markup, err = r.cacheConfig(); err != nil {
+			return err
+		}
+	}
+
+	// An interrupted migration must complete before any other updates are applied
+	if r.cache.IsMigrating() {
+		return r.migrate(config, markup)
 	}
 
 	if cacheExists {
-		if spec.Template == "" {
+		if serverTemplate {
 			return nil
 		}
 
+		// A migrated cache is accessed via its alias, which must be retained when the configuration is updated
+		updateConfig, updateMarkup := config, markup
+		if r.cache.GetServerCacheName() != r.cache.GetCacheName() {
+			var err error
+			if updateConfig, err = r.aliasedConfig(config, markup); err != nil {
+				return err
+			}
+			updateMarkup = mime.ApplicationJson
+		}
+
 		serverConfig, err := cache.Config(mime.ApplicationJson)
 		if err != nil {
 			return fmt.Errorf("unable to retrieve existing cache configuration: %w", err)
 		}
 
-		configUpdated, err := configChanged(serverConfig, spec.Template, r.infinispan, r.ispnClient.Caches(), r.versionManager)
+		configUpdated, err := configChanged(serverConfig, updateConfig, r.infinispan, r.ispnClient.Caches(), r.versionManager)
 		if err != nil {
 			return fmt.Errorf("unable to determine if configuration has changed: %w", err)
 		}
@@ -313,15 +500,24 @@ func (r *cacheRequest) reconcileDataGrid(cacheExists bool, cache api.Cache) erro
 
 		if spec.Updates.Strategy == v2alpha1.CacheUpdateRetain {
 			// Only update the cache if possible at runtime, otherwise set Ready=false.
-			if err := cache.UpdateConfig(spec.Template, mime.GuessMarkup(spec.Template)); err != nil {
+			if err := cache.UpdateConfig(updateConfig, updateMarkup); err != nil {
 				return fmt.Errorf("unable to update cache template at runtime: %w", err)
 			}
 			return nil
 		}
 
+		if spec.Updates.Strategy == v2alpha1.CacheUpdateMigrate {
+			// Update the cache configuration at runtime if possible, otherwise migrate the data to a new cache
+			if err := cache.UpdateConfig(updateConfig, updateMarkup); err != nil {
+				r.log.Info("unable to update cache template at runtime, migrating", "error", err)
+				return r.migrate(config, markup)
+			}
+			return nil
+		}
+
 		// Recreate strategy
 		// Update the cache configuration at runtime if possible, retaining data, otherwise delete
-		if err := cache.UpdateConfig(spec.Template, mime.GuessMarkup(spec.Template)); err != nil {
+		if err := cache.UpdateConfig(updateConfig, updateMarkup); err != nil {
 			r.log.Info("unable to update cache template at runtime, recreating", "error", err)
 
 			// Add an annotation to indicate to the ConfigListener that a remote-cache event should be expected for this CR
@@ -337,25 +533,52 @@ func (r *cacheRequest) reconcileDataGrid(cacheExists bool, cache api.Cache) erro
 				return fmt.Errorf("unable to add annotation on update '%s': %w", constants.ListenerControllerDelete, err)
 			}
 
-			cacheName := r.cache.GetCacheName()
+			cacheName := r.cache.GetServerCacheName()
 			if err := cache.Delete(); err != nil {
 				return fmt.Errorf("unable to delete existing cache '%s': %w", cacheName, err)
 			}
 
-			if err := cache.Create(spec.Template, mime.GuessMarkup(spec.Template)); err != nil {
+			if err := cache.Create(updateConfig, updateMarkup); err != nil {
 				return fmt.Errorf("unable to create cache '%s': %w", cacheName, err)
 			}
 		}
 		return nil
 	}
 
+	// Caches with the migrate strategy are created with a generated name and accessed via an alias, so that the alias
+	// can be moved to a migration target without interrupting clients
+	if !serverTemplate && spec.Updates.Strategy == v2alpha1.CacheUpdateMigrate && r.cache.Status.ServerCacheName == "" {
+		if err := r.migrationSupported(); err != nil {
+			return err
+		}
+		serverCacheName := fmt.Sprintf("%s-%d", r.cache.GetCacheName(), r.cache.GetGeneration())
+		// The server cache name must be recorded before the cache is created, so that the ConfigListener associates the
+		// cache with this CR
+		err := r.update(func() error {
+			r.cache.Status.ServerCacheName = serverCacheName
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		cache = r.ispnClient.Cache(serverCacheName)
+	}
+
+	if !serverTemplate && r.cache.GetServerCacheName() != r.cache.GetCacheName() {
+		var err error
+		if config, err = r.aliasedConfig(config, markup); err != nil {
+			return err
+		}
+		markup = mime.ApplicationJson
+	}
+
 	var err error
-	if spec.TemplateName != "" {
+	if serverTemplate {
 		if err = cache.CreateWithTemplate(spec.TemplateName); err != nil {
 			err = fmt.Errorf("unable to create cache with template name '%s': %w", spec.TemplateName, err)
 		}
 	} else {
-		if err = cache.Create(spec.Template, mime.GuessMarkup(spec.Template)); err != nil {
+		if err = cache.Create(config, markup); err != nil {
 			err = fmt.Errorf("unable to create cache with template: %w", err)
 		}
 	}
@@ -366,10 +589,441 @@ func (r *cacheRequest) reconcileDataGrid(cacheExists bool, cache api.Cache) erro
 	return err
 }
 
+// loadStoreCredentials loads the database credentials of the Cache CR store, if the store connects to a database. The
+// password is referenced by the alias of the current version of the Secret, so that the cache is updated when it changes
+func (r *cacheRequest) loadStoreCredentials() error {
+	connection := r.cache.PersistenceConnection()
+	if connection == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(r.ctx, types.NamespacedName{Namespace: r.cache.Namespace, Name: connection.CredentialsSecret}, secret); err != nil {
+		return fmt.Errorf("unable to load database credentials Secret '%s': %w", connection.CredentialsSecret, err)
+	}
+	r.storeCredentials = &storeCredentials{
+		username: string(secret.Data["username"]),
+		alias:    r.cache.GetStoreCredentialAlias(secret.ResourceVersion),
+	}
+	return nil
+}
+
+// cacheConfig returns the configuration to be submitted to the server. A typed configuration is rendered as JSON. If
+// persistence is configured, the template is converted to JSON so that the persistence section can be rendered with
+// the store's connection pool
+func (r *cacheRequest) cacheConfig() (string, mime.MimeType, error) {
+	config, markup, err := r.templateConfig()
+	if err != nil || len(r.authorizationRoles) == 0 {
+		r.rolesApplied = err == nil
+		return config, markup, err
+	}
+
+	// The roles of cache scoped Role CRs are merged with the roles of the template
+	if markup != mime.ApplicationJson {
+		if config, err = r.ispnClient.Caches().ConvertConfiguration(config, markup, mime.ApplicationJson); err != nil {
+			return "", "", fmt.Errorf("unable to convert cache template to '%s': %w", mime.ApplicationJson, err)
+		}
+	}
+	if config, err = container.AddAuthorizationRoles(config, r.authorizationRoles...); err != nil {
+		return "", "", fmt.Errorf("unable to apply authorization roles: %w", err)
+	}
+	r.rolesApplied = true
+	return config, mime.ApplicationJson, nil
+}
+
+// templateConfig returns the configuration defined by the Cache CR spec
+func (r *cacheRequest) templateConfig() (string, mime.MimeType, error) {
+	if r.cache.Spec.Configuration != nil {
+		config, err := container.CreateCacheConfig(cacheConfiguration(r.cache, r.storeCredentials))
+		if err != nil {
+			return "", "", fmt.Errorf("unable to create cache configuration: %w", err)
+		}
+		return config, mime.ApplicationJson, nil
+	}
+
+	template := r.cache.Spec.Template
+	markup := mime.GuessMarkup(template)
+	persistence := r.cache.Spec.Persistence
+	if persistence == nil {
+		return template, markup, nil
+	}
+
+	config := template
+	if markup != mime.ApplicationJson {
+		var err error
+		if config, err = r.ispnClient.Caches().ConvertConfiguration(template, markup, mime.ApplicationJson); err != nil {
+			return "", "", fmt.Errorf("unable to convert cache template to '%s': %w", mime.ApplicationJson, err)
+		}
+	}
+
+	config, err := container.ApplyPersistence(config, persistenceConfig(r.cache, r.storeCredentials))
+	if err != nil {
+		return "", "", fmt.Errorf("unable to apply persistence configuration: %w", err)
+	}
+	return config, mime.ApplicationJson, nil
+}
+
+// aliasedConfig returns the JSON representation of the provided configuration with the cache name defined as an alias
+func (r *cacheRequest) aliasedConfig(config string, markup mime.MimeType) (string, error) {
+	if markup != mime.ApplicationJson {
+		var err error
+		if config, err = r.ispnClient.Caches().ConvertConfiguration(config, markup, mime.ApplicationJson); err != nil {
+			return "", fmt.Errorf("unable to convert cache template to '%s': %w", mime.ApplicationJson, err)
+		}
+	}
+
+	config, err := container.ApplyAliases(config, r.cache.GetCacheName())
+	if err != nil {
+		return "", fmt.Errorf("unable to add alias to cache configuration: %w", err)
+	}
+	return config, nil
+}
+
+// migrationSupported returns an error if the Infinispan server does not support cache migrations
+func (r *cacheRequest) migrationSupported() error {
+	operand, err := r.versionManager.WithRef(r.infinispan.Spec.Version)
+	if err != nil {
+		return err
+	}
+	if operand.UpstreamVersion.Major < 15 {
+		return fmt.Errorf("the '%s' update strategy requires Infinispan 15 or later", v2alpha1.CacheUpdateMigrate)
+	}
+	return nil
+}
+
+// migrationTargetName returns the name of the cache that replaces the server cache when it's migrated. The name is
+// derived from the CR generation, however a migration caused by updated store credentials does not change the
+// generation, in which case a suffix is required to distinguish the target from the existing cache
+func (r *cacheRequest) migrationTargetName() string {
+	name := fmt.Sprintf("%s-%d", r.cache.GetCacheName(), r.cache.GetGeneration())
+	if name == r.cache.GetServerCacheName() {
+		return name + "-1"
+	}
+	return name
+}
+
+// migrate replaces the server cache with a new cache created from the provided configuration. The new cache is
+// connected to the existing cache via a remote store and the cache alias is moved to the new cache, so that clients
+// read through to the existing cache and their writes are retained. The remaining entries are then copied to the new
+// cache and the existing cache is removed. The progress is recorded in the Cache CR status so that an interrupted
+// migration is resumed by the next reconciliation
+func (r *cacheRequest) migrate(config string, markup mime.MimeType) error {
+	migration := r.cache.Status.Migration
+	if !r.cache.IsMigrating() {
+		if err := r.migrationSupported(); err != nil {
+			return err
+		}
+		// The alias can only be assigned to another cache once the cache with the same name has been removed, in
+		// which case writes made after the data has been copied would be lost
+		if r.cache.GetServerCacheName() == r.cache.GetCacheName() {
+			return fmt.Errorf("cache '%s' was not created with the '%s' update strategy, so it cannot be migrated without data loss. Recreate the Cache CR in order to migrate the cache", r.cache.GetCacheName(), v2alpha1.CacheUpdateMigrate)
+		}
+
+		migration = &v2alpha1.CacheMigrationStatus{
+			Phase:       v2alpha1.CacheMigrationSyncing,
+			SourceCache: r.cache.GetServerCacheName(),
+			TargetCache: r.migrationTargetName(),
+		}
+		if err := r.updateMigration(migration); err != nil {
+			return err
+		}
+	}
+
+	if migration.Phase == v2alpha1.CacheMigrationSyncing {
+		entries, err := r.syncMigrationTarget(migration, config, markup)
+		if err != nil {
+			return r.migrationFailed(migration, err)
+		}
+		migration.Phase = v2alpha1.CacheMigrationSwapping
+		migration.EntriesMigrated = entries
+		migration.Message = ""
+		if err := r.updateMigration(migration); err != nil {
+			return err
+		}
+	}
+
+	if err := r.removeMigrationSource(migration); err != nil {
+		return r.migrationFailed(migration, err)
+	}
+
+	r.reqLogger.Info("cache migration complete", "source", migration.SourceCache, "target", migration.TargetCache, "entries", migration.EntriesMigrated)
+	migration.Phase = v2alpha1.CacheMigrationSucceeded
+	migration.Message = ""
+	return r.update(func() error {
+		// The source cache no longer matches this CR, so the ConfigListener can't consume the annotation
+		delete(r.cache.ObjectMeta.Annotations, constants.ListenerControllerDelete)
+		r.cache.Status.ServerCacheName = migration.TargetCache
+		r.cache.Status.Migration = migration
+		return nil
+	})
+}
+
+// syncMigrationTarget creates the migration target cache, connects it to the source cache and assigns it the cache
+// alias, before copying all entries from the source cache. Returns the number of entries in the target cache
+func (r *cacheRequest) syncMigrationTarget(migration *v2alpha1.CacheMigrationStatus, config string, markup mime.MimeType) (int64, error) {
+	target := r.ispnClient.Cache(migration.TargetCache)
+	exists, err := target.Exists()
+	if err != nil {
+		return 0, fmt.Errorf("unable to determine if cache '%s' exists: %w", migration.TargetCache, err)
+	}
+	if !exists {
+		if err := target.Create(config, markup); err != nil {
+			return 0, fmt.Errorf("unable to create cache '%s': %w", migration.TargetCache, err)
+		}
+	}
+
+	upgrade := target.RollingUpgrade()
+	connected, err := upgrade.SourceConnected()
+	if err != nil {
+		return 0, fmt.Errorf("unable to determine if cache '%s' is connected to '%s': %w", migration.TargetCache, migration.SourceCache, err)
+	}
+	if !connected {
+		user := r.infinispan.GetOperatorUser()
+		pass, err := users.AdminPassword(user, r.infinispan.GetAdminSecretName(), r.infinispan.Namespace, r.kubernetes, r.ctx)
+		if err != nil {
+			return 0, fmt.Errorf("unable to retrieve operator admin identities: %w", err)
+		}
+		remoteStoreCfg, err := container.CreateRemoteStoreConfig(r.infinispan.GetAdminServiceName(), migration.SourceCache, user, pass)
+		if err != nil {
+			return 0, fmt.Errorf("unable to generate remote store config for cache '%s': %w", migration.SourceCache, err)
+		}
+		if err := upgrade.AddSource(remoteStoreCfg, mime.ApplicationJson); err != nil {
+			return 0, fmt.Errorf("unable to connect cache '%s' to '%s': %w", migration.TargetCache, migration.SourceCache, err)
+		}
+	}
+
+	// Clients are redirected to the target before the data is copied. Entries that have not been copied yet are read
+	// from the source cache via the remote store, and writes are propagated to the source cache until it's disconnected
+	alias := r.cache.GetCacheName()
+	if err := target.AssignAlias(alias); err != nil {
+		return 0, fmt.Errorf("unable to assign alias '%s' to cache '%s': %w", alias, migration.TargetCache, err)
+	}
+
+	result, err := upgrade.SyncData()
+	if err != nil {
+		return 0, fmt.Errorf("unable to sync data from cache '%s': %w", migration.SourceCache, err)
+	}
+	r.reqLogger.Info("cache data synced", "source", migration.SourceCache, "target", migration.TargetCache, "result", result)
+
+	if err := upgrade.DisconnectSource(); err != nil {
+		return 0, fmt.Errorf("unable to disconnect cache '%s' from '%s': %w", migration.TargetCache, migration.SourceCache, err)
+	}
+
+	size, err := target.Size()
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve size of cache '%s': %w", migration.TargetCache, err)
+	}
+	return int64(size), nil
+}
+
+// removeMigrationSource removes the source cache once all entries have been copied to the migration target
+func (r *cacheRequest) removeMigrationSource(migration *v2alpha1.CacheMigrationStatus) error {
+	source := r.ispnClient.Cache(migration.SourceCache)
+	sourceExists, err := source.Exists()
+	if err != nil {
+		return fmt.Errorf("unable to determine if cache '%s' exists: %w", migration.SourceCache, err)
+	}
+	if !sourceExists {
+		// The source cache was removed by a previous reconciliation
+		return nil
+	}
+
+	// Add an annotation to indicate to the ConfigListener that a remote-cache event should be expected for this CR
+	err = r.update(func() error {
+		if r.cache.ObjectMeta.Annotations == nil {
+			r.cache.ObjectMeta.Annotations = make(map[string]string, 1)
+		}
+		r.cache.ObjectMeta.Annotations[constants.ListenerControllerDelete] = "true"
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add annotation on migration '%s': %w", constants.ListenerControllerDelete, err)
+	}
+
+	if err := source.Delete(); err != nil {
+		return fmt.Errorf("unable to delete cache '%s': %w", migration.SourceCache, err)
+	}
+	return nil
+}
+
+func (r *cacheRequest) updateMigration(migration *v2alpha1.CacheMigrationStatus) error {
+	return r.update(func() error {
+		r.cache.Status.Migration = migration
+		return nil
+	})
+}
+
+// migrationFailed records the cause of a failed migration step in the Cache CR status, returning the original error
+func (r *cacheRequest) migrationFailed(migration *v2alpha1.CacheMigrationStatus, err error) error {
+	migration.Message = err.Error()
+	if updateErr := r.updateMigration(migration); updateErr != nil {
+		r.reqLogger.Error(updateErr, "unable to update cache migration status")
+	}
+	return err
+}
+
+// cacheConfiguration converts the Cache CR typed configuration, and persistence if defined, to the server representation
+func cacheConfiguration(cache *v2alpha1.Cache, credentials *storeCredentials) *container.CacheConfig {
+	spec := cache.Spec.Configuration
+	cfg := &container.Cache{
+		Mode:       string(spec.Mode),
+		Statistics: spec.Statistics,
+	}
+	if cfg.Mode == "" {
+		cfg.Mode = string(v2alpha1.CacheModeSync)
+	}
+
+	if encoding := spec.Encoding; encoding != nil {
+		cfg.Encoding = &container.Encoding{}
+		if encoding.Key != "" {
+			cfg.Encoding.Key = &container.MediaType{MediaType: encoding.Key}
+		}
+		if encoding.Value != "" {
+			cfg.Encoding.Value = &container.MediaType{MediaType: encoding.Value}
+		}
+	}
+
+	if memory := spec.Memory; memory != nil {
+		cfg.Memory = &container.Memory{
+			Storage:  string(memory.Storage),
+			MaxCount: memory.MaxCount,
+			MaxSize:  memory.MaxSize,
+			WhenFull: string(memory.WhenFull),
+		}
+	}
+
+	if expiration := spec.Expiration; expiration != nil {
+		cfg.Expiration = &container.Expiration{
+			Lifespan: expiration.Lifespan,
+			MaxIdle:  expiration.MaxIdle,
+			Interval: expiration.Interval,
+		}
+	}
+
+	if locking := spec.Locking; locking != nil {
+		cfg.Locking = &container.Locking{
+			Isolation:        string(locking.Isolation),
+			Striping:         locking.Striping,
+			AcquireTimeout:   locking.AcquireTimeout,
+			ConcurrencyLevel: locking.ConcurrencyLevel,
+		}
+	}
+
+	if indexing := spec.Indexing; indexing != nil {
+		cfg.Indexing = &container.Indexing{
+			Enabled:         indexing.Enabled,
+			Storage:         string(indexing.Storage),
+			IndexedEntities: indexing.IndexedEntities,
+		}
+	}
+
+	if len(spec.Backups) > 0 {
+		cfg.Backups = make(map[string]*container.Backup, len(spec.Backups))
+		for _, backup := range spec.Backups {
+			cfg.Backups[backup.Site] = &container.Backup{
+				Backup: &container.BackupConfig{
+					Strategy:      string(backup.Strategy),
+					FailurePolicy: string(backup.FailurePolicy),
+					Timeout:       backup.Timeout,
+				},
+			}
+		}
+	}
+
+	if cache.Spec.Persistence != nil {
+		cfg.Persistence = persistenceConfig(cache, credentials)
+	}
+
+	if spec.Type == v2alpha1.CacheTypeReplicated {
+		return &container.CacheConfig{ReplicatedCache: cfg}
+	}
+	cfg.Owners = spec.Owners
+	return &container.CacheConfig{DistributedCache: cfg}
+}
+
+// persistenceConfig converts the Cache CR persistence spec to the server representation. Database stores connect via
+// a connection pool owned by the store, so that no data source has to be defined in the server configuration, with the
+// password referenced from the server credential store
+func persistenceConfig(cache *v2alpha1.Cache, credentials *storeCredentials) *container.Persistence {
+	spec := cache.Spec.Persistence
+	persistence := &container.Persistence{
+		Passivation: spec.Passivation,
+	}
+	var connectionPool *container.ConnectionPool
+	if connection := cache.PersistenceConnection(); connection != nil {
+		connectionPool = &container.ConnectionPool{
+			ConnectionUrl: connection.URL,
+			Driver:        connection.Driver,
+		}
+		if credentials != nil {
+			connectionPool.Username = credentials.username
+			connectionPool.Password = container.CredentialReference(credentials.alias)
+		}
+	}
+
+	if jdbc := spec.JDBC; jdbc != nil {
+		column := func(c *v2alpha1.JDBCColumnSpec) *container.Column {
+			if c == nil {
+				return nil
+			}
+			return &container.Column{Name: c.Name, Type: c.Type}
+		}
+		persistence.StringKeyedJdbcStore = &container.StringKeyedJdbcStore{
+			Store:          container.Store(jdbc.CacheStoreSpec),
+			Dialect:        jdbc.Dialect,
+			ConnectionPool: connectionPool,
+			StringKeyedTable: &container.StringKeyedTable{
+				Prefix:          jdbc.Table.Prefix,
+				CreateOnStart:   true,
+				DropOnExit:      jdbc.Table.DropOnExit,
+				IdColumn:        column(&jdbc.Table.IDColumn),
+				DataColumn:      column(&jdbc.Table.DataColumn),
+				TimestampColumn: column(&jdbc.Table.TimestampColumn),
+				SegmentColumn:   column(jdbc.Table.SegmentColumn),
+			},
+		}
+	}
+
+	if sql := spec.SQL; sql != nil {
+		if sql.Queries == nil {
+			persistence.TableJdbcStore = &container.TableJdbcStore{
+				Store:          container.Store(sql.CacheStoreSpec),
+				Dialect:        sql.Dialect,
+				ConnectionPool: connectionPool,
+				TableName:      sql.Table,
+			}
+		} else {
+			q := sql.Queries
+			persistence.QueryJdbcStore = &container.QueryJdbcStore{
+				Store:          container.Store(sql.CacheStoreSpec),
+				Dialect:        sql.Dialect,
+				ConnectionPool: connectionPool,
+				KeyColumns:     sql.KeyColumns,
+				Queries: &container.Queries{
+					SelectSingle: q.Select,
+					SelectAll:    q.SelectAll,
+					Size:         q.Size,
+					Upsert:       q.Upsert,
+					DeleteSingle: q.Delete,
+					DeleteAll:    q.DeleteAll,
+				},
+			}
+		}
+	}
+
+	if file := spec.File; file != nil {
+		persistence.FileStore = &container.FileStore{
+			Preload: file.Preload,
+			Purge:   file.Purge,
+			Path:    file.Path,
+		}
+	}
+	return persistence
+}
+
 func (cl *CacheListener) RemoveStaleResources(podName string) error {
 	cl.Log.Info("Checking for stale cache resources")
-	k8s := cl.Kubernetes
-	ispn, err := NewInfinispanForPod(cl.Ctx, podName, cl.Infinispan, cl.VersionManager, k8s)
+	ispn, err := NewInfinispanForPod(cl.Ctx, podName, cl.Infinispan, cl.VersionManager, cl.Kubernetes)
 	if err != nil {
 		return err
 	}
@@ -380,62 +1034,157 @@ func (cl *CacheListener) RemoveStaleResources(podName string) error {
 		return err
 	}
 	cl.Log.Debugf("Caches defined on the server: '%v'", cacheNames)
+	_, err = cl.removeStaleResources(cacheNames)
+	return err
+}
 
-	// Create Set of CR names for 0(1) lookup
+// removeStaleResources marks for deletion all listener created Cache CRs that do not have a cache definition on the
+// server, returning the names of the marked CRs
+func (cl *CacheListener) removeStaleResources(cacheNames []string) ([]string, error) {
+	k8s := cl.Kubernetes
+	// Create Set of cache names for 0(1) lookup
 	serverCaches := make(map[string]struct{}, len(cacheNames))
 	for _, name := range cacheNames {
-		cacheCrName := strcase.ToKebab(name)
-		serverCaches[cacheCrName] = struct{}{}
+		serverCaches[name] = struct{}{}
 	}
 
 	// Retrieve list of all Cache CRs in namespace
 	cacheList := &v2alpha1.CacheList{}
 	if err := k8s.Client.List(cl.Ctx, cacheList, &client.ListOptions{Namespace: cl.Infinispan.Namespace}); err != nil {
-		return fmt.Errorf("unable to rerieve existing Cache resources: %w", err)
+		return nil, fmt.Errorf("unable to rerieve existing Cache resources: %w", err)
 	}
 
 	// Iterate over all existing CRs, marking for deletion any that do not have a cache definition on the server
+	var stale []string
 	for _, cache := range cacheList.Items {
 		listenerCreated := kube.IsOwnedBy(&cache, cl.Infinispan)
-		_, cacheExists := serverCaches[cache.GetCacheName()]
+		_, cacheExists := serverCaches[cache.GetServerCacheName()]
 		cl.Log.Debugf("Checking if Cache CR '%s' is stale. ListenerCreated=%t. CacheExists=%t", cache.Name, listenerCreated, cacheExists)
-		if listenerCreated && !cacheExists {
+		if listenerCreated && !cacheExists && !cache.IsMigrating() && cache.DeletionTimestamp.IsZero() {
+			if _, marked := cache.ObjectMeta.Annotations[constants.ListenerAnnotationDelete]; marked {
+				continue
+			}
 			cache.ObjectMeta.Annotations[constants.ListenerAnnotationDelete] = "true"
 			cl.Log.Infof("Marking stale Cache resource '%s' for deletion", cache.Name)
 			if err := k8s.Client.Update(cl.Ctx, &cache); err != nil {
 				if !errors.IsNotFound(err) {
-					return fmt.Errorf("unable to mark Cache '%s' for deletion: %w", cache.Name, err)
+					return stale, fmt.Errorf("unable to mark Cache '%s' for deletion: %w", cache.Name, err)
 				}
 			}
+			stale = append(stale, cache.Name)
 		}
 	}
-	return nil
+	return stale, nil
+}
+
+// Resync compares the caches defined on the server with the existing Cache CRs, creating, updating or marking for
+// deletion any CRs that have drifted from the server configuration, for example because an event was lost. The drift
+// detected is reported on the Infinispan CR and via the ConfigListener metrics. The names of the repaired CRs are returned
+func (cl *CacheListener) Resync() ([]string, error) {
+	cl.Log.Debug("Resynchronising Cache resources with the server")
+	ispnClient, err := NewInfinispan(cl.Ctx, cl.Infinispan, cl.VersionManager, cl.Kubernetes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Infinispan client: %w", err)
+	}
+
+	cacheNames, err := ispnClient.Caches().Names()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve cache names: %w", err)
+	}
+
+	var drifted []string
+	for _, cacheName := range cacheNames {
+		if strings.HasPrefix(cacheName, "___") {
+			continue
+		}
+
+		cache, err := cl.findExistingCacheCR(cacheName, cl.Infinispan.Name)
+		if err != nil {
+			return nil, err
+		}
+		if cache != nil && cache.Status.ObservedGeneration < cache.GetGeneration() {
+			// The cache controller has not yet applied the latest CR spec to the server
+			continue
+		}
+
+		configJson, err := ispnClient.Cache(cacheName).Config(mime.ApplicationJson)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve configuration of cache '%s': %w", cacheName, err)
+		}
+
+		updated, err := cl.createOrUpdate(cacheName, configJson)
+		if err != nil {
+			return nil, err
+		}
+		if updated {
+			cl.Log.Infof("Cache '%s' had drifted from the server configuration", cacheName)
+			drifted = append(drifted, cacheName)
+		}
+	}
+
+	stale, err := cl.removeStaleResources(cacheNames)
+	if err != nil {
+		return nil, err
+	}
+	drifted = append(drifted, stale...)
+	return drifted, cl.reportDrift(drifted)
+}
+
+// reportDrift exposes the number of drifted Cache CRs via the Infinispan CR status
+func (cl *CacheListener) reportDrift(drifted []string) error {
+	i := cl.Infinispan
+
+	status, msg := metav1.ConditionTrue, ""
+	if len(drifted) > 0 {
+		status = metav1.ConditionFalse
+		msg = fmt.Sprintf("%d Cache resource(s) had drifted from the server configuration and were repaired: %s", len(drifted), strings.Join(drifted, ", "))
+	}
+
+	k8sClient := cl.Kubernetes.Client
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		ispn := &v1.Infinispan{}
+		if err := k8sClient.Get(cl.Ctx, types.NamespacedName{Namespace: i.Namespace, Name: i.Name}, ispn); err != nil {
+			return err
+		}
+		patch := client.MergeFromWithOptions(ispn.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		if !ispn.SetCondition(v1.ConditionCachesSynchronized, status, msg) {
+			return nil
+		}
+		return k8sClient.Status().Patch(cl.Ctx, ispn, patch)
+	})
 }
 
 var cacheNameRegexp = regexp.MustCompile("[^-a-z0-9]")
 
 func (cl *CacheListener) CreateOrUpdate(data []byte) error {
-	namespace := cl.Infinispan.Namespace
-	clusterName := cl.Infinispan.Name
 	cacheName, configJson, err := unmarshallEventConfig(data)
 	if err != nil {
 		return err
 	}
+	_, err = cl.createOrUpdate(cacheName, configJson)
+	return err
+}
+
+// createOrUpdate ensures that a Cache CR exists with the provided cache configuration, returning true if a CR was
+// created or updated
+func (cl *CacheListener) createOrUpdate(cacheName, configJson string) (bool, error) {
+	namespace := cl.Infinispan.Namespace
+	clusterName := cl.Infinispan.Name
 
 	if strings.HasPrefix(cacheName, "___") {
 		cl.Log.Debugf("Ignoring internal cache %s", cacheName)
-		return nil
+		return false, nil
 	}
 
 	cache, err := cl.findExistingCacheCR(cacheName, clusterName)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	k8sClient := cl.Kubernetes.Client
 	ispnClient, err := NewInfinispan(cl.Ctx, cl.Infinispan, cl.VersionManager, cl.Kubernetes)
 	if err != nil {
-		return fmt.Errorf("unable to create Infinispan client: %w", err)
+		return false, fmt.Errorf("unable to create Infinispan client: %w", err)
 	}
 
 	if cache == nil {
@@ -443,7 +1192,7 @@ func (cl *CacheListener) CreateOrUpdate(data []byte) error {
 		sanitizedCacheName := cacheNameRegexp.ReplaceAllString(strcase.ToKebab(cacheName), "-")
 		errs := validation.IsDNS1123Subdomain(sanitizedCacheName)
 		if len(errs) > 0 {
-			return fmt.Errorf("unable to create Cache Resource Name for cache=%s, cluster=%s: %s", cacheName, clusterName, strings.Join(errs, "."))
+			return false, fmt.Errorf("unable to create Cache Resource Name for cache=%s, cluster=%s: %s", cacheName, clusterName, strings.Join(errs, "."))
 		}
 
 		cache = &v2alpha1.Cache{
@@ -463,40 +1212,68 @@ func (cl *CacheListener) CreateOrUpdate(data []byte) error {
 		// Convert JSON to yaml
 		configYaml, err := ispnClient.Caches().ConvertConfiguration(configJson, mime.ApplicationJson, mime.ApplicationYaml)
 		if err != nil {
-			return fmt.Errorf("unable to convert cache configuration from '%s' to '%s': %w", mime.ApplicationJson, mime.ApplicationYaml, err)
+			return false, fmt.Errorf("unable to convert cache configuration from '%s' to '%s': %w", mime.ApplicationJson, mime.ApplicationYaml, err)
 		}
 		if err != nil {
-			return err
+			return false, err
 		}
 		cache.Spec.Template = configYaml
 
 		controllerutil.AddFinalizer(cache, constants.InfinispanFinalizer)
 		if err := controllerutil.SetOwnerReference(cl.Infinispan, cache, k8sClient.Scheme()); err != nil {
-			return err
+			return false, err
 		}
 
 		cl.Log.Infof("Creating Cache CR for '%s'\n%s", cacheName, configYaml)
 		if err := k8sClient.Create(cl.Ctx, cache); err != nil {
-			return fmt.Errorf("unable to create Cache CR for cache '%s': %w", cacheName, err)
+			return false, fmt.Errorf("unable to create Cache CR for cache '%s': %w", cacheName, err)
 		}
 		cl.Log.Infof("Cache CR '%s' created", cache.Name)
+		return true, nil
 	} else {
 		// Update existing Cache
 		maxRetries := 5
 		for i := 1; i <= maxRetries; i++ {
 
 			if cache.Spec.Template == "" {
-				// The cache uses a template defined on the server so nothing to do
+				// The cache uses a template defined on the server, or a typed configuration managed by the operator, so nothing to do
+				break
+			}
+
+			if cache.IsMigrating() || cacheName != cache.GetServerCacheName() {
+				// The event was caused by the cache controller migrating the cache data
+				cl.Log.Debugf("Ignoring update of cache '%s' as Cache CR '%s' is being migrated", cacheName, cache.Name)
 				break
 			}
 
+			// The alias of a migrated cache is managed by the operator, so it must not be added to the template
+			if cache.Status.ServerCacheName != "" {
+				if configJson, err = container.RemoveAliases(configJson); err != nil {
+					return false, fmt.Errorf("unable to remove aliases from cache '%s' configuration: %w", cacheName, err)
+				}
+			}
+
+			// The persistence configuration is managed via spec.persistence, so it must not be added to the template
+			if cache.Spec.Persistence != nil {
+				if configJson, err = container.RemovePersistence(configJson); err != nil {
+					return false, fmt.Errorf("unable to remove persistence from cache '%s' configuration: %w", cacheName, err)
+				}
+			}
+
+			// The roles of cache scoped Role CRs are managed via the Role CRs, so they must not be added to the template
+			if len(cache.Status.AuthorizationRoles) > 0 {
+				if configJson, err = removeScopedRoles(cache, configJson, ispnClient.Caches()); err != nil {
+					return false, fmt.Errorf("unable to remove authorization roles from cache '%s' configuration: %w", cacheName, err)
+				}
+			}
+
 			configUpdated, err := configChanged(configJson, cache.Spec.Template, cl.Infinispan, ispnClient.Caches(), cl.VersionManager)
 			if !configUpdated {
 				cl.Log.Debugf("Cache '%s' configuration on update has not changed, ignoring update", cache.Name)
 				break
 			}
 
-			_, err = controllerutil.CreateOrPatch(cl.Ctx, k8sClient, cache, func() error {
+			result, err := controllerutil.CreateOrPatch(cl.Ctx, k8sClient, cache, func() error {
 				if cache.CreationTimestamp.IsZero() {
 					return errors.NewNotFound(schema.ParseGroupResource("caches.infinispan.org"), cache.Name)
 				}
@@ -522,29 +1299,46 @@ func (cl *CacheListener) CreateOrUpdate(data []byte) error {
 				}
 				controllerutil.AddFinalizer(cache, constants.InfinispanFinalizer)
 				cache.ObjectMeta.Annotations[constants.ListenerAnnotationGeneration] = strconv.FormatInt(cache.GetGeneration()+1, 10)
-				cache.Spec = v2alpha1.CacheSpec{
-					Name:         cacheName,
-					ClusterName:  cl.Infinispan.Name,
-					Template:     template,
-					TemplateName: templateName,
-					Updates:      cache.Spec.Updates,
-				}
+				// Only the template fields are owned by the listener, the remainder of the user's spec is retained
+				cache.Spec.Name = cache.GetCacheName()
+				cache.Spec.ClusterName = cl.Infinispan.Name
+				cache.Spec.Template = template
+				cache.Spec.TemplateName = templateName
 				return nil
 			})
 			if err == nil {
-				break
+				return result != controllerutil.OperationResultNone, nil
 			}
 
 			if !errors.IsConflict(err) {
-				return fmt.Errorf("unable to Update Cache CR '%s': %w", cache.Name, err)
+				return false, fmt.Errorf("unable to Update Cache CR '%s': %w", cache.Name, err)
 			}
 			cl.Log.Errorf("Conflict encountered on Cache CR '%s' update. Retry %d..%d", cache.Name, i, maxRetries)
 		}
 		if err != nil {
-			return fmt.Errorf("unable to Update Cache CR %s after %d attempts", cache.Name, maxRetries)
+			return false, fmt.Errorf("unable to Update Cache CR %s after %d attempts", cache.Name, maxRetries)
 		}
 	}
-	return nil
+	return false, nil
+}
+
+// removeScopedRoles removes the roles that were added to the cache configuration by cache scoped Role CRs, retaining
+// the roles that are defined by the Cache CR template
+func removeScopedRoles(cache *v2alpha1.Cache, configJson string, caches api.Caches) (string, error) {
+	template, err := caches.ConvertConfiguration(cache.Spec.Template, mime.GuessMarkup(cache.Spec.Template), mime.ApplicationJson)
+	if err != nil {
+		return "", err
+	}
+
+	var roles []string
+	for _, role := range cache.Status.AuthorizationRoles {
+		if inTemplate, err := container.HasAuthorizationRole(template, role); err != nil {
+			return "", err
+		} else if !inTemplate {
+			roles = append(roles, role)
+		}
+	}
+	return container.RemoveAuthorizationRoles(configJson, roles...)
 }
 
 func (cl *CacheListener) findExistingCacheCR(cacheName, clusterName string) (*v2alpha1.Cache, error) {
@@ -558,7 +1352,12 @@ func (cl *CacheListener) findExistingCacheCR(cacheName, clusterName string) (*v2
 
 	var caches []v2alpha1.Cache
 	for _, c := range cacheList.Items {
-		if c.GetCacheName() == cacheName && c.Spec.ClusterName == clusterName {
+		if c.Spec.ClusterName != clusterName {
+			continue
+		}
+		// Caches created by a migration are associated with the Cache CR that is being migrated
+		migrationTarget := c.Status.Migration != nil && c.Status.Migration.TargetCache == cacheName
+		if c.GetServerCacheName() == cacheName || migrationTarget {
 			caches = append(caches, c)
 		}
 	}
@@ -6,8 +6,10 @@ import (
 
 	v1 "github.com/infinispan/infinispan-operator/api/v1"
 	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	httpClient "github.com/infinispan/infinispan-operator/pkg/http"
 	"github.com/infinispan/infinispan-operator/pkg/http/curl"
-	"github.com/infinispan/infinispan-operator/pkg/infinispan/client"
+	"github.com/infinispan/infinispan-operator/pkg/http/native"
+	ispnClient "github.com/infinispan/infinispan-operator/pkg/infinispan/client"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
 	users "github.com/infinispan/infinispan-operator/pkg/infinispan/security"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/version"
@@ -28,9 +30,9 @@ func NewInfinispan(ctx context.Context, i *v1.Infinispan, versionManager *versio
 	return NewInfinispanForPod(ctx, podList.Items[0].Name, i, versionManager, kubernetes)
 }
 
-// NewInfinispanForPod retrieves credential information to initialise a curl.Client and uses this to return a api.Infinispan implementation
+// NewInfinispanForPod retrieves credential information to initialise a httpClient.HttpClient and uses this to return a api.Infinispan implementation
 func NewInfinispanForPod(ctx context.Context, podName string, i *v1.Infinispan, versionManager *version.Manager, kubernetes *kube.Kubernetes) (api.Infinispan, error) {
-	curlClient, err := NewCurlClient(ctx, podName, i, kubernetes)
+	client, err := NewHttpClient(ctx, podName, i, kubernetes)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create Infinispan client: %w", err)
 	}
@@ -38,7 +40,15 @@ func NewInfinispanForPod(ctx context.Context, podName string, i *v1.Infinispan,
 	if err != nil {
 		return nil, fmt.Errorf("spec.Version '%s' doesn't exist", i.Spec.Version)
 	}
-	return client.New(operand, curlClient), nil
+	return ispnClient.New(operand, client), nil
+}
+
+// NewHttpClient returns the httpClient.HttpClient transport configured for the v1.Infinispan instance
+func NewHttpClient(ctx context.Context, podName string, i *v1.Infinispan, kubernetes *kube.Kubernetes) (httpClient.HttpClient, error) {
+	if i.IsNativeHttpTransport() {
+		return NewNativeClient(ctx, podName, i, kubernetes)
+	}
+	return NewCurlClient(ctx, podName, i, kubernetes)
 }
 
 // NewCurlClient return a new curl.Client using the admin credentials associated with the v1.Infinispan instance
@@ -55,8 +65,26 @@ func NewCurlClient(ctx context.Context, podName string, i *v1.Infinispan, kubern
 		Container: InfinispanContainer,
 		Podname:   podName,
 		Namespace: i.Namespace,
-		Protocol:  "http",
+		Protocol:  consts.InfinispanAdminProtocol,
 		Port:      consts.InfinispanAdminPort,
 	}, kubernetes)
 	return curlClient, nil
 }
+
+// NewNativeClient return a new native.Client using the admin credentials associated with the v1.Infinispan instance
+func NewNativeClient(ctx context.Context, podName string, i *v1.Infinispan, kubernetes *kube.Kubernetes) (*native.Client, error) {
+	pass, err := users.AdminPassword(i.GetOperatorUser(), i.GetAdminSecretName(), i.Namespace, kubernetes, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve operator admin identities when creating native http client: %w", err)
+	}
+	return native.New(native.Config{
+		Credentials: &native.Credentials{
+			Username: i.GetOperatorUser(),
+			Password: pass,
+		},
+		Podname:   podName,
+		Namespace: i.Namespace,
+		Protocol:  consts.InfinispanAdminProtocol,
+		Port:      consts.InfinispanAdminPort,
+	}, kubernetes), nil
+}
@@ -31,6 +31,11 @@ var (
 	// DefaultPVSize default size for persistent volume
 	DefaultPVSize = resource.MustParse("1Gi")
 
+	// DefaultUpgradeCanaryMemory the memory of the canary pod of an upgrade, which only requires enough to start the server
+	DefaultUpgradeCanaryMemory = resource.MustParse("512Mi")
+	// DefaultUpgradeCanaryCPU the CPU requested by the canary pod of an upgrade
+	DefaultUpgradeCanaryCPU = resource.MustParse("100m")
+
 	DeploymentAnnotations = map[string]string{
 		"openshift.io/display-name":      "Infinispan Cluster",
 		"openshift.io/documentation-url": "http://infinispan.org/documentation/",
@@ -51,6 +56,13 @@ var (
 		Patch: 24,
 	}
 
+	// 15.0.0.Final required to encrypt the JGroups transport with SYM_ENCRYPT or ASYM_ENCRYPT
+	MinVersionJGroupsEncryption = semver.Version{
+		Major: 15,
+		Minor: 0,
+		Patch: 0,
+	}
+
 	// 15.0.7.Final required to support the automatic reloading of TLS certs
 	MinVersionAutomaticCertificateReloading = semver.Version{
 		Major: 15,
@@ -63,10 +75,19 @@ const (
 	// DefaultDeveloperUser users to access the cluster rest API
 	DefaultDeveloperUser = "developer"
 	// DefaultCacheName default cache name for the CacheService
-	DefaultCacheName                        = "default"
+	DefaultCacheName = "default"
+	// DefaultEndpointSecurityRealm the security realm used by the default endpoint
+	DefaultEndpointSecurityRealm = "default"
+	// LDAPEndpointSecurityRealm the security realm that only authenticates the users of spec.security.ldap
+	LDAPEndpointSecurityRealm = "ldap"
+	// TokenEndpointSecurityRealm the security realm that only authenticates the bearer tokens of spec.security.tokenRealm
+	TokenEndpointSecurityRealm = "token"
+	// DefaultReplicationFactor the number of owners of each entry used by the server when not configured
+	DefaultReplicationFactor                = 2
 	AdminUsernameKey                        = "username"
 	AdminPasswordKey                        = "password"
 	InfinispanAdminPort                     = 11223
+	InfinispanAdminProtocol                 = "http" // The admin endpoint is only reachable within the cluster and is never encrypted
 	InfinispanAdminPortName                 = "infinispan-adm"
 	InfinispanJmxPort                       = 9999
 	InfinispanJmxPortName                   = "jfr-jmx" // Jmx constant required for automatic lookup of JMX endpoint
@@ -76,6 +97,7 @@ const (
 	InfinispanUserPortName                  = "infinispan"
 	CrossSitePort                           = 7900
 	CrossSitePortName                       = "xsite"
+	InfinispanJGroupsPort                   = 7800
 	GossipRouterDiagPort                    = 7500
 	StatefulSetPodLabel                     = "app.kubernetes.io/created-by"
 	StaticCrossSiteUriSchema                = "infinispan+xsite"
@@ -97,17 +119,59 @@ const (
 	SiteTransportKeyStoreRoot     = ServerEncryptRoot + "/transport-site-tls"
 	SiteRouterKeyStoreRoot        = ServerEncryptRoot + "/router-site-tls"
 	SiteTrustStoreRoot            = ServerEncryptRoot + "/truststore-site-tls"
+	JGroupsEncryptKeyStoreRoot    = ServerEncryptRoot + "/jgroups-encryption"
 	ServerSecurityRoot            = "/etc/security"
 	ServerIdentitiesFilename      = "identities.yaml"
 	CliPropertiesFilename         = "cli.properties"
 	ServerIdentitiesBatchFilename = "identities.cli"
-	ServerAdminIdentitiesRoot     = ServerSecurityRoot + "/admin"
-	ServerUserIdentitiesRoot      = ServerSecurityRoot + "/user"
-	ServerOperatorSecurity        = ServerSecurityRoot + "/conf/operator-security"
-	ServerRoot                    = "/opt/infinispan/server"
+	// ServerPreviousAdminIdentitiesFilename the admin identities that remain valid during the grace period of a credential rotation
+	ServerPreviousAdminIdentitiesFilename = "previous-admin-identities.yaml"
+	// ServerPreviousIdentitiesFilename the user identities that remain valid during the grace period of a credential rotation
+	ServerPreviousIdentitiesFilename = "previous-identities.yaml"
+	ServerAdminIdentitiesRoot        = ServerSecurityRoot + "/admin"
+	ServerUserIdentitiesRoot         = ServerSecurityRoot + "/user"
+	ServerOperatorSecurity           = ServerSecurityRoot + "/conf/operator-security"
+	ServerRoot                       = "/opt/infinispan/server"
+	// ServerCredentialStorePath the user credential store, which is loaded from the server configuration directory
+	ServerCredentialStorePath = ServerRoot + "/conf/credentials.pfx"
 
 	EncryptTruststoreKey         = "truststore.p12"
 	EncryptTruststorePasswordKey = "truststore-password"
+	// EncryptTruststoreSourceHashKey the hash of the certificates used to generate a truststore
+	EncryptTruststoreSourceHashKey = "truststore-source-hash"
+
+	JGroupsEncryptKeyStoreKey = "keystore.p12"
+	JGroupsEncryptPasswordKey = "password"
+	JGroupsEncryptAliasKey    = "alias"
+
+	// LDAPBindPasswordKey the key in the LDAP bind credential secret containing the password of the bind DN
+	LDAPBindPasswordKey = "password"
+	// LDAPCAKey the key in the LDAP CA secret containing the PEM encoded CA certificates
+	LDAPCAKey = "ca.crt"
+	// ServerLDAPTruststoreFilename the file containing the CA certificates trusted by LDAPS connections
+	ServerLDAPTruststoreFilename = "ldap-truststore.pem"
+	// LDAPBindCredentialAlias the alias of the LDAP bind DN password in the credential store
+	LDAPBindCredentialAlias = "ldap-bind-password"
+	// DefaultLDAPUserFilter the default filter used to find the LDAP entry of a user
+	DefaultLDAPUserFilter = "(uid={0})"
+	// DefaultLDAPUserNameAttribute the default attribute of an LDAP user entry containing the username
+	DefaultLDAPUserNameAttribute = "uid"
+	// DefaultLDAPGroupFilter the default filter used to find the LDAP groups of a user
+	DefaultLDAPGroupFilter = "(member={1})"
+	// DefaultLDAPGroupNameAttribute the default attribute of an LDAP group entry mapped to a role
+	DefaultLDAPGroupNameAttribute = "cn"
+	// UserPasswordKey the key in the password secret of a User CR containing the password of the user
+	UserPasswordKey = "password"
+	// DefaultCredentialStoreVaultMount the default mount path of the Vault KV version 2 secrets engine of a CredentialStore source
+	DefaultCredentialStoreVaultMount = "secret"
+	// VaultTokenKey the key in the Vault token secret of a CredentialStore source containing the token
+	VaultTokenKey = "token"
+	// ServiceAccountTokenPath the path of the operator ServiceAccount token, presented when logging in with the Vault Kubernetes auth method
+	ServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// DefaultTokenRealmPrincipalClaim the default claim of a bearer token containing the username
+	DefaultTokenRealmPrincipalClaim = "preferred_username"
+	// DefaultTokenRealmRoleClaim the default claim of a bearer token containing the roles of the user
+	DefaultTokenRealmRoleClaim = "groups"
 
 	DefaultCacheTemplate = `<infinispan>
 		<cache-container>
@@ -130,6 +194,48 @@ const (
 	DefaultWaitClusterNotWellFormed = 15 * time.Second
 	// DefaultWaitPodsNotReady wait delay until cluster pods are ready
 	DefaultWaitClusterPodsNotReady = 2 * time.Second
+	// DefaultCounterStatusInterval delay between refreshes of a Counter CR's observed value
+	DefaultCounterStatusInterval = 30 * time.Second
+	// DefaultAutoscaleInterval delay between evaluations of a cluster's memory usage when autoscaling is enabled
+	DefaultAutoscaleInterval = 30 * time.Second
+	// DefaultAutoscaleCooldown minimum delay between two autoscaling operations
+	DefaultAutoscaleCooldown = 5 * time.Minute
+	// DefaultXSiteStateTransferInterval delay between checks of the progress of a cross-site state transfer
+	DefaultXSiteStateTransferInterval = 5 * time.Second
+	// DefaultXSiteStatusInterval delay between refreshes of an Infinispan CR's cross-site status
+	DefaultXSiteStatusInterval = 30 * time.Second
+	// DefaultJGroupsFDInterval the default interval in milliseconds at which JGroups heartbeats are sent
+	DefaultJGroupsFDInterval = 8000
+	// DefaultJGroupsFDTimeout the default time in milliseconds without a heartbeat after which a JGroups member is suspected
+	DefaultJGroupsFDTimeout = 40000
+	// DefaultJGroupsMergeMinInterval the default minimum interval in milliseconds between JGroups partition checks
+	DefaultJGroupsMergeMinInterval = 10000
+	// DefaultJGroupsMergeMaxInterval the default maximum interval in milliseconds between JGroups partition checks
+	DefaultJGroupsMergeMaxInterval = 30000
+	// DefaultTLSCertificateExpiryWarning period before the expiry of the endpoint certificate that a warning is reported
+	DefaultTLSCertificateExpiryWarning = 30 * 24 * time.Hour
+	// DefaultTLSCertificateCheckInterval delay between checks of the endpoint certificate's validity
+	DefaultTLSCertificateCheckInterval = time.Hour
+	// DefaultTLSRotationInterval delay between checks of the progress of a certificate rotation
+	DefaultTLSRotationInterval = 10 * time.Second
+	// DefaultTLSReloadPeriod time allowed for updated certificates to be propagated to, and reloaded by, the server pods
+	DefaultTLSReloadPeriod = 2 * time.Minute
+	// DefaultCredentialRotationGracePeriod the default time that the previous passwords remain valid after a credential rotation
+	DefaultCredentialRotationGracePeriod = time.Hour
+	// DefaultCredentialRotationCheckInterval delay between checks of the progress of a credential rotation
+	DefaultCredentialRotationCheckInterval = 10 * time.Second
+	// DefaultCredentialStoreRefreshInterval delay between retrievals of the CredentialStore entries from an external secret store
+	DefaultCredentialStoreRefreshInterval = 5 * time.Minute
+	// DefaultUpgradePreflightInterval delay between attempts of the preflight checks of a blocked Shutdown upgrade
+	DefaultUpgradePreflightInterval = 30 * time.Second
+	// DefaultUpgradePreflightMinFreeStoragePercent the default percentage of free space required on each data volume before a Shutdown upgrade
+	DefaultUpgradePreflightMinFreeStoragePercent = 10
+	// DefaultUpgradeRollbackTimeout the default time allowed for a Shutdown upgrade to form a cluster before it is rolled back
+	DefaultUpgradeRollbackTimeout = 10 * time.Minute
+	// DefaultUpgradeCanaryTimeout the default time allowed for the canary pod of an upgrade to pass all checks
+	DefaultUpgradeCanaryTimeout = 10 * time.Minute
+	// DefaultUpgradeCanaryInterval delay between attempts of the checks performed on the canary pod of an upgrade
+	DefaultUpgradeCanaryInterval = 15 * time.Second
 )
 
 const (
@@ -137,6 +243,19 @@ const (
 	DefaultSiteTransportKeyStoreAlias = "transport"
 	DefaultSiteRouterKeyStoreAlias    = "router"
 	DefaultSiteTrustStoreFileName     = "truststore.p12"
+	// DefaultSiteCertManagerKeyStoreAlias the alias of the key in the PKCS#12 keystores created by cert-manager. The
+	// keystores do not define a friendlyName, so the JDK exposes the key with a generated alias
+	DefaultSiteCertManagerKeyStoreAlias = "1"
+	// SiteCertManagerKeyStorePasswordKey the key in the operator generated secret containing the password of the
+	// keystores created by cert-manager
+	SiteCertManagerKeyStorePasswordKey = "password"
+)
+
+const (
+	// ObjectStoreAccessKeyID the key in a Backup or Restore objectStore credentials secret containing the access key id
+	ObjectStoreAccessKeyID = "AWS_ACCESS_KEY_ID"
+	// ObjectStoreSecretAccessKey the key in a Backup or Restore objectStore credentials secret containing the secret access key
+	ObjectStoreSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
 )
 
 const (
@@ -144,6 +263,23 @@ const (
 	ListenerAnnotationGeneration = AnnotationDomain + "listener-generation"
 	ListenerAnnotationDelete     = AnnotationDomain + "listener-delete"
 	ListenerControllerDelete     = AnnotationDomain + "controller-delete"
+	CounterAnnotationReset       = AnnotationDomain + "counter-reset"
+	BackupScheduleLabel          = AnnotationDomain + "backup-schedule"
+	BackupScheduleAnnotationTime = AnnotationDomain + "backup-scheduled-time"
+	// HotRodUpgradeAnnotationResume resumes a Hot Rod rolling upgrade that is paused before its current stage
+	HotRodUpgradeAnnotationResume = AnnotationDomain + "hotrod-upgrade-resume"
+	// HotRodUpgradeAnnotationAbort aborts a Hot Rod rolling upgrade and rolls the cluster back to the source version
+	HotRodUpgradeAnnotationAbort = AnnotationDomain + "hotrod-upgrade-abort"
+	// UpgradeCanaryAnnotationRetry restarts the canary verification of an upgrade that failed
+	UpgradeCanaryAnnotationRetry = AnnotationDomain + "upgrade-canary-retry"
+	// CredentialRotationAnnotation rotates the passwords generated by the operator on demand
+	CredentialRotationAnnotation = AnnotationDomain + "rotate-credentials"
+	// CredentialStoreAnnotation records the version and aliases of the CredentialStore entries added to a pod's running server container
+	CredentialStoreAnnotation = AnnotationDomain + "credential-store"
+	// IdentitiesAnnotation records the version of the generated identities added to a pod's server container
+	IdentitiesAnnotation = AnnotationDomain + "identities"
+	// UsersAnnotation records the version of each User CR identity added to a pod's server container
+	UsersAnnotation = AnnotationDomain + "users"
 )
 
 // GetWithDefault return value if not empty else return defValue
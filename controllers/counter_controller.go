@@ -0,0 +1,254 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/version"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// CounterReconciler reconciles a Counter object
+type CounterReconciler struct {
+	client.Client
+	log            logr.Logger
+	scheme         *runtime.Scheme
+	kubernetes     *kube.Kubernetes
+	eventRec       record.EventRecorder
+	versionManager *version.Manager
+}
+
+type counterRequest struct {
+	*CounterReconciler
+	ctx       context.Context
+	counter   *v2alpha1.Counter
+	reqLogger logr.Logger
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CounterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) (err error) {
+	r.Client = mgr.GetClient()
+	r.log = ctrl.Log.WithName("controllers").WithName("Counter")
+	r.scheme = mgr.GetScheme()
+	r.kubernetes = kube.NewKubernetesFromController(mgr)
+	r.eventRec = mgr.GetEventRecorderFor("counter-controller")
+
+	r.versionManager, err = version.ManagerFromEnv(v1.OperatorOperandVersionEnvVarName)
+	if err != nil {
+		return
+	}
+
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &v2alpha1.Counter{}, "spec.clusterName", func(obj client.Object) []string {
+		return []string{obj.(*v2alpha1.Counter).Spec.ClusterName}
+	}); err != nil {
+		return
+	}
+
+	builder := ctrl.NewControllerManagedBy(mgr).For(&v2alpha1.Counter{})
+	builder.Watches(
+		&source.Kind{Type: &v1.Infinispan{}},
+		handler.EnqueueRequestsFromMapFunc(
+			func(a client.Object) []reconcile.Request {
+				i := a.(*v1.Infinispan)
+				// Only enqueue requests once a Infinispan CR has the WellFormed condition or it has been deleted
+				if !i.HasCondition(v1.ConditionWellFormed) || !a.GetDeletionTimestamp().IsZero() {
+					return nil
+				}
+
+				var requests []reconcile.Request
+				counterList := &v2alpha1.CounterList{}
+				if err := r.kubernetes.ResourcesListByField(a.GetNamespace(), "spec.clusterName", a.GetName(), counterList, ctx); err != nil {
+					r.log.Error(err, "watches failed to list Counter CRs")
+				}
+
+				for _, item := range counterList.Items {
+					requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+				}
+				return requests
+			}),
+	)
+	return builder.Complete(r)
+}
+
+// +kubebuilder:rbac:groups=infinispan.org,namespace=infinispan-operator-system,resources=counters;counters/status;counters/finalizers,verbs=get;list;watch;create;update;patch;delete
+
+func (r *CounterReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("+++++ Reconciling Counter.")
+	defer reqLogger.Info("----- End Reconciling Counter.")
+
+	// Fetch the Counter instance
+	instance := &v2alpha1.Counter{}
+	if err := r.Client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Info("Counter resource not found. Ignoring it since it has been deleted")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	counter := &counterRequest{
+		CounterReconciler: r,
+		ctx:               ctx,
+		counter:           instance,
+		reqLogger:         reqLogger,
+	}
+	crDeleted := instance.GetDeletionTimestamp() != nil
+
+	// Fetch the Infinispan cluster
+	infinispan := &v1.Infinispan{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ClusterName}, infinispan); err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Error(err, fmt.Sprintf("Infinispan cluster %s not found", instance.Spec.ClusterName))
+			if crDeleted {
+				return ctrl.Result{}, counter.removeFinalizer()
+			}
+			// No need to requeue request here as the Infinispan watch ensures that a request is queued when the cluster is updated
+			return ctrl.Result{}, counter.update(func() error {
+				instance.SetCondition(v2alpha1.CounterConditionReady, metav1.ConditionFalse, "")
+				return nil
+			})
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Cluster must be well formed
+	if !infinispan.IsWellFormed() {
+		reqLogger.Info(fmt.Sprintf("Infinispan cluster %s not well formed", infinispan.Name))
+		// No need to requeue request here as the Infinispan watch ensures that a request is queued when the cluster is updated
+		return ctrl.Result{}, nil
+	}
+
+	ispnClient, err := NewInfinispan(ctx, infinispan, r.versionManager, r.kubernetes)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to create Infinispan client: %w", err)
+	}
+	counters := ispnClient.Counters()
+	counterName := instance.GetCounterName()
+
+	if crDeleted {
+		if controllerutil.ContainsFinalizer(instance, constants.InfinispanFinalizer) {
+			// Remove deleted counters from the server before removing the Finalizer
+			if err := counters.Delete(counterName); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, counter.removeFinalizer()
+		}
+		return ctrl.Result{}, nil
+	}
+
+	value, err := counter.ispnCreateOrReset(counters)
+	if err != nil {
+		reqLogger.Error(err, "Unable to reconcile Counter")
+		return ctrl.Result{Requeue: true}, counter.update(func() error {
+			instance.SetCondition(v2alpha1.CounterConditionReady, metav1.ConditionFalse, err.Error())
+			return nil
+		})
+	}
+
+	err = counter.update(func() error {
+		instance.SetCondition(v2alpha1.CounterConditionReady, metav1.ConditionTrue, "")
+		instance.Status.Value = &value
+		if instance.Annotations != nil {
+			delete(instance.Annotations, constants.CounterAnnotationReset)
+		}
+		// Add finalizer so that the counter is removed on the server when the Counter CR is deleted
+		if !controllerutil.ContainsFinalizer(instance, constants.InfinispanFinalizer) {
+			controllerutil.AddFinalizer(instance, constants.InfinispanFinalizer)
+		}
+		return nil
+	})
+	// Periodically requeue so that the status reflects the live value of the counter
+	return ctrl.Result{RequeueAfter: constants.DefaultCounterStatusInterval}, err
+}
+
+// ispnCreateOrReset ensures that the counter exists on the server, resetting its value if requested, and returns the
+// current value of the counter
+func (r *counterRequest) ispnCreateOrReset(counters api.Counters) (int64, error) {
+	name := r.counter.GetCounterName()
+	value, exists, err := counters.Get(name)
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve counter '%s': %w", name, err)
+	}
+
+	if !exists {
+		r.reqLogger.Info("Creating counter", "counter", name)
+		if err := counters.Create(name, counterConfig(r.counter)); err != nil {
+			return 0, fmt.Errorf("unable to create counter '%s': %w", name, err)
+		}
+		return r.counter.Spec.InitialValue, nil
+	}
+
+	if _, reset := r.counter.Annotations[constants.CounterAnnotationReset]; reset {
+		r.reqLogger.Info("Resetting counter", "counter", name)
+		if err := counters.Reset(name); err != nil {
+			return 0, fmt.Errorf("unable to reset counter '%s': %w", name, err)
+		}
+		r.eventRec.Event(r.counter, "Normal", "CounterReset", fmt.Sprintf("Counter '%s' reset to its initial value", name))
+		if value, _, err = counters.Get(name); err != nil {
+			return 0, fmt.Errorf("unable to retrieve counter '%s': %w", name, err)
+		}
+	}
+	return value, nil
+}
+
+func (r *counterRequest) update(mutate func() error) error {
+	counter := r.counter
+	_, err := controllerutil.CreateOrPatch(r.ctx, r.Client, counter, func() error {
+		if counter.CreationTimestamp.IsZero() {
+			return errors.NewNotFound(schema.ParseGroupResource("counter.infinispan.org"), counter.Name)
+		}
+		return mutate()
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update counter %s: %w", counter.Name, err)
+	}
+	return nil
+}
+
+func (r *counterRequest) removeFinalizer() error {
+	if controllerutil.ContainsFinalizer(r.counter, constants.InfinispanFinalizer) {
+		return r.update(func() error {
+			controllerutil.RemoveFinalizer(r.counter, constants.InfinispanFinalizer)
+			return nil
+		})
+	}
+	return nil
+}
+
+func counterConfig(c *v2alpha1.Counter) *api.CounterConfig {
+	spec := c.Spec
+	config := &api.CounterConfig{
+		InitialValue: spec.InitialValue,
+		Storage:      api.CounterStorageVolatile,
+	}
+	if spec.Storage == v2alpha1.CounterStoragePersistent {
+		config.Storage = api.CounterStoragePersistent
+	}
+	if spec.Type == v2alpha1.CounterTypeWeak {
+		config.Type = api.CounterTypeWeak
+		config.ConcurrencyLevel = spec.ConcurrencyLevel
+	} else {
+		config.Type = api.CounterTypeStrong
+		config.LowerBound = spec.LowerBound
+		config.UpperBound = spec.UpperBound
+	}
+	return config
+}
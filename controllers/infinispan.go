@@ -17,6 +17,7 @@ import (
 
 	"github.com/go-logr/logr"
 	infinispanv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
 	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -74,14 +75,32 @@ func (r *InfinispanReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 		return err
 	}
 
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &infinispanv1.Infinispan{}, "spec.security.ldap.bindCredentialSecretName", func(obj client.Object) []string {
+		return []string{obj.(*infinispanv1.Infinispan).GetLDAPBindCredentialSecretName()}
+	}); err != nil {
+		return err
+	}
+
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &infinispanv1.Infinispan{}, "spec.security.ldap.caSecretName", func(obj client.Object) []string {
+		return []string{obj.(*infinispanv1.Infinispan).GetLDAPCASecretName()}
+	}); err != nil {
+		return err
+	}
+
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &infinispanv1.Infinispan{}, "spec.security.credentialStoreSource.vault.tokenSecretName", func(obj client.Object) []string {
+		return []string{obj.(*infinispanv1.Infinispan).GetCredentialStoreVaultTokenSecretName()}
+	}); err != nil {
+		return err
+	}
+
 	if err = mgr.GetFieldIndexer().IndexField(ctx, &infinispanv1.Infinispan{}, "spec.configMapName", func(obj client.Object) []string {
 		return []string{obj.(*infinispanv1.Infinispan).Spec.ConfigMapName}
 	}); err != nil {
 		return err
 	}
 
-	r.supportedTypes = make(map[schema.GroupVersionKind]struct{}, 3)
-	for _, gvk := range []schema.GroupVersionKind{infinispan.IngressGVK, infinispan.RouteGVK, infinispan.ServiceMonitorGVK} {
+	r.supportedTypes = make(map[schema.GroupVersionKind]struct{}, 4)
+	for _, gvk := range []schema.GroupVersionKind{infinispan.IngressGVK, infinispan.RouteGVK, infinispan.ServiceMonitorGVK, infinispan.CertificateGVK} {
 		// Validate that GroupVersionKind is supported on runtime platform
 		ok, err := kubernetes.IsGroupVersionKindSupported(gvk)
 		if err != nil {
@@ -147,7 +166,7 @@ func (r *InfinispanReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 					var requests []reconcile.Request
 					// Lookup only Secrets not controlled by Infinispan CR GVK. This means it's a custom defined Secret
 					if !kube.IsControlledByGVK(a.GetOwnerReferences(), infinispanv1.SchemeBuilder.GroupVersion.WithKind(reflect.TypeOf(infinispanv1.Infinispan{}).Name())) {
-						for _, field := range []string{"spec.security.endpointSecretName", "spec.security.credentialStoreSecretName", "spec.security.endpointEncryption.certSecretName", "spec.security.endpointEncryption.clientCertSecretName"} {
+						for _, field := range []string{"spec.security.endpointSecretName", "spec.security.credentialStoreSecretName", "spec.security.endpointEncryption.certSecretName", "spec.security.endpointEncryption.clientCertSecretName", "spec.security.ldap.bindCredentialSecretName", "spec.security.ldap.caSecretName", "spec.security.credentialStoreSource.vault.tokenSecretName"} {
 							ispnList := &infinispanv1.InfinispanList{}
 							if err := kubernetes.ResourcesListByField(a.GetNamespace(), field, a.GetName(), ispnList, ctx); err != nil {
 								r.log.Error(err, "failed to list Infinispan CR")
@@ -159,10 +178,60 @@ func (r *InfinispanReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 								return requests
 							}
 						}
+						// Password secrets of User CRs are applied to the servers of the User's cluster
+						userList := &v2alpha1.UserList{}
+						if err := kubernetes.ResourcesListByField(a.GetNamespace(), "spec.passwordSecretName", a.GetName(), userList, ctx); err != nil {
+							r.log.Error(err, "failed to list User CR")
+						}
+						for _, item := range userList.Items {
+							requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.Spec.ClusterName}})
+						}
+
+						// Credential secrets of Cache CR stores are added to the credential store of the Cache's cluster
+						cacheList := &v2alpha1.CacheList{}
+						if err := kubernetes.ResourcesListByField(a.GetNamespace(), "spec.persistence.connection.credentialsSecret", a.GetName(), cacheList, ctx); err != nil {
+							r.log.Error(err, "failed to list Cache CR")
+						}
+						for _, item := range cacheList.Items {
+							requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.Spec.ClusterName}})
+						}
+						return requests
 					}
 					return nil
 				}),
 		).
+		Watches(
+			&source.Kind{Type: &v2alpha1.Cache{}},
+			handler.EnqueueRequestsFromMapFunc(
+				func(a client.Object) []reconcile.Request {
+					// Only enqueue the cluster if the Cache store requires credentials from the credential store
+					cache := a.(*v2alpha1.Cache)
+					if cache.PersistenceConnection() == nil {
+						return nil
+					}
+					return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: cache.Namespace, Name: cache.Spec.ClusterName}}}
+				}),
+		).
+		Watches(
+			&source.Kind{Type: &v2alpha1.User{}},
+			handler.EnqueueRequestsFromMapFunc(
+				func(a client.Object) []reconcile.Request {
+					return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: a.GetNamespace(), Name: a.(*v2alpha1.User).Spec.ClusterName}}}
+				}),
+		).
+		// The cluster permission mapper is only configured whilst Role CRs exist for the cluster
+		Watches(
+			&source.Kind{Type: &v2alpha1.Role{}},
+			handler.EnqueueRequestsFromMapFunc(
+				func(a client.Object) []reconcile.Request {
+					return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: a.GetNamespace(), Name: a.(*v2alpha1.Role).Spec.ClusterName}}}
+				}),
+			builder.WithPredicates(
+				predicate.Funcs{
+					UpdateFunc: func(e event.UpdateEvent) bool { return false },
+				},
+			),
+		).
 		Watches(
 			&source.Kind{Type: &corev1.ConfigMap{}},
 			handler.EnqueueRequestsFromMapFunc(
@@ -195,6 +264,7 @@ func (r *InfinispanReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 // +kubebuilder:rbac:groups=core;events.k8s.io,namespace=infinispan-operator-system,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=core,namespace=infinispan-operator-system,resources=serviceaccounts,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,namespace=infinispan-operator-system,resources=roles;rolebindings,verbs=get;list;watch;create;delete;update
+// +kubebuilder:rbac:groups=coordination.k8s.io,namespace=infinispan-operator-system,resources=leases,verbs=get;list;watch;create;update;delete
 
 // +kubebuilder:rbac:groups=apps,namespace=infinispan-operator-system,resources=replicasets,verbs=get
 // +kubebuilder:rbac:groups=apps,namespace=infinispan-operator-system,resources=deployments;deployments/finalizers;statefulsets,verbs=get;list;watch;create;update;delete;patch
@@ -206,6 +276,8 @@ func (r *InfinispanReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 
 // +kubebuilder:rbac:groups=monitoring.coreos.com,namespace=infinispan-operator-system,resources=servicemonitors,verbs=get;list;watch;create;delete;update
 
+// +kubebuilder:rbac:groups=cert-manager.io,namespace=infinispan-operator-system,resources=certificates,verbs=get;list;watch;create;delete;update
+
 // +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions;customresourcedefinitions/status,verbs=get;list;watch
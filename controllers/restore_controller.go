@@ -6,6 +6,7 @@ import (
 
 	"github.com/infinispan/infinispan-operator/api/v2alpha1"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +29,7 @@ type restore struct {
 	instance *v2alpha1.Restore
 	client   client.Client
 	scheme   *runtime.Scheme
+	kube     *kube.Kubernetes
 	ctx      context.Context
 }
 
@@ -46,6 +48,7 @@ func (r *RestoreReconciler) ResourceInstance(ctx context.Context, name types.Nam
 		instance: instance,
 		client:   r.Client,
 		scheme:   ctrl.Scheme,
+		kube:     ctrl.Kube,
 		ctx:      ctx,
 	}
 	return restore, nil
@@ -93,6 +96,20 @@ func (r *restore) update(mutate func()) (bool, error) {
 }
 
 func (r *restore) Init() (*zeroCapacitySpec, error) {
+	if r.instance.Spec.ObjectStore != nil {
+		// The archive is downloaded from the object store to an ephemeral volume before the restore is executed
+		return &zeroCapacitySpec{
+			Container: r.instance.Spec.Container,
+			PodLabels: map[string]string{"restore_cr": r.instance.Name},
+			Volume: zeroCapacityVolumeSpec{
+				MountPath: BackupDataMountPath,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			},
+		}, nil
+	}
+
 	backup := &v2alpha1.Backup{}
 	backupKey := types.NamespacedName{
 		Namespace: r.instance.Namespace,
@@ -133,7 +150,7 @@ func (r *restore) Exec(client api.Infinispan) error {
 		}
 	}
 	config := &api.RestoreConfig{
-		Location:  fmt.Sprintf("%[1]s/%[2]s/%[2]s.zip", BackupDataMountPath, instance.Spec.Backup),
+		Location:  archivePath(instance.Spec.Backup),
 		Resources: resources,
 	}
 
@@ -143,11 +160,26 @@ func (r *restore) Exec(client api.Infinispan) error {
 	}
 
 	if status == api.StatusNotFound {
+		if objectStore := instance.Spec.ObjectStore; objectStore != nil {
+			config.Location = archivePath(instance.Name)
+			if err := r.download(objectStore, config.Location); err != nil {
+				return err
+			}
+		}
 		return client.Container().Restores().Create(instance.Name, config)
 	}
 	return nil
 }
 
+func (r *restore) download(objectStore *v2alpha1.RestoreObjectStoreSpec, file string) error {
+	restore := r.instance
+	store, err := newObjectStoreClient(r.ctx, r.kube, &objectStore.ObjectStoreSpec, restore.Namespace)
+	if err != nil {
+		return err
+	}
+	return downloadArchive(r.kube, store, restore.Name, restore.Namespace, file, objectStore.Key)
+}
+
 func (r *restore) ExecStatus(client api.Infinispan) (zeroCapacityPhase, error) {
 	name := r.instance.Name
 
@@ -0,0 +1,369 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/version"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// RoleReconciler reconciles a Role object
+type RoleReconciler struct {
+	client.Client
+	log            logr.Logger
+	scheme         *runtime.Scheme
+	kubernetes     *kube.Kubernetes
+	eventRec       record.EventRecorder
+	versionManager *version.Manager
+}
+
+type roleRequest struct {
+	*RoleReconciler
+	ctx       context.Context
+	role      *v2alpha1.Role
+	reqLogger logr.Logger
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RoleReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) (err error) {
+	r.Client = mgr.GetClient()
+	r.log = ctrl.Log.WithName("controllers").WithName("Role")
+	r.scheme = mgr.GetScheme()
+	r.kubernetes = kube.NewKubernetesFromController(mgr)
+	r.eventRec = mgr.GetEventRecorderFor("role-controller")
+
+	r.versionManager, err = version.ManagerFromEnv(v1.OperatorOperandVersionEnvVarName)
+	if err != nil {
+		return
+	}
+
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &v2alpha1.Role{}, "spec.clusterName", func(obj client.Object) []string {
+		return []string{obj.(*v2alpha1.Role).Spec.ClusterName}
+	}); err != nil {
+		return
+	}
+
+	builder := ctrl.NewControllerManagedBy(mgr).For(&v2alpha1.Role{})
+	builder.Watches(
+		&source.Kind{Type: &v1.Infinispan{}},
+		handler.EnqueueRequestsFromMapFunc(
+			func(a client.Object) []reconcile.Request {
+				i := a.(*v1.Infinispan)
+				// Only enqueue requests once a Infinispan CR has the WellFormed condition or it has been deleted
+				if !i.HasCondition(v1.ConditionWellFormed) || !a.GetDeletionTimestamp().IsZero() {
+					return nil
+				}
+
+				var requests []reconcile.Request
+				roleList := &v2alpha1.RoleList{}
+				if err := r.kubernetes.ResourcesListByField(i.GetNamespace(), "spec.clusterName", i.GetName(), roleList, ctx); err != nil {
+					r.log.Error(err, "watches.Infinispan: failed to list Role CRs")
+				}
+
+				for _, item := range roleList.Items {
+					requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+				}
+				return requests
+			}),
+	)
+	builder.Watches(
+		&source.Kind{Type: &v2alpha1.Cache{}},
+		handler.EnqueueRequestsFromMapFunc(
+			func(a client.Object) []reconcile.Request {
+				cache := a.(*v2alpha1.Cache)
+				var requests []reconcile.Request
+				roleList := &v2alpha1.RoleList{}
+				if err := r.kubernetes.ResourcesListByField(a.GetNamespace(), "spec.clusterName", cache.Spec.ClusterName, roleList, ctx); err != nil {
+					r.log.Error(err, "watches.Cache: failed to list Role CRs")
+				}
+
+				// Enqueue the roles that are scoped to the cache, or were previously applied to it
+				for _, item := range roleList.Items {
+					if contains(item.GetScopedCaches(), cache.GetCacheName()) || contains(item.Status.Caches, cache.GetCacheName()) {
+						requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+					}
+				}
+				return requests
+			}),
+	)
+	return builder.Complete(r)
+}
+
+// +kubebuilder:rbac:groups=infinispan.org,namespace=infinispan-operator-system,resources=roles;roles/status;roles/finalizers,verbs=get;list;watch;create;update;patch;delete
+
+func (r *RoleReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("+++++ Reconciling Role.")
+	defer reqLogger.Info("----- End Reconciling Role.")
+
+	// Fetch the Role instance
+	instance := &v2alpha1.Role{}
+	if err := r.Client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Info("Role resource not found. Ignoring it since it has been deleted")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	role := &roleRequest{
+		RoleReconciler: r,
+		ctx:            ctx,
+		role:           instance,
+		reqLogger:      reqLogger,
+	}
+	crDeleted := instance.GetDeletionTimestamp() != nil
+
+	// Fetch the Infinispan cluster
+	infinispan := &v1.Infinispan{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ClusterName}, infinispan); err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Error(err, fmt.Sprintf("Infinispan cluster %s not found", instance.Spec.ClusterName))
+			if crDeleted {
+				return ctrl.Result{}, role.removeFinalizer()
+			}
+			// No need to requeue request here as the Infinispan watch ensures that a request is queued when the cluster is updated
+			return ctrl.Result{}, role.update(func() error {
+				instance.SetCondition(v2alpha1.RoleConditionReady, metav1.ConditionFalse, "")
+				return nil
+			})
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !infinispan.IsAuthorizationEnabled() {
+		if crDeleted {
+			return ctrl.Result{}, role.removeFinalizer()
+		}
+		msg := "Authorization must be enabled with 'spec.security.authorization.enabled=true'"
+		return ctrl.Result{}, role.update(func() error {
+			instance.SetCondition(v2alpha1.RoleConditionReady, metav1.ConditionFalse, msg)
+			return nil
+		})
+	}
+
+	// Cluster must be well formed
+	if !infinispan.IsWellFormed() {
+		reqLogger.Info(fmt.Sprintf("Infinispan cluster %s not well formed", infinispan.Name))
+		// No need to requeue request here as the Infinispan watch ensures that a request is queued when the cluster is updated
+		return ctrl.Result{}, nil
+	}
+
+	ispnClient, err := NewInfinispan(ctx, infinispan, r.versionManager, r.kubernetes)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to create Infinispan client: %w", err)
+	}
+
+	if crDeleted {
+		if controllerutil.ContainsFinalizer(instance, constants.InfinispanFinalizer) {
+			// The Cache controller removes deleted roles from the cache configurations, which must no longer reference
+			// the role when it is removed from the server
+			caches, err := role.appliedCaches()
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if len(caches) > 0 {
+				reqLogger.Info("Waiting for the role to be removed from caches", "caches", caches)
+				// No need to requeue request here as the Cache watch ensures that a request is queued when a cache is updated
+				return ctrl.Result{}, nil
+			}
+			// Remove deleted roles from the server before removing the Finalizer so that any granted access is revoked
+			if err := role.ispnDelete(ispnClient); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, role.removeFinalizer()
+		}
+		return ctrl.Result{}, nil
+	}
+
+	permissions := make([]string, len(instance.Spec.Permissions))
+	for i, p := range instance.Spec.Permissions {
+		permissions[i] = string(p)
+	}
+	permissions = sortedCopy(permissions)
+	principals := sortedCopy(instance.Spec.Principals)
+
+	if err := role.ispnApply(ispnClient, permissions, principals); err != nil {
+		reqLogger.Error(err, "Unable to reconcile Role")
+		return ctrl.Result{Requeue: true}, role.update(func() error {
+			instance.SetCondition(v2alpha1.RoleConditionReady, metav1.ConditionFalse, err.Error())
+			return nil
+		})
+	}
+
+	// The Cache controller adds the role to the configuration of the Cache CRs that it is scoped to, once the role has
+	// been created on the server
+	caches, err := role.appliedCaches()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var msg string
+	for _, cache := range instance.GetScopedCaches() {
+		if !contains(caches, cache) {
+			msg = fmt.Sprintf("Waiting for the role to be applied to the Cache CR of cache '%s'", cache)
+			break
+		}
+	}
+
+	return ctrl.Result{}, role.update(func() error {
+		if msg == "" {
+			instance.SetCondition(v2alpha1.RoleConditionReady, metav1.ConditionTrue, "")
+		} else {
+			instance.SetCondition(v2alpha1.RoleConditionReady, metav1.ConditionFalse, msg)
+		}
+		instance.Status.Permissions = make([]v2alpha1.RolePermission, len(permissions))
+		for i, p := range permissions {
+			instance.Status.Permissions[i] = v2alpha1.RolePermission(p)
+		}
+		instance.Status.Caches = caches
+		instance.Status.Principals = principals
+		// Add finalizer so that the role is removed on the server when the Role CR is deleted
+		if !controllerutil.ContainsFinalizer(instance, constants.InfinispanFinalizer) {
+			controllerutil.AddFinalizer(instance, constants.InfinispanFinalizer)
+		}
+		return nil
+	})
+}
+
+// ispnApply creates or updates the role on the server, before reconciling the principals it is granted to with the
+// state recorded in the Role status
+func (r *roleRequest) ispnApply(ispnClient api.Infinispan, permissions, principals []string) error {
+	name := r.role.GetRoleName()
+	roles := ispnClient.Roles()
+
+	info, exists, err := roles.Get(name)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve role '%s': %w", name, err)
+	}
+
+	if !exists {
+		r.reqLogger.Info("Creating role", "role", name)
+		if err := roles.Create(name, permissions); err != nil {
+			return fmt.Errorf("unable to create role '%s': %w", name, err)
+		}
+		r.eventRec.Event(r.role, corev1.EventTypeNormal, "RoleCreated", fmt.Sprintf("Role '%s' created", name))
+	} else if !reflect.DeepEqual(sortedCopy(info.Permissions), permissions) {
+		r.reqLogger.Info("Updating role permissions", "role", name)
+		if err := roles.Update(name, permissions); err != nil {
+			return fmt.Errorf("unable to update role '%s': %w", name, err)
+		}
+		r.eventRec.Event(r.role, corev1.EventTypeNormal, "RoleUpdated", fmt.Sprintf("Role '%s' updated", name))
+	}
+
+	for _, principal := range difference(principals, r.role.Status.Principals) {
+		if err := roles.Grant(principal, name); err != nil {
+			return fmt.Errorf("unable to grant role '%s' to '%s': %w", name, principal, err)
+		}
+	}
+
+	for _, principal := range difference(r.role.Status.Principals, principals) {
+		if err := roles.Deny(principal, name); err != nil {
+			return fmt.Errorf("unable to deny role '%s' to '%s': %w", name, principal, err)
+		}
+	}
+
+	if err := roles.FlushCache(); err != nil {
+		return fmt.Errorf("unable to flush ACL cache: %w", err)
+	}
+	return nil
+}
+
+// ispnDelete revokes the role from all principals it was granted to, before removing it from the server
+func (r *roleRequest) ispnDelete(ispnClient api.Infinispan) error {
+	name := r.role.GetRoleName()
+	roles := ispnClient.Roles()
+
+	for _, principal := range r.role.Status.Principals {
+		if err := roles.Deny(principal, name); err != nil {
+			return fmt.Errorf("unable to deny role '%s' to '%s': %w", name, principal, err)
+		}
+	}
+
+	if err := roles.Delete(name); err != nil {
+		return fmt.Errorf("unable to delete role '%s': %w", name, err)
+	}
+
+	if err := roles.FlushCache(); err != nil {
+		return fmt.Errorf("unable to flush ACL cache: %w", err)
+	}
+	return nil
+}
+
+// appliedCaches returns the sorted names of the caches whose Cache CR has applied the role to the cache configuration
+func (r *roleRequest) appliedCaches() ([]string, error) {
+	cacheList := &v2alpha1.CacheList{}
+	if err := r.kubernetes.ResourcesListByField(r.role.Namespace, "spec.clusterName", r.role.Spec.ClusterName, cacheList, r.ctx); err != nil {
+		return nil, fmt.Errorf("unable to list Cache CRs: %w", err)
+	}
+
+	var caches []string
+	for _, cache := range cacheList.Items {
+		if contains(cache.Status.AuthorizationRoles, r.role.GetRoleName()) {
+			caches = append(caches, cache.GetCacheName())
+		}
+	}
+	return sortedCopy(caches), nil
+}
+
+func (r *roleRequest) update(mutate func() error) error {
+	role := r.role
+	_, err := controllerutil.CreateOrPatch(r.ctx, r.Client, role, func() error {
+		if role.CreationTimestamp.IsZero() {
+			return errors.NewNotFound(schema.ParseGroupResource("role.infinispan.org"), role.Name)
+		}
+		return mutate()
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update role %s: %w", role.Name, err)
+	}
+	return nil
+}
+
+func (r *roleRequest) removeFinalizer() error {
+	if controllerutil.ContainsFinalizer(r.role, constants.InfinispanFinalizer) {
+		return r.update(func() error {
+			controllerutil.RemoveFinalizer(r.role, constants.InfinispanFinalizer)
+			return nil
+		})
+	}
+	return nil
+}
+
+// difference returns the elements of a that are not present in b
+func difference(a, b []string) (diff []string) {
+	for _, s := range a {
+		if !contains(b, s) {
+			diff = append(diff, s)
+		}
+	}
+	return
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
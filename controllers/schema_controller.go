@@ -0,0 +1,470 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/iancoleman/strcase"
+	v1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/version"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// SchemaReconciler reconciles a Schema object
+type SchemaReconciler struct {
+	client.Client
+	log            logr.Logger
+	scheme         *runtime.Scheme
+	kubernetes     *kube.Kubernetes
+	eventRec       record.EventRecorder
+	versionManager *version.Manager
+}
+
+type SchemaListener struct {
+	// The Infinispan cluster to listen to in the configured namespace
+	Infinispan     *v1.Infinispan
+	Ctx            context.Context
+	Kubernetes     *kube.Kubernetes
+	Log            *zap.SugaredLogger
+	VersionManager *version.Manager
+}
+
+type schemaRequest struct {
+	*SchemaReconciler
+	ctx       context.Context
+	schema    *v2alpha1.Schema
+	reqLogger logr.Logger
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SchemaReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) (err error) {
+	r.Client = mgr.GetClient()
+	r.log = ctrl.Log.WithName("controllers").WithName("Schema")
+	r.scheme = mgr.GetScheme()
+	r.kubernetes = kube.NewKubernetesFromController(mgr)
+	r.eventRec = mgr.GetEventRecorderFor("schema-controller")
+
+	r.versionManager, err = version.ManagerFromEnv(v1.OperatorOperandVersionEnvVarName)
+	if err != nil {
+		return
+	}
+
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &v2alpha1.Schema{}, "spec.clusterName", func(obj client.Object) []string {
+		return []string{obj.(*v2alpha1.Schema).Spec.ClusterName}
+	}); err != nil {
+		return
+	}
+
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &v2alpha1.Schema{}, "spec.configMap.name", func(obj client.Object) []string {
+		if cm := obj.(*v2alpha1.Schema).Spec.ConfigMap; cm != nil {
+			return []string{cm.Name}
+		}
+		return nil
+	}); err != nil {
+		return
+	}
+
+	enqueueSchemas := func(field string) handler.MapFunc {
+		return func(a client.Object) []reconcile.Request {
+			var requests []reconcile.Request
+			schemaList := &v2alpha1.SchemaList{}
+			if err := r.kubernetes.ResourcesListByField(a.GetNamespace(), field, a.GetName(), schemaList, ctx); err != nil {
+				r.log.Error(err, "watches failed to list Schema CRs")
+			}
+
+			for _, item := range schemaList.Items {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+			}
+			return requests
+		}
+	}
+
+	builder := ctrl.NewControllerManagedBy(mgr).For(&v2alpha1.Schema{})
+	builder.Watches(
+		&source.Kind{Type: &v1.Infinispan{}},
+		handler.EnqueueRequestsFromMapFunc(
+			func(a client.Object) []reconcile.Request {
+				i := a.(*v1.Infinispan)
+				// Only enqueue requests once a Infinispan CR has the WellFormed condition or it has been deleted
+				if !i.HasCondition(v1.ConditionWellFormed) || !a.GetDeletionTimestamp().IsZero() {
+					return nil
+				}
+				return enqueueSchemas("spec.clusterName")(a)
+			}),
+	)
+	builder.Watches(
+		&source.Kind{Type: &corev1.ConfigMap{}},
+		handler.EnqueueRequestsFromMapFunc(enqueueSchemas("spec.configMap.name")),
+	)
+	return builder.Complete(r)
+}
+
+// +kubebuilder:rbac:groups=infinispan.org,namespace=infinispan-operator-system,resources=schemas;schemas/status;schemas/finalizers,verbs=get;list;watch;create;update;patch;delete
+
+func (r *SchemaReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("+++++ Reconciling Schema.")
+	defer reqLogger.Info("----- End Reconciling Schema.")
+
+	// Fetch the Schema instance
+	instance := &v2alpha1.Schema{}
+	if err := r.Client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Info("Schema resource not found. Ignoring it since it has been deleted")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	schema := &schemaRequest{
+		SchemaReconciler: r,
+		ctx:              ctx,
+		schema:           instance,
+		reqLogger:        reqLogger,
+	}
+
+	if schema.markedForDeletion() {
+		reqLogger.Info("Schema CR marked for deletion. Attempting to remove.")
+		// The ConfigListener has marked this resource for deletion as the schema no longer exists on the server
+		if err := schema.removeFinalizer(); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		if err := r.Client.Delete(ctx, instance); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		reqLogger.Info("Schema CR Removed.")
+		return ctrl.Result{}, nil
+	}
+
+	crDeleted := instance.GetDeletionTimestamp() != nil
+
+	// Fetch the Infinispan cluster
+	infinispan := &v1.Infinispan{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ClusterName}, infinispan); err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Error(err, fmt.Sprintf("Infinispan cluster %s not found", instance.Spec.ClusterName))
+			if crDeleted {
+				return ctrl.Result{}, schema.removeFinalizer()
+			}
+			// No need to requeue request here as the Infinispan watch ensures that a request is queued when the cluster is updated
+			return ctrl.Result{}, schema.update(func() error {
+				instance.SetCondition(v2alpha1.SchemaConditionReady, metav1.ConditionFalse, "")
+				return nil
+			})
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Cluster must be well formed
+	if !infinispan.IsWellFormed() {
+		reqLogger.Info(fmt.Sprintf("Infinispan cluster %s not well formed", infinispan.Name))
+		// No need to requeue request here as the Infinispan watch ensures that a request is queued when the cluster is updated
+		return ctrl.Result{}, nil
+	}
+
+	ispnClient, err := NewInfinispan(ctx, infinispan, r.versionManager, r.kubernetes)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to create Infinispan client: %w", err)
+	}
+	schemas := ispnClient.Schemas()
+	schemaName := instance.GetSchemaName()
+
+	if crDeleted {
+		if controllerutil.ContainsFinalizer(instance, constants.InfinispanFinalizer) {
+			// Remove deleted schemas from the server before removing the Finalizer
+			if err := schemas.Delete(schemaName); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, schema.removeFinalizer()
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Don't contact the Infinispan server for resources created by the ConfigListener
+	if schema.reconcileOnServer() {
+		content, err := schema.content()
+		if err != nil {
+			reqLogger.Error(err, "Unable to load Schema content")
+			// No need to requeue request here as the ConfigMap watch ensures that a request is queued when the ConfigMap is created
+			return ctrl.Result{}, schema.update(func() error {
+				instance.SetCondition(v2alpha1.SchemaConditionReady, metav1.ConditionFalse, err.Error())
+				return nil
+			})
+		}
+
+		if err := schema.ispnCreateOrUpdate(schemas, content); err != nil {
+			return ctrl.Result{Requeue: true}, schema.update(func() error {
+				instance.SetCondition(v2alpha1.SchemaConditionReady, metav1.ConditionFalse, err.Error())
+				return nil
+			})
+		}
+	}
+
+	// Retrieve the validation status of the schema, as errors may be resolved or introduced by other schemas
+	validationErr, err := schema.validationError(schemas)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = schema.update(func() error {
+		if validationErr != nil {
+			instance.SetCondition(v2alpha1.SchemaConditionReady, metav1.ConditionFalse, validationErr.Error())
+			instance.Status.ValidationError = &v2alpha1.SchemaValidationError{
+				Message: validationErr.Message,
+				Cause:   validationErr.Cause,
+			}
+		} else {
+			instance.SetCondition(v2alpha1.SchemaConditionReady, metav1.ConditionTrue, "")
+			instance.Status.ValidationError = nil
+		}
+		// Add finalizer so that the schema is removed on the server when the Schema CR is deleted
+		if !controllerutil.ContainsFinalizer(instance, constants.InfinispanFinalizer) {
+			controllerutil.AddFinalizer(instance, constants.InfinispanFinalizer)
+		}
+		return nil
+	})
+	if err == nil && validationErr != nil {
+		// Periodically re-check the schema as errors caused by missing dependencies are resolved once they are registered
+		return ctrl.Result{RequeueAfter: constants.DefaultWaitOnCluster}, nil
+	}
+	return ctrl.Result{}, err
+}
+
+// content returns the schema definition, either defined inline or loaded from the referenced ConfigMap
+func (r *schemaRequest) content() (string, error) {
+	spec := r.schema.Spec
+	if spec.ConfigMap == nil {
+		return spec.Schema, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.Client.Get(r.ctx, types.NamespacedName{Namespace: r.schema.Namespace, Name: spec.ConfigMap.Name}, configMap); err != nil {
+		return "", fmt.Errorf("unable to load ConfigMap '%s': %w", spec.ConfigMap.Name, err)
+	}
+	content, exists := configMap.Data[spec.ConfigMap.Key]
+	if !exists {
+		return "", fmt.Errorf("key '%s' not found in ConfigMap '%s'", spec.ConfigMap.Key, spec.ConfigMap.Name)
+	}
+	return content, nil
+}
+
+func (r *schemaRequest) ispnCreateOrUpdate(schemas api.Schemas, content string) error {
+	name := r.schema.GetSchemaName()
+	existing, exists, err := schemas.Get(name)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve schema '%s': %w", name, err)
+	}
+
+	if exists && existing == content {
+		r.reqLogger.Info("schema has not changed, ignoring update")
+		return nil
+	}
+
+	r.reqLogger.Info("Registering schema", "schema", name)
+	if _, err := schemas.CreateOrUpdate(name, content); err != nil {
+		return fmt.Errorf("unable to register schema '%s': %w", name, err)
+	}
+	return nil
+}
+
+func (r *schemaRequest) validationError(schemas api.Schemas) (*api.SchemaError, error) {
+	name := r.schema.GetSchemaName()
+	list, err := schemas.List()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve schema validation status: %w", err)
+	}
+	for _, s := range list {
+		if s.Name == name {
+			return s.Error, nil
+		}
+	}
+	return nil, fmt.Errorf("schema '%s' not found on the server", name)
+}
+
+func (r *schemaRequest) update(mutate func() error) error {
+	return updateSchema(r.schema, r.ctx, r.Client, mutate)
+}
+
+// Determine if reconciliation was triggered by the ConfigListener
+func (r *schemaRequest) reconcileOnServer() bool {
+	if val, exists := r.schema.ObjectMeta.Annotations[constants.ListenerAnnotationGeneration]; exists {
+		generation, _ := strconv.ParseInt(val, 10, 64)
+		return generation < r.schema.GetGeneration()
+	}
+	return true
+}
+
+func (r *schemaRequest) markedForDeletion() bool {
+	_, exists := r.schema.ObjectMeta.Annotations[constants.ListenerAnnotationDelete]
+	return exists
+}
+
+func (r *schemaRequest) removeFinalizer() error {
+	if controllerutil.ContainsFinalizer(r.schema, constants.InfinispanFinalizer) {
+		return r.update(func() error {
+			controllerutil.RemoveFinalizer(r.schema, constants.InfinispanFinalizer)
+			return nil
+		})
+	}
+	return nil
+}
+
+// Sync creates Schema CRs for schemas that have been registered on the server by other means, e.g. the CLI, updates
+// the CRs of schemas that have been modified on the server and marks CRs for deletion when the schema has been removed
+func (sl *SchemaListener) Sync() error {
+	k8sClient := sl.Kubernetes.Client
+	ispnClient, err := NewInfinispan(sl.Ctx, sl.Infinispan, sl.VersionManager, sl.Kubernetes)
+	if err != nil {
+		return fmt.Errorf("unable to create Infinispan client: %w", err)
+	}
+	schemas := ispnClient.Schemas()
+
+	serverSchemas, err := schemas.List()
+	if err != nil {
+		return err
+	}
+
+	schemaList := &v2alpha1.SchemaList{}
+	if err := k8sClient.List(sl.Ctx, schemaList, &client.ListOptions{Namespace: sl.Infinispan.Namespace}); err != nil {
+		return fmt.Errorf("unable to retrieve existing Schema resources: %w", err)
+	}
+
+	existing := make(map[string]*v2alpha1.Schema, len(schemaList.Items))
+	for i := range schemaList.Items {
+		s := &schemaList.Items[i]
+		if s.Spec.ClusterName == sl.Infinispan.Name {
+			existing[s.GetSchemaName()] = s
+		}
+	}
+
+	for _, info := range serverSchemas {
+		name := info.Name
+		content, exists, err := schemas.Get(name)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		if err := sl.createOrUpdate(name, content, existing[name]); err != nil {
+			sl.Log.Errorf("unable to import schema '%s': %v", name, err)
+		}
+		delete(existing, name)
+	}
+
+	// Remaining listener created CRs no longer have a schema definition on the server
+	for _, s := range existing {
+		if !kube.IsOwnedBy(s, sl.Infinispan) || !s.DeletionTimestamp.IsZero() {
+			continue
+		}
+		sl.Log.Infof("Marking stale Schema resource '%s' for deletion", s.Name)
+		err := updateSchema(s, sl.Ctx, k8sClient, func() error {
+			if s.ObjectMeta.Annotations == nil {
+				s.ObjectMeta.Annotations = make(map[string]string, 1)
+			}
+			s.ObjectMeta.Annotations[constants.ListenerAnnotationDelete] = "true"
+			return nil
+		})
+		if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to mark Schema '%s' for deletion: %w", s.Name, err)
+		}
+	}
+	return nil
+}
+
+var schemaNameRegexp = regexp.MustCompile("[^-a-z0-9]")
+
+// ImportedSchemaName returns the name of the Schema CR created for a schema that was defined directly on the server,
+// i.e. "<cluster>-<schema name without the .proto suffix>"
+func ImportedSchemaName(clusterName, schemaName string) string {
+	sanitizedName := schemaNameRegexp.ReplaceAllString(strcase.ToKebab(strings.TrimSuffix(schemaName, ".proto")), "-")
+	return fmt.Sprintf("%s-%s", clusterName, sanitizedName)
+}
+
+func (sl *SchemaListener) createOrUpdate(name, content string, schema *v2alpha1.Schema) error {
+	k8sClient := sl.Kubernetes.Client
+	if schema == nil {
+		// The name is deterministic so that Cache CRs can declare a dependency on imported schemas
+		crName := ImportedSchemaName(sl.Infinispan.Name, name)
+		if errs := validation.IsDNS1123Subdomain(crName); len(errs) > 0 {
+			return fmt.Errorf("unable to create Schema Resource Name for schema=%s, cluster=%s: %s", name, sl.Infinispan.Name, strings.Join(errs, "."))
+		}
+
+		schema = &v2alpha1.Schema{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      crName,
+				Namespace: sl.Infinispan.Namespace,
+				Annotations: map[string]string{
+					constants.ListenerAnnotationGeneration: "1",
+				},
+			},
+			Spec: v2alpha1.SchemaSpec{
+				ClusterName: sl.Infinispan.Name,
+				Name:        name,
+				Schema:      content,
+			},
+		}
+		controllerutil.AddFinalizer(schema, constants.InfinispanFinalizer)
+		if err := controllerutil.SetOwnerReference(sl.Infinispan, schema, k8sClient.Scheme()); err != nil {
+			return err
+		}
+
+		sl.Log.Infof("Creating Schema CR for '%s'", name)
+		if err := k8sClient.Create(sl.Ctx, schema); err != nil {
+			return fmt.Errorf("unable to create Schema CR for schema '%s': %w", name, err)
+		}
+		sl.Log.Infof("Schema CR '%s' created", schema.Name)
+		return nil
+	}
+
+	// Schemas defined via a ConfigMap are owned by the user, so the CR is not updated to reflect server changes
+	if schema.Spec.ConfigMap != nil || schema.Spec.Schema == content {
+		return nil
+	}
+
+	sl.Log.Infof("Updating Schema CR '%s'", schema.Name)
+	return updateSchema(schema, sl.Ctx, k8sClient, func() error {
+		if schema.ObjectMeta.Annotations == nil {
+			schema.ObjectMeta.Annotations = make(map[string]string, 1)
+		}
+		controllerutil.AddFinalizer(schema, constants.InfinispanFinalizer)
+		schema.ObjectMeta.Annotations[constants.ListenerAnnotationGeneration] = strconv.FormatInt(schema.GetGeneration()+1, 10)
+		schema.Spec.Schema = content
+		return nil
+	})
+}
+
+func updateSchema(s *v2alpha1.Schema, ctx context.Context, client client.Client, mutate func() error) error {
+	_, err := controllerutil.CreateOrPatch(ctx, client, s, func() error {
+		if s.CreationTimestamp.IsZero() {
+			return errors.NewNotFound(schema.ParseGroupResource("schema.infinispan.org"), s.Name)
+		}
+		return mutate()
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update schema %s: %w", s.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// UserReconciler reconciles a User object
+type UserReconciler struct {
+	client.Client
+	log        logr.Logger
+	scheme     *runtime.Scheme
+	kubernetes *kube.Kubernetes
+	eventRec   record.EventRecorder
+}
+
+type userRequest struct {
+	*UserReconciler
+	ctx       context.Context
+	user      *v2alpha1.User
+	reqLogger logr.Logger
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *UserReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) (err error) {
+	r.Client = mgr.GetClient()
+	r.log = ctrl.Log.WithName("controllers").WithName("User")
+	r.scheme = mgr.GetScheme()
+	r.kubernetes = kube.NewKubernetesFromController(mgr)
+	r.eventRec = mgr.GetEventRecorderFor("user-controller")
+
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &v2alpha1.User{}, "spec.clusterName", func(obj client.Object) []string {
+		return []string{obj.(*v2alpha1.User).Spec.ClusterName}
+	}); err != nil {
+		return
+	}
+
+	if err = mgr.GetFieldIndexer().IndexField(ctx, &v2alpha1.User{}, "spec.passwordSecretName", func(obj client.Object) []string {
+		return []string{obj.(*v2alpha1.User).Spec.PasswordSecretName}
+	}); err != nil {
+		return
+	}
+
+	enqueueUsers := func(field string) handler.MapFunc {
+		return func(a client.Object) []reconcile.Request {
+			var requests []reconcile.Request
+			userList := &v2alpha1.UserList{}
+			if err := r.kubernetes.ResourcesListByField(a.GetNamespace(), field, a.GetName(), userList, ctx); err != nil {
+				r.log.Error(err, "watches failed to list User CRs")
+			}
+
+			for _, item := range userList.Items {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+			}
+			return requests
+		}
+	}
+
+	builder := ctrl.NewControllerManagedBy(mgr).For(&v2alpha1.User{})
+	builder.Watches(
+		&source.Kind{Type: &v1.Infinispan{}},
+		handler.EnqueueRequestsFromMapFunc(
+			func(a client.Object) []reconcile.Request {
+				i := a.(*v1.Infinispan)
+				// Only enqueue requests once a Infinispan CR has the WellFormed condition or it has been deleted
+				if !i.HasCondition(v1.ConditionWellFormed) || !a.GetDeletionTimestamp().IsZero() {
+					return nil
+				}
+				return enqueueUsers("spec.clusterName")(a)
+			}),
+	)
+	builder.Watches(
+		&source.Kind{Type: &corev1.Secret{}},
+		handler.EnqueueRequestsFromMapFunc(enqueueUsers("spec.passwordSecretName")),
+	)
+	return builder.Complete(r)
+}
+
+// +kubebuilder:rbac:groups=infinispan.org,namespace=infinispan-operator-system,resources=users;users/status;users/finalizers,verbs=get;list;watch;create;update;patch;delete
+
+func (r *UserReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("+++++ Reconciling User.")
+	defer reqLogger.Info("----- End Reconciling User.")
+
+	// Fetch the User instance
+	instance := &v2alpha1.User{}
+	if err := r.Client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Info("User resource not found. Ignoring it since it has been deleted")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	user := &userRequest{
+		UserReconciler: r,
+		ctx:            ctx,
+		user:           instance,
+		reqLogger:      reqLogger,
+	}
+	crDeleted := instance.GetDeletionTimestamp() != nil
+
+	// Fetch the Infinispan cluster
+	infinispan := &v1.Infinispan{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ClusterName}, infinispan); err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Error(err, fmt.Sprintf("Infinispan cluster %s not found", instance.Spec.ClusterName))
+			if crDeleted {
+				return ctrl.Result{}, user.removeFinalizer()
+			}
+			// No need to requeue request here as the Infinispan watch ensures that a request is queued when the cluster is updated
+			return ctrl.Result{}, user.update(func() error {
+				instance.SetCondition(v2alpha1.UserConditionReady, metav1.ConditionFalse, "")
+				return nil
+			})
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Users can only be managed when the default security realm authenticates users with its property files
+	if !infinispan.IsPropertiesRealmEnabled() {
+		if crDeleted {
+			return ctrl.Result{}, user.removeFinalizer()
+		}
+		msg := fmt.Sprintf("Infinispan cluster %s does not authenticate users with credentials managed by the operator", infinispan.Name)
+		return ctrl.Result{}, user.update(func() error {
+			instance.SetCondition(v2alpha1.UserConditionReady, metav1.ConditionFalse, msg)
+			return nil
+		})
+	}
+
+	if crDeleted {
+		// The Infinispan controller removes the Finalizer once the user has been removed from every server
+		return ctrl.Result{}, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.PasswordSecretName}, secret); err != nil {
+		if errors.IsNotFound(err) {
+			// No need to requeue request here as the Secret watch ensures that a request is queued when the secret is created
+			msg := fmt.Sprintf("Password secret '%s' not found", instance.Spec.PasswordSecretName)
+			return ctrl.Result{}, user.update(func() error {
+				instance.SetCondition(v2alpha1.UserConditionReady, metav1.ConditionFalse, msg)
+				return nil
+			})
+		}
+		return ctrl.Result{}, err
+	}
+
+	password, ok := secret.Data[constants.UserPasswordKey]
+	if !ok || len(password) == 0 {
+		msg := fmt.Sprintf("The '%s' key must be provided in password secret '%s'", constants.UserPasswordKey, secret.Name)
+		return ctrl.Result{}, user.update(func() error {
+			instance.SetCondition(v2alpha1.UserConditionReady, metav1.ConditionFalse, msg)
+			return nil
+		})
+	}
+
+	// The Infinispan controller applies the user to every server and then updates the status of the User CR, so the
+	// user is only Ready once its current groups and password have been applied
+	applied := reflect.DeepEqual(instance.Status.Groups, sortedCopy(instance.Spec.Groups)) &&
+		instance.Status.PasswordSecretVersion == secret.ResourceVersion
+	return ctrl.Result{}, user.update(func() error {
+		if !applied {
+			msg := fmt.Sprintf("Waiting for the user to be applied to the servers of Infinispan cluster %s", infinispan.Name)
+			instance.SetCondition(v2alpha1.UserConditionReady, metav1.ConditionFalse, msg)
+		}
+		// Add finalizer so that the user is removed on the server when the User CR is deleted
+		if !controllerutil.ContainsFinalizer(instance, constants.InfinispanFinalizer) {
+			controllerutil.AddFinalizer(instance, constants.InfinispanFinalizer)
+		}
+		return nil
+	})
+}
+
+func (r *userRequest) update(mutate func() error) error {
+	user := r.user
+	_, err := controllerutil.CreateOrPatch(r.ctx, r.Client, user, func() error {
+		if user.CreationTimestamp.IsZero() {
+			return errors.NewNotFound(schema.ParseGroupResource("user.infinispan.org"), user.Name)
+		}
+		return mutate()
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update user %s: %w", user.Name, err)
+	}
+	return nil
+}
+
+func (r *userRequest) removeFinalizer() error {
+	if controllerutil.ContainsFinalizer(r.user, constants.InfinispanFinalizer) {
+		return r.update(func() error {
+			controllerutil.RemoveFinalizer(r.user, constants.InfinispanFinalizer)
+			return nil
+		})
+	}
+	return nil
+}
+
+// sortedCopy returns a sorted copy of the provided slice, or nil if the slice is empty
+func sortedCopy(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	c := append([]string(nil), s...)
+	sort.Strings(c)
+	return c
+}
@@ -0,0 +1,358 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/infinispan/infinispan-operator/api/v1"
+	v2 "github.com/infinispan/infinispan-operator/api/v2alpha1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/version"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// XSiteOperationReconciler reconciles a XSiteOperation object
+type XSiteOperationReconciler struct {
+	client.Client
+	log            logr.Logger
+	scheme         *runtime.Scheme
+	kubernetes     *kube.Kubernetes
+	eventRec       record.EventRecorder
+	versionManager *version.Manager
+}
+
+// Struct for wrapping reconcile request data
+type xsiteOperationRequest struct {
+	*XSiteOperationReconciler
+	ctx       context.Context
+	operation *v2.XSiteOperation
+	reqLogger logr.Logger
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *XSiteOperationReconciler) SetupWithManager(mgr ctrl.Manager) (err error) {
+	r.Client = mgr.GetClient()
+	r.log = ctrl.Log.WithName("controllers").WithName("XSiteOperation")
+	r.scheme = mgr.GetScheme()
+	r.kubernetes = kube.NewKubernetesFromController(mgr)
+	r.eventRec = mgr.GetEventRecorderFor("xsiteoperation-controller")
+
+	r.versionManager, err = version.ManagerFromEnv(v1.OperatorOperandVersionEnvVarName)
+	if err != nil {
+		return
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v2.XSiteOperation{}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=infinispan.org,namespace=infinispan-operator-system,resources=xsiteoperations;xsiteoperations/status;xsiteoperations/finalizers,verbs=get;list;watch;create;update;patch
+
+func (reconciler *XSiteOperationReconciler) Reconcile(ctx context.Context, ctrlRequest ctrl.Request) (ctrl.Result, error) {
+	reqLogger := reconciler.log.WithValues("Request.Namespace", ctrlRequest.Namespace, "Request.Name", ctrlRequest.Name)
+	reqLogger.Info("Reconciling XSiteOperation")
+
+	// Fetch the XSiteOperation instance
+	instance := &v2.XSiteOperation{}
+	err := reconciler.Get(ctx, ctrlRequest.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	operation := &xsiteOperationRequest{
+		XSiteOperationReconciler: reconciler,
+		ctx:                      ctx,
+		operation:                instance,
+		reqLogger:                reqLogger,
+	}
+
+	switch instance.Status.Phase {
+	case "":
+		return reconcile.Result{}, operation.UpdatePhase(v2.XSiteOperationInitializing, nil)
+	case v2.XSiteOperationInitializing:
+		return operation.initialize()
+	case v2.XSiteOperationInitialized:
+		return operation.execute()
+	case v2.XSiteOperationRunning:
+		return operation.waitToComplete()
+	default:
+		// Operation either succeeded or failed
+		return ctrl.Result{}, nil
+	}
+}
+
+func (r *xsiteOperationRequest) initialize() (reconcile.Result, error) {
+	operation := r.operation
+	spec := operation.Spec
+	// Ensure the Infinispan cluster exists
+	infinispan := &v1.Infinispan{}
+	if result, err := kube.LookupResource(spec.Cluster, operation.Namespace, infinispan, operation, r.Client, r.reqLogger, r.eventRec, r.ctx); result != nil {
+		return *result, err
+	}
+
+	if !infinispan.HasSites() {
+		err := fmt.Errorf("Infinispan '%s' does not have cross-site replication configured", spec.Cluster)
+		return reconcile.Result{}, r.UpdatePhase(v2.XSiteOperationFailed, err)
+	}
+
+	if _, exists := infinispan.GetRemoteSiteLocations()[spec.Site]; spec.Site != "" && !exists {
+		err := fmt.Errorf("site '%s' is not a remote location of Infinispan '%s'", spec.Site, spec.Cluster)
+		return reconcile.Result{}, r.UpdatePhase(v2.XSiteOperationFailed, err)
+	}
+
+	if err := infinispan.EnsureClusterStability(); err != nil {
+		r.reqLogger.Info(fmt.Sprintf("Infinispan '%s' not ready: %s", spec.Cluster, err.Error()))
+		return reconcile.Result{RequeueAfter: consts.DefaultWaitOnCluster}, nil
+	}
+
+	_, err := r.update(func() error {
+		operation.Status.ClusterUID = &infinispan.UID
+		operation.Status.Phase = v2.XSiteOperationInitialized
+		return nil
+	})
+	return reconcile.Result{}, err
+}
+
+func (r *xsiteOperationRequest) execute() (reconcile.Result, error) {
+	operation := r.operation
+	spec := operation.Spec
+	ispnClient, result, err := r.infinispanClient()
+	if ispnClient == nil {
+		return result, err
+	}
+
+	caches := spec.Caches
+	if len(caches) == 0 {
+		if caches, err = r.backupCaches(ispnClient); err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to determine the caches that backup to site '%s': %w", spec.Site, err)
+		}
+		if len(caches) == 0 {
+			err := fmt.Errorf("no caches backup to site '%s'", spec.Site)
+			return reconcile.Result{}, r.UpdatePhase(v2.XSiteOperationFailed, err)
+		}
+	}
+
+	results := make([]v2.XSiteCacheResult, len(caches))
+	for i, cache := range caches {
+		results[i] = r.executeOnCache(cache, ispnClient.Cache(cache))
+	}
+
+	phase := v2.XSiteOperationSucceeded
+	if spec.Operation == v2.XSiteOperationPushState {
+		phase = v2.XSiteOperationRunning
+	}
+	return reconcile.Result{}, r.updateResults(phase, results)
+}
+
+// backupCaches returns the name of all caches that backup to spec.site, or that have any backup configured if
+// spec.site is empty
+func (r *xsiteOperationRequest) backupCaches(ispnClient api.Infinispan) ([]string, error) {
+	names, err := ispnClient.Caches().Names()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	site := r.operation.Spec.Site
+	var caches []string
+	for _, name := range names {
+		statuses, err := ispnClient.Cache(name).Xsite().SiteStatuses()
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := statuses[site]; exists || (site == "" && len(statuses) > 0) {
+			caches = append(caches, name)
+		}
+	}
+	return caches, nil
+}
+
+func (r *xsiteOperationRequest) executeOnCache(name string, cache api.Cache) v2.XSiteCacheResult {
+	spec := r.operation.Spec
+	xsite := cache.Xsite()
+	result := v2.XSiteCacheResult{Name: name}
+
+	var err error
+	switch spec.Operation {
+	case v2.XSiteOperationTakeOffline:
+		err = xsite.TakeOffline(spec.Site)
+	case v2.XSiteOperationBringOnline:
+		err = xsite.BringOnline(spec.Site)
+	case v2.XSiteOperationPushState:
+		err = xsite.PushState(spec.Site)
+	case v2.XSiteOperationCancelPushState:
+		err = xsite.CancelPushState(spec.Site)
+	case v2.XSiteOperationClearPushStateStatus:
+		err = xsite.ClearPushStateStatus()
+	case v2.XSiteOperationConfigureTakeOffline:
+		err = xsite.UpdateTakeOfflineConfig(spec.Site, &api.TakeOfflineConfig{
+			AfterFailures: spec.TakeOffline.AfterFailures,
+			MinWait:       spec.TakeOffline.MinWait,
+		})
+	default:
+		err = fmt.Errorf("unknown operation '%s'", spec.Operation)
+	}
+
+	if err == nil {
+		result.Status, err = r.cacheStatus(xsite)
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// cacheStatus returns the state transfer status of the cache for the PushState and CancelPushState operations, or
+// the status of the site otherwise
+func (r *xsiteOperationRequest) cacheStatus(xsite api.CacheXsite) (string, error) {
+	site := r.operation.Spec.Site
+	switch r.operation.Spec.Operation {
+	case v2.XSiteOperationClearPushStateStatus:
+		return "", nil
+	case v2.XSiteOperationPushState, v2.XSiteOperationCancelPushState:
+		statuses, err := xsite.PushStateStatus()
+		if err != nil {
+			return "", err
+		}
+		return string(statuses[site]), nil
+	default:
+		statuses, err := xsite.SiteStatuses()
+		if err != nil {
+			return "", err
+		}
+		return string(statuses[site]), nil
+	}
+}
+
+func (r *xsiteOperationRequest) waitToComplete() (reconcile.Result, error) {
+	operation := r.operation
+	ispnClient, result, err := r.infinispanClient()
+	if ispnClient == nil {
+		return result, err
+	}
+
+	site := operation.Spec.Site
+	results := make([]v2.XSiteCacheResult, len(operation.Status.Caches))
+	completed := true
+	for i, cacheResult := range operation.Status.Caches {
+		results[i] = cacheResult
+		if cacheResult.Error != "" {
+			continue
+		}
+		statuses, err := ispnClient.Cache(cacheResult.Name).Xsite().PushStateStatus()
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("unable to retrieve state transfer status of cache '%s': %w", cacheResult.Name, err)
+		}
+		status := statuses[site]
+		results[i].Status = string(status)
+		switch status {
+		case api.PushStateStatusOK:
+		case api.PushStateStatusError, api.PushStateStatusCanceled:
+			results[i].Error = fmt.Sprintf("state transfer to site '%s' ended with status '%s'", site, status)
+		default:
+			completed = false
+		}
+	}
+
+	if !completed {
+		if err := r.updateResults(v2.XSiteOperationRunning, results); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: consts.DefaultXSiteStateTransferInterval}, nil
+	}
+	return reconcile.Result{}, r.updateResults(v2.XSiteOperationSucceeded, results)
+}
+
+// infinispanClient returns a client for the Infinispan cluster, or a nil client and the result that the reconcile
+// loop should return if the cluster is not available
+func (r *xsiteOperationRequest) infinispanClient() (api.Infinispan, reconcile.Result, error) {
+	operation := r.operation
+	infinispan := &v1.Infinispan{}
+	if result, err := kube.LookupResource(operation.Spec.Cluster, operation.Namespace, infinispan, operation, r.Client, r.reqLogger, r.eventRec, r.ctx); result != nil {
+		return nil, *result, err
+	}
+
+	expectedUid := *operation.Status.ClusterUID
+	if infinispan.GetUID() != expectedUid {
+		err := fmt.Errorf("unable to execute XSiteOperation. Infinispan CR UUID has changed, expected '%s' observed '%s'", expectedUid, infinispan.GetUID())
+		return nil, reconcile.Result{}, r.UpdatePhase(v2.XSiteOperationFailed, err)
+	}
+
+	ispnClient, err := NewInfinispan(r.ctx, infinispan, r.versionManager, r.kubernetes)
+	if err != nil {
+		return nil, reconcile.Result{}, fmt.Errorf("unable to create Infinispan client: %w", err)
+	}
+	return ispnClient, reconcile.Result{}, nil
+}
+
+// updateResults sets the per-cache results of the operation. Once the operation has completed on every cache, the phase
+// is Failed if the operation failed on any cache. A Running operation remains Running so that the remaining caches are
+// still monitored
+func (r *xsiteOperationRequest) updateResults(phase v2.XSiteOperationPhase, results []v2.XSiteCacheResult) error {
+	var failed []string
+	for _, result := range results {
+		if result.Error != "" {
+			failed = append(failed, result.Name)
+		}
+	}
+
+	var reason string
+	if len(failed) > 0 {
+		if phase != v2.XSiteOperationRunning {
+			phase = v2.XSiteOperationFailed
+		}
+		reason = fmt.Sprintf("%s failed on caches: %s", r.operation.Spec.Operation, strings.Join(failed, ", "))
+	}
+
+	_, err := r.update(func() error {
+		status := &r.operation.Status
+		status.Caches = results
+		status.Phase = phase
+		status.Reason = reason
+		return nil
+	})
+	return err
+}
+
+func (r *xsiteOperationRequest) UpdatePhase(phase v2.XSiteOperationPhase, phaseErr error) error {
+	_, err := r.update(func() error {
+		operation := r.operation
+		var reason string
+		if phaseErr != nil {
+			reason = phaseErr.Error()
+		}
+		operation.Status.Phase = phase
+		operation.Status.Reason = reason
+		return nil
+	})
+	return err
+}
+
+func (r *xsiteOperationRequest) update(mutate func() error) (bool, error) {
+	operation := r.operation
+	res, err := controllerutil.CreateOrPatch(r.ctx, r.Client, operation, func() error {
+		if operation.CreationTimestamp.IsZero() {
+			return errors.NewNotFound(schema.ParseGroupResource("xsiteoperation.infinispan.org"), operation.Name)
+		}
+		return mutate()
+	})
+	return res != controllerutil.OperationResultNone, err
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/infinispan/infinispan-operator/controllers"
@@ -18,17 +19,39 @@ import (
 	"github.com/infinispan/infinispan-operator/controllers/constants"
 	"github.com/infinispan/infinispan-operator/pkg/kubernetes"
 	"github.com/infinispan/infinispan-operator/pkg/mime"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/r3labs/sse/v2"
+	zapLog "go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 var (
 	scheme = runtime.NewScheme()
+	// Whether this pod is the elected leader
+	leading atomic.Bool
+	// Whether the server event stream is currently connected
+	streamConnected atomic.Bool
+)
+
+const (
+	// Leader election timings, matching the controller-runtime defaults
+	leaseDuration      = 15 * time.Second
+	leaseRenewDeadline = 10 * time.Second
+	leaseRetryPeriod   = 2 * time.Second
+	// The server does not emit events for schema changes, so schemas are periodically synchronised instead
+	schemaSyncInterval = 30 * time.Second
+	// Cache events can be lost or fail to be applied, so Cache CRs are periodically compared with the server
+	cacheResyncInterval = 5 * time.Minute
 )
 
 func init() {
@@ -41,8 +64,12 @@ type Parameters struct {
 	// The Name of the Infinispan cluster to listen to
 	Cluster string
 	// The Namespace of the cluster
-	Namespace  string
-	ZapOptions *zap.Options
+	Namespace string
+	// The address the metric endpoint binds to
+	MetricsBindAddress string
+	// The address the health probe endpoint binds to
+	HealthProbeBindAddress string
+	ZapOptions             *zap.Options
 }
 
 func New(ctx context.Context, p Parameters) {
@@ -51,7 +78,8 @@ func New(ctx context.Context, p Parameters) {
 	log.Info(fmt.Sprintf("Starting Infinispan ConfigListener Version: %s", launcher.Version))
 
 	ctx, cancel := context.WithCancel(ctx)
-	k8s, err := kubernetes.NewKubernetesFromConfig(ctrl.GetConfigOrDie(), scheme)
+	restConfig := ctrl.GetConfigOrDie()
+	k8s, err := kubernetes.NewKubernetesFromConfig(restConfig, scheme)
 	if err != nil {
 		log.Fatal("failed to create client")
 	}
@@ -79,84 +107,219 @@ func New(ctx context.Context, p Parameters) {
 		log.Fatalf("unable to load Operand versions: %v", err)
 	}
 
-	cacheListener := &controllers.CacheListener{
-		Infinispan:     infinispan,
-		Ctx:            ctx,
-		Kubernetes:     k8s,
-		Log:            log,
-		VersionManager: versionManager,
+	serveEndpoints(p, log)
+
+	// Leader election ensures that a single ConfigListener pod processes server events, with standby pods taking over
+	// if the leader fails
+	identity, err := os.Hostname()
+	if err != nil {
+		log.Fatalf("unable to determine leader election identity: %v", err)
+	}
+	clientset, err := k8sclient.NewForConfig(restConfig)
+	if err != nil {
+		log.Fatalf("unable to create leader election client: %v", err)
+	}
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      infinispan.GetConfigListenerName(),
+			Namespace: p.Namespace,
+		},
+		Client: clientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
 	}
 
-	wait := func() {
-		t := time.NewTimer(time.Second)
-		select {
-		case <-ctx.Done():
-			t.Stop()
-			log.Info("Context cancelled, terminating.")
-			os.Exit(0)
-		case <-t.C:
+	listen := func(ctx context.Context) {
+		cacheListener := &controllers.CacheListener{
+			Infinispan:     infinispan,
+			Ctx:            ctx,
+			Kubernetes:     k8s,
+			Log:            log,
+			VersionManager: versionManager,
 		}
-	}
 
-	for {
-		podList := &corev1.PodList{}
-		err = k8s.ResourcesList(infinispan.Namespace, infinispan.PodSelectorLabels(), podList, ctx)
-		if err != nil {
-			log.Error("Unable to retrieve Infinispan pod list: %w", err)
+		schemaListener := &controllers.SchemaListener{
+			Infinispan:     infinispan,
+			Ctx:            ctx,
+			Kubernetes:     k8s,
+			Log:            log,
+			VersionManager: versionManager,
 		}
 
-		var readyPod *corev1.Pod
-		for _, pod := range podList.Items {
-			if kubernetes.IsPodReady(pod) {
-				readyPod = &pod
+		wait := func() {
+			t := time.NewTimer(time.Second)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				log.Info("Context cancelled, terminating.")
+				os.Exit(0)
+			case <-t.C:
+			}
+		}
+
+		for {
+			podList := &corev1.PodList{}
+			err = k8s.ResourcesList(infinispan.Namespace, infinispan.PodSelectorLabels(), podList, ctx)
+			if err != nil {
+				log.Error("Unable to retrieve Infinispan pod list: %w", err)
+			}
+
+			var readyPod *corev1.Pod
+			for _, pod := range podList.Items {
+				if kubernetes.IsPodReady(pod) {
+					readyPod = &pod
+					break
+				}
+			}
+
+			if readyPod == nil {
+				log.Info("Waiting for an Infinispan pod to become ready...")
+			} else if err = cacheListener.RemoveStaleResources(readyPod.Name); err != nil {
+				log.Errorf("Unable to remove stale resources: %v", err)
+			} else {
 				break
 			}
+			wait()
 		}
 
-		if readyPod == nil {
-			log.Info("Waiting for an Infinispan pod to become ready...")
-		} else if err = cacheListener.RemoveStaleResources(readyPod.Name); err != nil {
-			log.Errorf("Unable to remove stale resources: %v", err)
-		} else {
-			break
+		log.Infof("Consuming streams from service '%s'\n", service)
+		containerSse := sse.NewClient(serviceWithAuth + "/rest/v2/container/config?action=listen&includeCurrentState=true")
+		containerSse.Connection.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+		containerSse.Headers = map[string]string{
+			"Accept": string(mime.ApplicationJson),
+		}
+		containerSse.ReconnectStrategy = backoff.NewConstantBackOff(time.Second)
+		containerSse.OnConnect(func(*sse.Client) {
+			streamConnected.Store(true)
+		})
+		containerSse.OnDisconnect(func(*sse.Client) {
+			streamConnected.Store(false)
+		})
+		containerSse.ReconnectNotify = func(e error, t time.Duration) {
+			reconnectsCounter.Inc()
+			log.Warnf("Cache stream connection lost. Reconnecting: %v", e)
+			// Reload the Infinispan CR as the StatefulSet may have been updated due to a Hot Rod rolling upgrade
+			loadInfinispan()
 		}
-		wait()
-	}
 
-	log.Infof("Consuming streams from service '%s'\n", service)
-	containerSse := sse.NewClient(serviceWithAuth + "/rest/v2/container/config?action=listen&includeCurrentState=true")
-	containerSse.Connection.Transport = &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-	}
-	containerSse.Headers = map[string]string{
-		"Accept": string(mime.ApplicationJson),
-	}
-	containerSse.ReconnectStrategy = backoff.NewConstantBackOff(time.Second)
-	containerSse.ReconnectNotify = func(e error, t time.Duration) {
-		log.Warnf("Cache stream connection lost. Reconnecting: %v", e)
-		// Reload the Infinispan CR as the StatefulSet may have been updated due to a Hot Rod rolling upgrade
-		loadInfinispan()
-	}
+		go func() {
+			err = containerSse.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
+				var err error
+				event := string(msg.Event)
+				log.Debugf("ConfigListener received event '%s':\n---\n%s\n---\n", event, msg.Data)
+				eventsCounter.WithLabelValues(event).Inc()
+				switch event {
+				case "create-cache", "update-cache":
+					err = cacheListener.CreateOrUpdate(msg.Data)
+				case "remove-cache":
+					err = cacheListener.Delete(msg.Data)
+				}
+				if err != nil {
+					eventErrorsCounter.WithLabelValues(event).Inc()
+					log.Errorf("Error encountered for event '%s': %v", event, err)
+				}
+			})
+			if err != nil {
+				log.Errorf("Error encountered on SSE subscribe: %v", err)
+				cancel()
+			}
+		}()
 
-	go func() {
-		err = containerSse.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
-			var err error
-			event := string(msg.Event)
-			log.Debugf("ConfigListener received event '%s':\n---\n%s\n---\n", event, msg.Data)
-			switch event {
-			case "create-cache", "update-cache":
-				err = cacheListener.CreateOrUpdate(msg.Data)
-			case "remove-cache":
-				err = cacheListener.Delete(msg.Data)
+		go func() {
+			ticker := time.NewTicker(cacheResyncInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+				drifted, err := cacheListener.Resync()
+				if err != nil {
+					log.Errorf("Error encountered resynchronising caches: %v", err)
+					continue
+				}
+				cacheDriftGauge.WithLabelValues(infinispan.Namespace, infinispan.Name).Set(float64(len(drifted)))
+				if len(drifted) > 0 {
+					log.Warnf("Repaired %d Cache resources that had drifted from the server: %v", len(drifted), drifted)
+				}
 			}
-			if err != nil {
-				log.Errorf("Error encountered for event '%s': %v", event, err)
+		}()
+
+		go func() {
+			ticker := time.NewTicker(schemaSyncInterval)
+			defer ticker.Stop()
+			for {
+				if err := schemaListener.Sync(); err != nil {
+					log.Errorf("Error encountered synchronising schemas: %v", err)
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
 			}
-		})
-		if err != nil {
-			log.Errorf("Error encountered on SSE subscribe: %v", err)
-			cancel()
+		}()
+		<-ctx.Done()
+	}
+
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   leaseRenewDeadline,
+		RetryPeriod:     leaseRetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Infof("Elected as ConfigListener leader '%s'", identity)
+				leading.Store(true)
+				leaderGauge.Set(1)
+				listen(ctx)
+			},
+			OnStoppedLeading: func() {
+				log.Info("ConfigListener leadership lost, terminating.")
+				os.Exit(0)
+			},
+			OnNewLeader: func(current string) {
+				if current != identity {
+					log.Infof("Waiting for ConfigListener leadership, current leader '%s'", current)
+				}
+			},
+		},
+	})
+}
+
+// serveEndpoints exposes the ConfigListener metrics and health probes
+func serveEndpoints(p Parameters, log *zapLog.SugaredLogger) {
+	serve := func(addr string, mux *http.ServeMux) {
+		if addr == "" || addr == "0" {
+			return
+		}
+		go func() {
+			if err := http.ListenAndServe(addr, mux); err != nil {
+				log.Errorf("Unable to serve endpoint on '%s': %v", addr, err)
+			}
+		}()
+	}
+
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}))
+	serve(p.MetricsBindAddress, metricsMux)
+
+	healthMux := http.NewServeMux()
+	healthMux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	healthMux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		// Standby pods are ready, whereas the leader is only ready once the server event stream is connected
+		if leading.Load() && !streamConnected.Load() {
+			http.Error(w, "server event stream not connected", http.StatusServiceUnavailable)
+			return
 		}
-	}()
-	<-ctx.Done()
+		w.WriteHeader(http.StatusOK)
+	})
+	serve(p.HealthProbeBindAddress, healthMux)
 }
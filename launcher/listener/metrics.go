@@ -0,0 +1,50 @@
+package listener
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	eventsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "infinispan_config_listener_events_total",
+			Help: "Number of server events processed by the ConfigListener",
+		},
+		[]string{"event"},
+	)
+
+	eventErrorsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "infinispan_config_listener_event_errors_total",
+			Help: "Number of server events that the ConfigListener failed to process",
+		},
+		[]string{"event"},
+	)
+
+	reconnectsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "infinispan_config_listener_reconnects_total",
+			Help: "Number of times the ConfigListener reconnected to the server event stream",
+		},
+	)
+
+	leaderGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "infinispan_config_listener_leader",
+			Help: "Whether the ConfigListener pod is the elected leader that processes server events",
+		},
+	)
+
+	cacheDriftGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "infinispan_config_listener_cache_drift",
+			Help: "Number of Cache resources that had drifted from the server configuration during the last resync",
+		},
+		[]string{"namespace", "cluster"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(eventsCounter, eventErrorsCounter, reconnectsCounter, leaderGauge, cacheDriftGauge)
+}
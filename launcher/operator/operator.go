@@ -123,6 +123,30 @@ func NewWithContext(ctx context.Context, p Parameters) {
 		setupLog.Error(err, "unable to create controller", "controller", "Cache")
 		os.Exit(1)
 	}
+	if err = (&controllers.CounterReconciler{}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Counter")
+		os.Exit(1)
+	}
+	if err = (&controllers.SchemaReconciler{}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Schema")
+		os.Exit(1)
+	}
+	if err = (&controllers.UserReconciler{}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "User")
+		os.Exit(1)
+	}
+	if err = (&controllers.RoleReconciler{}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Role")
+		os.Exit(1)
+	}
+	if err = (&controllers.BackupScheduleReconciler{}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BackupSchedule")
+		os.Exit(1)
+	}
+	if err = (&controllers.XSiteOperationReconciler{}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "XSiteOperation")
+		os.Exit(1)
+	}
 
 	if err = (&controllers.ReconcileOperatorConfig{}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "OperatorConfig")
@@ -158,6 +182,36 @@ func NewWithContext(ctx context.Context, p Parameters) {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Restore")
 			os.Exit(1)
 		}
+
+		if err = (&infinispanv2alpha1.Counter{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Counter")
+			os.Exit(1)
+		}
+
+		if err = (&infinispanv2alpha1.Schema{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Schema")
+			os.Exit(1)
+		}
+
+		if err = (&infinispanv2alpha1.User{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "User")
+			os.Exit(1)
+		}
+
+		if err = (&infinispanv2alpha1.Role{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Role")
+			os.Exit(1)
+		}
+
+		if err = (&infinispanv2alpha1.BackupSchedule{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "BackupSchedule")
+			os.Exit(1)
+		}
+
+		if err = (&infinispanv2alpha1.XSiteOperation{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "XSiteOperation")
+			os.Exit(1)
+		}
 	}
 
 	if err := mgr.AddHealthzCheck("health", healthz.Ping); err != nil {
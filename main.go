@@ -35,6 +35,8 @@ func main() {
 	listenerFs.String("kubeconfig", "", "Paths to a kubeconfig. Only required if out-of-cluster.")
 	listenerNs := listenerFs.String("namespace", "", "The namespace of the Infinispan cluster.")
 	listenerCluster := listenerFs.String("cluster", "", "The name of the Infinispan cluster.")
+	listenerMetricsAddr := listenerFs.String("metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
+	listenerProbeAddr := listenerFs.String("health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	zapOpts.BindFlags(listenerFs)
 
 	switch os.Args[1] {
@@ -53,9 +55,11 @@ func main() {
 			os.Exit(1)
 		}
 		listener.New(context.Background(), listener.Parameters{
-			Namespace:  *listenerNs,
-			Cluster:    *listenerCluster,
-			ZapOptions: &zapOpts,
+			Namespace:              *listenerNs,
+			Cluster:                *listenerCluster,
+			MetricsBindAddress:     *listenerMetricsAddr,
+			HealthProbeBindAddress: *listenerProbeAddr,
+			ZapOptions:             &zapOpts,
 		})
 	default:
 		exit()
@@ -0,0 +1,70 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"1-2-3 * * * *",
+		"a * * * *",
+		"@every 5m",
+	} {
+		_, err := Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestNext(t *testing.T) {
+	from := time.Date(2023, time.January, 31, 10, 15, 30, 0, time.UTC)
+	testCases := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2023, time.January, 31, 10, 16, 0, 0, time.UTC)},
+		{"*/20 * * * *", time.Date(2023, time.January, 31, 10, 20, 0, 0, time.UTC)},
+		{"15 * * * *", time.Date(2023, time.January, 31, 11, 15, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2023, time.February, 1, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2023, time.January, 31, 11, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		// 2023-01-31 is a Tuesday
+		{"0 0 * * sun", time.Date(2023, time.February, 5, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2023, time.February, 5, 0, 0, 0, 0, time.UTC)},
+		{"30 9-17/4 * * mon-fri", time.Date(2023, time.January, 31, 13, 30, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 10 jun-aug *", time.Date(2023, time.June, 10, 0, 0, 0, 0, time.UTC)},
+		// When both day fields are restricted a day matches if either field matches
+		{"0 0 13 * fri", time.Date(2023, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+
+	for _, tc := range testCases {
+		s, err := Parse(tc.expr)
+		if assert.NoError(t, err, tc.expr) {
+			assert.Equal(t, tc.expected, s.Next(from), tc.expr)
+		}
+	}
+}
+
+func TestNextIsStrictlyAfter(t *testing.T) {
+	s, err := Parse("0 * * * *")
+	assert.NoError(t, err)
+	from := time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, from.Add(time.Hour), s.Next(from))
+}
@@ -0,0 +1,164 @@
+package native
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"strings"
+)
+
+const (
+	basicScheme  = "basic"
+	digestScheme = "digest"
+)
+
+type challenge struct {
+	scheme string
+	params map[string]string
+}
+
+// authorize returns the Authorization header value that satisfies one of the challenges contained in the 401 response
+func authorize(rsp *http.Response, method, uri string, credentials *Credentials) (string, error) {
+	challenges := parseChallenges(rsp.Header.Values("WWW-Authenticate"))
+
+	var basic *challenge
+	for i, c := range challenges {
+		switch c.scheme {
+		case digestScheme:
+			if auth, err := digestAuthorization(c, method, uri, credentials); err == nil {
+				return auth, nil
+			}
+		case basicScheme:
+			basic = &challenges[i]
+		}
+	}
+
+	if basic != nil {
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(credentials.Username, credentials.Password)
+		return req.Header.Get("Authorization"), nil
+	}
+	return "", fmt.Errorf("server did not return a supported authentication challenge")
+}
+
+func digestAuthorization(c challenge, method, uri string, credentials *Credentials) (string, error) {
+	var newHash func() hash.Hash
+	algorithm := c.params["algorithm"]
+	switch strings.ToUpper(algorithm) {
+	case "", "MD5":
+		newHash = md5.New
+	case "SHA-256":
+		newHash = sha256.New
+	default:
+		return "", fmt.Errorf("unsupported digest algorithm '%s'", algorithm)
+	}
+
+	digest := func(s string) string {
+		h := newHash()
+		h.Write([]byte(s))
+		return hex.EncodeToString(h.Sum(nil))
+	}
+
+	realm, nonce := c.params["realm"], c.params["nonce"]
+	ha1 := digest(credentials.Username + ":" + realm + ":" + credentials.Password)
+	ha2 := digest(method + ":" + uri)
+
+	var qop string
+	for _, q := range strings.Split(c.params["qop"], ",") {
+		if strings.TrimSpace(q) == "auth" {
+			qop = "auth"
+			break
+		}
+	}
+
+	b := new(strings.Builder)
+	fmt.Fprintf(b, `Digest username="%s", realm="%s", nonce="%s", uri="%s"`, credentials.Username, realm, nonce, uri)
+	if qop != "" {
+		cnonce, err := cnonce()
+		if err != nil {
+			return "", err
+		}
+		nc := "00000001"
+		response := digest(strings.Join([]string{ha1, nonce, nc, cnonce, qop, ha2}, ":"))
+		fmt.Fprintf(b, `, response="%s", qop=%s, nc=%s, cnonce="%s"`, response, qop, nc, cnonce)
+	} else {
+		fmt.Fprintf(b, `, response="%s"`, digest(ha1+":"+nonce+":"+ha2))
+	}
+	if opaque, ok := c.params["opaque"]; ok {
+		fmt.Fprintf(b, `, opaque="%s"`, opaque)
+	}
+	if algorithm != "" {
+		fmt.Fprintf(b, `, algorithm=%s`, algorithm)
+	}
+	return b.String(), nil
+}
+
+// parseChallenges parses the WWW-Authenticate header values into their individual challenges. Multiple challenges may
+// be sent in a single header value, e.g. `Basic realm="admin", Digest realm="admin", nonce="..."`
+func parseChallenges(headers []string) []challenge {
+	var challenges []challenge
+	for _, header := range headers {
+		var current *challenge
+		for _, token := range splitParams(header) {
+			token = strings.TrimSpace(token)
+			if token == "" {
+				continue
+			}
+			// A token starting with an auth-scheme begins a new challenge
+			if i := strings.IndexByte(token, ' '); i > 0 && !strings.Contains(token[:i], "=") {
+				challenges = append(challenges, challenge{
+					scheme: strings.ToLower(token[:i]),
+					params: map[string]string{},
+				})
+				current = &challenges[len(challenges)-1]
+				token = strings.TrimSpace(token[i+1:])
+			} else if !strings.Contains(token, "=") {
+				challenges = append(challenges, challenge{
+					scheme: strings.ToLower(token),
+					params: map[string]string{},
+				})
+				current = &challenges[len(challenges)-1]
+				continue
+			}
+
+			if current == nil {
+				continue
+			}
+			if kv := strings.SplitN(token, "=", 2); len(kv) == 2 {
+				current.params[strings.ToLower(strings.TrimSpace(kv[0]))] = strings.Trim(strings.TrimSpace(kv[1]), `"`)
+			}
+		}
+	}
+	return challenges
+}
+
+// splitParams splits a header value on commas that are not contained within a quoted-string
+func splitParams(s string) []string {
+	var parts []string
+	var quoted bool
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+func cnonce() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("unable to generate digest cnonce: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
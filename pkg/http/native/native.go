@@ -0,0 +1,202 @@
+// Package native provides a http implementation that sends requests directly to an Infinispan pod using net/http
+package native
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	dialTimeout     = 10 * time.Second
+	idleConnTimeout = 30 * time.Second
+	// requestTimeout bounds requests to unresponsive pods, whilst allowing long-running operations such as the
+	// synchronisation of data during Hot Rod rolling upgrades to complete
+	requestTimeout = 5 * time.Minute
+)
+
+// sharedTransport is used by all clients so that connections to the same pod are pooled across reconciliations
+var sharedTransport = newTransport()
+
+type Credentials struct {
+	Username string
+	Password string
+}
+
+type Config struct {
+	Credentials *Credentials
+	Podname     string
+	Namespace   string
+	Protocol    string
+	Port        int
+	// The IP address of the pod. If empty, the address is retrieved from the API server on the first request
+	PodIP string
+	// The time limit of each request, including the reading of the response body. Defaults to 5 minutes
+	Timeout time.Duration
+}
+
+type Client struct {
+	Config Config
+	*kube.Kubernetes
+	http *http.Client
+
+	mu      sync.Mutex
+	address string
+}
+
+func New(c Config, kubernetes *kube.Kubernetes) *Client {
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = requestTimeout
+	}
+	return &Client{
+		Config:     c,
+		Kubernetes: kubernetes,
+		http:       &http.Client{Transport: sharedTransport, Timeout: timeout},
+	}
+}
+
+func (c *Client) CloneForPod(podName string) *Client {
+	config := c.Config
+	config.Podname = podName
+	config.PodIP = ""
+	return &Client{
+		Config:     config,
+		Kubernetes: c.Kubernetes,
+		http:       c.http,
+	}
+}
+
+func (c *Client) Get(path string, headers map[string]string) (*http.Response, error) {
+	return c.execute(http.MethodGet, path, nil, headers)
+}
+
+func (c *Client) Head(path string, headers map[string]string) (*http.Response, error) {
+	return c.execute(http.MethodHead, path, nil, headers)
+}
+
+func (c *Client) Post(path, payload string, headers map[string]string) (*http.Response, error) {
+	return c.execute(http.MethodPost, path, []byte(payload), headers)
+}
+
+func (c *Client) PostMultipart(path string, parts map[string]string, headers map[string]string) (*http.Response, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	for k, v := range parts {
+		if err := writer.WriteField(k, v); err != nil {
+			return nil, fmt.Errorf("unable to write multipart field '%s': %w", k, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("unable to create multipart body: %w", err)
+	}
+
+	h := make(map[string]string, len(headers)+1)
+	for k, v := range headers {
+		h[k] = v
+	}
+	h["Content-Type"] = writer.FormDataContentType()
+	return c.execute(http.MethodPost, path, body.Bytes(), h)
+}
+
+func (c *Client) Put(path, payload string, headers map[string]string) (*http.Response, error) {
+	return c.execute(http.MethodPut, path, []byte(payload), headers)
+}
+
+func (c *Client) Delete(path string, headers map[string]string) (*http.Response, error) {
+	return c.execute(http.MethodDelete, path, nil, headers)
+}
+
+func (c *Client) execute(method, path string, payload []byte, headers map[string]string) (*http.Response, error) {
+	host, err := c.podAddress()
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%s://%s/%s", c.Config.Protocol, host, path)
+
+	rsp, err := c.do(method, url, payload, headers, "")
+	if err != nil || c.Config.Credentials == nil || rsp.StatusCode != http.StatusUnauthorized {
+		return rsp, err
+	}
+
+	// Authenticate using the challenge returned by the server, preferring DIGEST over BASIC as is the case with curl
+	authorization, err := authorize(rsp, method, rsp.Request.URL.RequestURI(), c.Config.Credentials)
+	if cerr := drainAndClose(rsp); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.do(method, url, payload, headers, authorization)
+}
+
+func (c *Client) do(method, url string, payload []byte, headers map[string]string, authorization string) (*http.Response, error) {
+	var body io.Reader
+	if len(payload) > 0 {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s request for '%s': %w", method, url, err)
+	}
+	for header, value := range headers {
+		req.Header.Set(header, value)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	return c.http.Do(req)
+}
+
+// podAddress resolves the host:port of the configured pod. Pods are not addressable via DNS as the StatefulSet is
+// not associated with a governing service, so the pod IP is retrieved from the API server instead if it was not
+// provided. The address is resolved once per client.
+func (c *Client) podAddress() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.address != "" {
+		return c.address, nil
+	}
+
+	podIP := c.Config.PodIP
+	if podIP == "" {
+		pod := &corev1.Pod{}
+		key := types.NamespacedName{Namespace: c.Config.Namespace, Name: c.Config.Podname}
+		if err := c.Kubernetes.Client.Get(context.TODO(), key, pod); err != nil {
+			return "", fmt.Errorf("unable to retrieve pod '%s': %w", c.Config.Podname, err)
+		}
+		if pod.Status.PodIP == "" {
+			return "", fmt.Errorf("pod '%s' has not been assigned an IP address", c.Config.Podname)
+		}
+		podIP = pod.Status.PodIP
+	}
+	c.address = net.JoinHostPort(podIP, strconv.Itoa(c.Config.Port))
+	return c.address, nil
+}
+
+func newTransport() *http.Transport {
+	return &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: dialTimeout,
+		}).DialContext,
+		IdleConnTimeout: idleConnTimeout,
+	}
+}
+
+func drainAndClose(rsp *http.Response) error {
+	if _, err := io.Copy(io.Discard, rsp.Body); err != nil {
+		return err
+	}
+	return rsp.Body.Close()
+}
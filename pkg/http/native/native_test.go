@@ -0,0 +1,225 @@
+package native
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testUser  = "admin"
+	testPass  = "password"
+	testRealm = "admin"
+	testNonce = "abc123"
+)
+
+var digestParam = regexp.MustCompile(`(\w+)="?([^",]+)"?`)
+
+// digestServer emulates the Infinispan admin endpoint which offers both BASIC and DIGEST authentication
+func digestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if auth == "" {
+			w.Header().Add("WWW-Authenticate", fmt.Sprintf(`Basic realm="%s"`, testRealm))
+			w.Header().Add("WWW-Authenticate", fmt.Sprintf(`Digest realm="%s", nonce="%s", opaque="00000000000000000000000000000000", algorithm=MD5, qop="auth"`, testRealm, testNonce))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		params := map[string]string{}
+		for _, m := range digestParam.FindAllStringSubmatch(auth, -1) {
+			params[m[1]] = m[2]
+		}
+		md5Hex := func(s string) string {
+			h := md5.Sum([]byte(s))
+			return hex.EncodeToString(h[:])
+		}
+		ha1 := md5Hex(testUser + ":" + testRealm + ":" + testPass)
+		ha2 := md5Hex(r.Method + ":" + r.URL.RequestURI())
+		expected := md5Hex(ha1 + ":" + testNonce + ":" + params["nc"] + ":" + params["cnonce"] + ":" + params["qop"] + ":" + ha2)
+		if params["response"] != expected {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+		_, _ = fmt.Fprintf(w, "%s %s", r.Method, string(body))
+	}))
+}
+
+func newTestClient(t *testing.T, server *httptest.Server, credentials *Credentials) *Client {
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-0", Namespace: "default"},
+		Status:     corev1.PodStatus{PodIP: host},
+	}
+	kubernetes := &kube.Kubernetes{Client: fake.NewClientBuilder().WithObjects(pod).Build()}
+	return New(Config{
+		Credentials: credentials,
+		Podname:     pod.Name,
+		Namespace:   pod.Namespace,
+		Protocol:    "http",
+		Port:        portNum,
+	}, kubernetes)
+}
+
+func readBody(t *testing.T, rsp *http.Response) string {
+	defer rsp.Body.Close()
+	body, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestDigestAuthentication(t *testing.T) {
+	server := digestServer(t)
+	defer server.Close()
+
+	client := newTestClient(t, server, &Credentials{Username: testUser, Password: testPass})
+
+	rsp, err := client.Get("rest/v2/caches?action=names", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, "GET ", readBody(t, rsp))
+
+	rsp, err = client.Post("rest/v2/caches/example", `{"distributed-cache":{}}`, map[string]string{"Content-Type": "application/json"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Equal(t, `POST {"distributed-cache":{}}`, readBody(t, rsp))
+}
+
+func TestInvalidCredentials(t *testing.T) {
+	server := digestServer(t)
+	defer server.Close()
+
+	client := newTestClient(t, server, &Credentials{Username: testUser, Password: "wrong"})
+	rsp, err := client.Delete("rest/v2/caches/example", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rsp.StatusCode)
+}
+
+func TestNoCredentials(t *testing.T) {
+	server := digestServer(t)
+	defer server.Close()
+
+	client := newTestClient(t, server, nil)
+	rsp, err := client.Head("rest/v2/cache-managers/default/health/status", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+}
+
+func TestPostMultipart(t *testing.T) {
+	server := digestServer(t)
+	defer server.Close()
+
+	client := newTestClient(t, server, &Credentials{Username: testUser, Password: testPass})
+	rsp, err := client.PostMultipart("rest/v2/caches?action=convert", map[string]string{"key": "value"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	assert.Regexp(t, "multipart/form-data; boundary=.*", rsp.Header.Get("Content-Type"))
+	assert.Contains(t, readBody(t, rsp), "name=\"key\"\r\n\r\nvalue")
+}
+
+func TestUnknownPod(t *testing.T) {
+	server := digestServer(t)
+	defer server.Close()
+
+	client := newTestClient(t, server, nil).CloneForPod("example-1")
+	_, err := client.Get("rest/v2/caches", nil)
+	assert.ErrorContains(t, err, "unable to retrieve pod 'example-1'")
+}
+
+func TestPodAddressResolvedOnce(t *testing.T) {
+	server := digestServer(t)
+	defer server.Close()
+
+	client := newTestClient(t, server, &Credentials{Username: testUser, Password: testPass})
+	_, err := client.Get("rest/v2/caches", nil)
+	require.NoError(t, err)
+
+	// The pod is not retrieved again once its address is known
+	require.NoError(t, client.Kubernetes.Client.Delete(context.TODO(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "example-0", Namespace: "default"}}))
+	rsp, err := client.Get("rest/v2/caches", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+}
+
+func TestProvidedPodIP(t *testing.T) {
+	server := digestServer(t)
+	defer server.Close()
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	kubernetes := &kube.Kubernetes{Client: fake.NewClientBuilder().Build()}
+	client := New(Config{
+		Credentials: &Credentials{Username: testUser, Password: testPass},
+		Podname:     "example-0",
+		Namespace:   "default",
+		Protocol:    "http",
+		Port:        portNum,
+		PodIP:       host,
+	}, kubernetes)
+	rsp, err := client.Get("rest/v2/caches", nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+}
+
+func TestRequestTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	client := New(Config{
+		Podname:   "example-0",
+		Namespace: "default",
+		Protocol:  "http",
+		Port:      portNum,
+		PodIP:     host,
+		Timeout:   100 * time.Millisecond,
+	}, &kube.Kubernetes{})
+	// An unresponsive pod does not block the caller indefinitely
+	_, err = client.Get("rest/v2/caches", nil)
+	assert.ErrorContains(t, err, "Client.Timeout exceeded")
+}
+
+func TestParseChallenges(t *testing.T) {
+	challenges := parseChallenges([]string{`Basic realm="admin", Digest realm="admin", nonce="a,b", qop="auth,auth-int", algorithm=SHA-256`})
+	require.Len(t, challenges, 2)
+	assert.Equal(t, basicScheme, challenges[0].scheme)
+	assert.Equal(t, "admin", challenges[0].params["realm"])
+	assert.Equal(t, digestScheme, challenges[1].scheme)
+	assert.Equal(t, "a,b", challenges[1].params["nonce"])
+	assert.Equal(t, "auth,auth-int", challenges[1].params["qop"])
+	assert.Equal(t, "SHA-256", challenges[1].params["algorithm"])
+}
@@ -3,6 +3,7 @@ package api
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 
 	"github.com/infinispan/infinispan-operator/pkg/mime"
@@ -13,11 +14,15 @@ type Infinispan interface {
 	Cache(name string) Cache
 	Caches() Caches
 	Container() Container
+	Counters() Counters
 	Logging() Logging
 	Metrics() Metrics
 	ProtobufMetadataCacheName() string
+	Roles() Roles
 	ScriptCacheName() string
+	Schemas() Schemas
 	Server() Server
+	Users() Users
 }
 
 // Container interface contains all operations and sub-interfaces related to interactions with the Infinispan cache-container
@@ -47,6 +52,7 @@ type Restores interface {
 
 // Cache contains all operations and sub-interfaces for manipulating a specific cache
 type Cache interface {
+	AssignAlias(alias string) error
 	Config(contentType mime.MimeType) (string, error)
 	Create(config string, contentType mime.MimeType, flags ...string) error
 	CreateWithTemplate(templateName string) error
@@ -57,6 +63,20 @@ type Cache interface {
 	RollingUpgrade() RollingUpgrade
 	Size() (int, error)
 	UpdateConfig(config string, contentType mime.MimeType) error
+	Xsite() CacheXsite
+}
+
+// CacheXsite contains all Xsite operations for a specific cache
+type CacheXsite interface {
+	BringOnline(site string) error
+	CancelPushState(site string) error
+	ClearPushStateStatus() error
+	PushState(site string) error
+	PushStateStatus() (map[string]PushStateStatus, error)
+	SiteStatuses() (map[string]XsiteStatus, error)
+	TakeOffline(site string) error
+	TakeOfflineConfig(site string) (*TakeOfflineConfig, error)
+	UpdateTakeOfflineConfig(site string, config *TakeOfflineConfig) error
 }
 
 // RollingUpgrade contains all operations for coordinating rolling upgrades on a specific cache
@@ -74,6 +94,42 @@ type Caches interface {
 	Names() ([]string, error)
 }
 
+// Counters contains all operations for manipulating clustered counters
+type Counters interface {
+	Create(name string, config *CounterConfig) error
+	Delete(name string) error
+	Get(name string) (int64, bool, error)
+	Names() ([]string, error)
+	Reset(name string) error
+}
+
+// Schemas contains all operations for manipulating Protobuf schemas
+type Schemas interface {
+	CreateOrUpdate(name, content string) (*SchemaError, error)
+	Delete(name string) error
+	Get(name string) (string, bool, error)
+	List() ([]SchemaInfo, error)
+}
+
+// Users contains all operations for managing the users of the default security realm
+type Users interface {
+	Create(name string, config *UserConfig) error
+	Delete(name string) error
+	Get(name string) (*UserInfo, bool, error)
+	Update(name string, config *UserConfig) error
+}
+
+// Roles contains all operations for managing authorization roles and the principals that they are granted to
+type Roles interface {
+	Create(name string, permissions []string) error
+	Delete(name string) error
+	Deny(principal string, roles ...string) error
+	FlushCache() error
+	Get(name string) (*RoleInfo, bool, error)
+	Grant(principal string, roles ...string) error
+	Update(name string, permissions []string) error
+}
+
 // Cluster contains all operations that are performed cluster-wide
 type Cluster interface {
 	GracefulShutdown() error
@@ -96,9 +152,14 @@ type Server interface {
 	Stop() error
 }
 
-// Xsite contains all Xsite replated operations
+// Xsite contains all Xsite replated operations that apply to all caches in the container
 type Xsite interface {
+	BringOnline(site string) error
+	CancelPushState(site string) error
 	PushAllState() error
+	PushState(site string) error
+	SiteStatuses() (map[string]XsiteStatus, error)
+	TakeOffline(site string) error
 }
 
 // HealthStatus indicated the possible statuses of the Infinispan server
@@ -151,12 +212,128 @@ type BackupRestoreResources struct {
 	Tasks []string `json:"tasks,omitempty"`
 }
 
+type CounterType string
+
+const (
+	CounterTypeStrong CounterType = "strong-counter"
+	CounterTypeWeak   CounterType = "weak-counter"
+)
+
+type CounterStorage string
+
+const (
+	CounterStorageVolatile   CounterStorage = "VOLATILE"
+	CounterStoragePersistent CounterStorage = "PERSISTENT"
+)
+
+type CounterConfig struct {
+	Type         CounterType    `json:"-" validate:"required,oneof=strong-counter weak-counter"`
+	InitialValue int64          `json:"initial-value"`
+	Storage      CounterStorage `json:"storage,omitempty"`
+	// +optional
+	LowerBound *int64 `json:"lower-bound,omitempty"`
+	// +optional
+	UpperBound *int64 `json:"upper-bound,omitempty"`
+	// +optional
+	ConcurrencyLevel *int32 `json:"concurrency-level,omitempty"`
+}
+
+// MarshalJSON wraps the counter attributes in an object named after the counter type as expected by the server
+func (c CounterConfig) MarshalJSON() ([]byte, error) {
+	type attributes CounterConfig
+	return json.Marshal(map[CounterType]attributes{
+		c.Type: attributes(c),
+	})
+}
+
+type SchemaInfo struct {
+	Name  string       `json:"name"`
+	Error *SchemaError `json:"error,omitempty"`
+}
+
+type SchemaError struct {
+	Message string `json:"message"`
+	Cause   string `json:"cause"`
+}
+
+func (e *SchemaError) Error() string {
+	if e.Cause == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Message, e.Cause)
+}
+
+type UserConfig struct {
+	Password string `json:"password" validate:"required"`
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+type UserInfo struct {
+	Username string   `json:"username"`
+	Groups   []string `json:"groups"`
+}
+
+type RoleInfo struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+	// Implicit is true for the predefined roles of the server
+	Implicit bool `json:"implicit"`
+}
+
 type ContainerInfo struct {
 	Coordinator bool           `json:"coordinator"`
+	RelayNode   bool           `json:"relay_node"`
 	SitesView   *[]interface{} `json:"sites_view,omitempty"`
 	Version     string         `json:"version"`
 }
 
+// XsiteStatus indicates whether a backup site is receiving updates
+type XsiteStatus string
+
+const (
+	XsiteStatusOnline  XsiteStatus = "online"
+	XsiteStatusOffline XsiteStatus = "offline"
+	// XsiteStatusMixed means that the site is online for some caches or nodes and offline for others
+	XsiteStatusMixed XsiteStatus = "mixed"
+)
+
+// UnmarshalJSON accepts both the plain string returned for a cache and the object containing a "status" field
+// returned for the container
+func (s *XsiteStatus) UnmarshalJSON(data []byte) error {
+	var status string
+	if err := json.Unmarshal(data, &status); err == nil {
+		*s = XsiteStatus(status)
+		return nil
+	}
+	var obj struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	*s = XsiteStatus(obj.Status)
+	return nil
+}
+
+// PushStateStatus indicates the progress of a state transfer to a backup site
+type PushStateStatus string
+
+const (
+	PushStateStatusSending  PushStateStatus = "SENDING"
+	PushStateStatusOK       PushStateStatus = "OK"
+	PushStateStatusError    PushStateStatus = "ERROR"
+	PushStateStatusCanceled PushStateStatus = "CANCELED"
+)
+
+// TakeOfflineConfig determines when a backup site is automatically taken offline
+type TakeOfflineConfig struct {
+	// The number of consecutive failed requests after which the site is taken offline, disabled if <= 0
+	AfterFailures int32 `json:"after_failures"`
+	// The minimum time in milliseconds to wait after the first failure before the site is taken offline, disabled if <= 0
+	MinWait int64 `json:"min_wait"`
+}
+
 type NotSupportedError struct {
 	Version string
 }
@@ -169,6 +346,9 @@ type PathResolver interface {
 	Caches(string) string
 	CacheManager(string) string
 	Container(string) string
+	Counters(string) string
 	Logging(string) string
+	Schemas(string) string
+	Security(string) string
 	Server(string) string
 }
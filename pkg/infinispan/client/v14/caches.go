@@ -33,6 +33,16 @@ func (c *cache) entryUrl(key string) string {
 	return fmt.Sprintf("%s/%s", c.url(), url.PathEscape(key))
 }
 
+func (c *cache) AssignAlias(alias string) (err error) {
+	path := fmt.Sprintf("%s?action=assign-alias&alias=%s", c.url(), url.QueryEscape(alias))
+	rsp, err := c.Post(path, "", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "assigning cache alias", http.StatusOK, http.StatusNoContent)
+	return
+}
+
 func (c *cache) Config(contentType mime.MimeType) (config string, err error) {
 	path := c.url() + "?action=config"
 	rsp, err := c.HttpClient.Get(path, nil)
@@ -151,6 +161,13 @@ func (c *cache) Size() (size int, err error) {
 	return strconv.Atoi(body)
 }
 
+func (c *cache) Xsite() api.CacheXsite {
+	return &cacheXsite{
+		cache:      c,
+		HttpClient: c.HttpClient,
+	}
+}
+
 func (c *cache) RollingUpgrade() api.RollingUpgrade {
 	return &rollingUpgrade{
 		cache:      c,
@@ -0,0 +1,99 @@
+package v14
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	httpClient "github.com/infinispan/infinispan-operator/pkg/http"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/mime"
+)
+
+type counters struct {
+	api.PathResolver
+	httpClient.HttpClient
+}
+
+func (c *counters) url(name string) string {
+	return c.Counters("/" + url.PathEscape(name))
+}
+
+func (c *counters) Create(name string, config *api.CounterConfig) (err error) {
+	if err = validator.Var(name, "required"); err != nil {
+		return
+	}
+	if err = validator.Struct(config); err != nil {
+		return
+	}
+
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return
+	}
+	headers := map[string]string{"Content-Type": string(mime.ApplicationJson)}
+	rsp, err := c.Post(c.url(name), string(payload), headers)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	// The server returns 304 if a counter with the same name is already defined
+	err = httpClient.ValidateResponse(rsp, err, "creating counter", http.StatusOK, http.StatusNoContent, http.StatusNotModified)
+	return
+}
+
+func (c *counters) Delete(name string) (err error) {
+	rsp, err := c.HttpClient.Delete(c.url(name), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "deleting counter", http.StatusOK, http.StatusNoContent, http.StatusNotFound)
+	return
+}
+
+func (c *counters) Get(name string) (val int64, exists bool, err error) {
+	rsp, err := c.HttpClient.Get(c.url(name), map[string]string{"Accept": string(mime.TextPlain)})
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "getting counter value", http.StatusOK, http.StatusNotFound); err != nil {
+		return
+	}
+	if rsp.StatusCode == http.StatusNotFound {
+		return
+	}
+	body, err := readResponseBody(rsp)
+	if err != nil {
+		return
+	}
+	val, err = strconv.ParseInt(strings.TrimSpace(body), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("unable to parse counter value: %w", err)
+	}
+	return val, true, nil
+}
+
+func (c *counters) Names() (names []string, err error) {
+	rsp, err := c.HttpClient.Get(c.Counters(""), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "getting counter names", http.StatusOK); err != nil {
+		return
+	}
+	if err = json.NewDecoder(rsp.Body).Decode(&names); err != nil {
+		return nil, fmt.Errorf("unable to decode: %w", err)
+	}
+	return
+}
+
+func (c *counters) Reset(name string) (err error) {
+	rsp, err := c.Post(c.url(name)+"?action=reset", "", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "resetting counter", http.StatusOK, http.StatusNoContent)
+	return
+}
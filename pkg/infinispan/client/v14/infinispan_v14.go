@@ -30,6 +30,10 @@ func (i *infinispan) Container() api.Container {
 	return &Container{i.PathResolver, i.HttpClient}
 }
 
+func (i *infinispan) Counters() api.Counters {
+	return &counters{i.PathResolver, i.HttpClient}
+}
+
 func (i *infinispan) Logging() api.Logging {
 	return &logging{i.PathResolver, i.HttpClient}
 }
@@ -42,10 +46,22 @@ func (i *infinispan) ProtobufMetadataCacheName() string {
 	return "___protobuf_metadata"
 }
 
+func (i *infinispan) Roles() api.Roles {
+	return &roles{i.PathResolver, i.HttpClient}
+}
+
 func (i *infinispan) ScriptCacheName() string {
 	return "___script_cache"
 }
 
+func (i *infinispan) Schemas() api.Schemas {
+	return &schemas{i.PathResolver, i.HttpClient}
+}
+
 func (i *infinispan) Server() api.Server {
 	return &server{i.PathResolver, i.HttpClient}
 }
+
+func (i *infinispan) Users() api.Users {
+	return &users{i.PathResolver, i.HttpClient}
+}
@@ -20,7 +20,10 @@ func (m *metrics) Get(postfix string) (buf *bytes.Buffer, err error) {
 		"Acccept": string(mime.ApplicationJson),
 	}
 
-	path := fmt.Sprintf("%s/%s", MetricsPath, postfix)
+	path := MetricsPath
+	if postfix != "" {
+		path = fmt.Sprintf("%s/%s", MetricsPath, postfix)
+	}
 	rsp, err := m.HttpClient.Get(path, headers)
 	if err = httpClient.ValidateResponse(rsp, err, "getting metrics", http.StatusOK); err != nil {
 		return
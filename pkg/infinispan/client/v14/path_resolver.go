@@ -22,10 +22,22 @@ func (r *pathResolver) Container(s string) string {
 	return r.Root + "/container" + s
 }
 
+func (r *pathResolver) Counters(s string) string {
+	return r.Root + "/counters" + s
+}
+
 func (r *pathResolver) Logging(s string) string {
 	return r.Root + "/logging/loggers" + s
 }
 
+func (r *pathResolver) Schemas(s string) string {
+	return r.Root + "/schemas" + s
+}
+
+func (r *pathResolver) Security(s string) string {
+	return r.Root + "/security" + s
+}
+
 func (r *pathResolver) Server(s string) string {
 	return r.Root + "/server" + s
 }
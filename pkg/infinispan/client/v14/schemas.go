@@ -0,0 +1,89 @@
+package v14
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	httpClient "github.com/infinispan/infinispan-operator/pkg/http"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/mime"
+)
+
+type schemas struct {
+	api.PathResolver
+	httpClient.HttpClient
+}
+
+func (s *schemas) url(name string) string {
+	return s.Schemas("/" + url.PathEscape(name))
+}
+
+func (s *schemas) CreateOrUpdate(name, content string) (schemaErr *api.SchemaError, err error) {
+	if err = validator.Var(name, "required"); err != nil {
+		return
+	}
+
+	headers := map[string]string{"Content-Type": string(mime.TextPlain)}
+	rsp, err := s.Put(s.url(name), content, headers)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "creating schema", http.StatusOK, http.StatusNoContent); err != nil {
+		return
+	}
+
+	// The server returns the validation status of the schema in the response body
+	body, err := readResponseBody(rsp)
+	if err != nil || strings.TrimSpace(body) == "" {
+		return
+	}
+	info := &api.SchemaInfo{}
+	if err = json.Unmarshal([]byte(body), info); err != nil {
+		return nil, fmt.Errorf("unable to decode: %w", err)
+	}
+	return info.Error, nil
+}
+
+func (s *schemas) Delete(name string) (err error) {
+	rsp, err := s.HttpClient.Delete(s.url(name), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "deleting schema", http.StatusOK, http.StatusNoContent, http.StatusNotFound)
+	return
+}
+
+func (s *schemas) Get(name string) (content string, exists bool, err error) {
+	rsp, err := s.HttpClient.Get(s.url(name), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "getting schema", http.StatusOK, http.StatusNotFound); err != nil {
+		return
+	}
+	if rsp.StatusCode == http.StatusNotFound {
+		return
+	}
+	content, err = readResponseBody(rsp)
+	if err != nil {
+		return "", false, err
+	}
+	return content, true, nil
+}
+
+func (s *schemas) List() (schemas []api.SchemaInfo, err error) {
+	rsp, err := s.HttpClient.Get(s.Schemas(""), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "listing schemas", http.StatusOK); err != nil {
+		return
+	}
+	if err = json.NewDecoder(rsp.Body).Decode(&schemas); err != nil {
+		return nil, fmt.Errorf("unable to decode: %w", err)
+	}
+	return
+}
@@ -0,0 +1,203 @@
+package v14
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	httpClient "github.com/infinispan/infinispan-operator/pkg/http"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/mime"
+)
+
+type users struct {
+	api.PathResolver
+	httpClient.HttpClient
+}
+
+type roles struct {
+	api.PathResolver
+	httpClient.HttpClient
+}
+
+func (u *users) url(name string) string {
+	return u.Security("/users/" + url.PathEscape(name))
+}
+
+func (u *users) Create(name string, config *api.UserConfig) (err error) {
+	if err = validator.Var(name, "required"); err != nil {
+		return
+	}
+	if err = validator.Struct(config); err != nil {
+		return
+	}
+
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return
+	}
+	headers := map[string]string{"Content-Type": string(mime.ApplicationJson)}
+	rsp, err := u.Post(u.url(name), string(payload), headers)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "creating user", http.StatusOK, http.StatusNoContent)
+	return
+}
+
+func (u *users) Delete(name string) (err error) {
+	rsp, err := u.HttpClient.Delete(u.url(name), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "deleting user", http.StatusOK, http.StatusNoContent, http.StatusNotFound)
+	return
+}
+
+func (u *users) Get(name string) (info *api.UserInfo, exists bool, err error) {
+	rsp, err := u.HttpClient.Get(u.url(name), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "getting user", http.StatusOK, http.StatusNotFound); err != nil {
+		return
+	}
+	if rsp.StatusCode == http.StatusNotFound {
+		return
+	}
+	info = &api.UserInfo{}
+	if err = json.NewDecoder(rsp.Body).Decode(info); err != nil {
+		return nil, false, fmt.Errorf("unable to decode: %w", err)
+	}
+	return info, true, nil
+}
+
+func (u *users) Update(name string, config *api.UserConfig) (err error) {
+	if err = validator.Var(name, "required"); err != nil {
+		return
+	}
+	if err = validator.Struct(config); err != nil {
+		return
+	}
+
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return
+	}
+	headers := map[string]string{"Content-Type": string(mime.ApplicationJson)}
+	rsp, err := u.Put(u.url(name), string(payload), headers)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "updating user", http.StatusOK, http.StatusNoContent)
+	return
+}
+
+func (r *roles) url(name string) string {
+	return r.Security("/permissions/" + url.PathEscape(name))
+}
+
+func (r *roles) Create(name string, permissions []string) (err error) {
+	if err = validator.Var(name, "required"); err != nil {
+		return
+	}
+	if err = validator.Var(permissions, "required,min=1"); err != nil {
+		return
+	}
+
+	rsp, err := r.Post(r.url(name)+"?"+url.Values{"permission": permissions}.Encode(), "", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "creating role", http.StatusOK, http.StatusNoContent)
+	return
+}
+
+func (r *roles) Delete(name string) (err error) {
+	rsp, err := r.HttpClient.Delete(r.url(name), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "deleting role", http.StatusOK, http.StatusNoContent, http.StatusNotFound)
+	return
+}
+
+func (r *roles) Deny(principal string, roles ...string) (err error) {
+	if err = validator.Var(principal, "required"); err != nil {
+		return
+	}
+	if len(roles) == 0 {
+		return
+	}
+
+	query := url.Values{"action": {"deny"}, "role": roles}
+	path := r.Security("/roles/"+url.PathEscape(principal)) + "?" + query.Encode()
+	rsp, err := r.Put(path, "", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "denying roles", http.StatusOK, http.StatusNoContent)
+	return
+}
+
+func (r *roles) FlushCache() (err error) {
+	rsp, err := r.Post(r.Security("/cache?action=flush"), "", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "flushing ACL cache", http.StatusOK, http.StatusNoContent)
+	return
+}
+
+func (r *roles) Get(name string) (info *api.RoleInfo, exists bool, err error) {
+	rsp, err := r.HttpClient.Get(r.url(name), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "getting role", http.StatusOK, http.StatusNotFound); err != nil {
+		return
+	}
+	if rsp.StatusCode == http.StatusNotFound {
+		return
+	}
+	info = &api.RoleInfo{}
+	if err = json.NewDecoder(rsp.Body).Decode(info); err != nil {
+		return nil, false, fmt.Errorf("unable to decode: %w", err)
+	}
+	return info, true, nil
+}
+
+func (r *roles) Grant(principal string, roles ...string) (err error) {
+	if err = validator.Var(principal, "required"); err != nil {
+		return
+	}
+	if len(roles) == 0 {
+		return
+	}
+
+	query := url.Values{"action": {"grant"}, "role": roles}
+	path := r.Security("/roles/"+url.PathEscape(principal)) + "?" + query.Encode()
+	rsp, err := r.Put(path, "", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "granting roles", http.StatusOK, http.StatusNoContent)
+	return
+}
+
+func (r *roles) Update(name string, permissions []string) (err error) {
+	if err = validator.Var(name, "required"); err != nil {
+		return
+	}
+	if err = validator.Var(permissions, "required,min=1"); err != nil {
+		return
+	}
+
+	rsp, err := r.Put(r.url(name)+"?"+url.Values{"permission": permissions}.Encode(), "", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	err = httpClient.ValidateResponse(rsp, err, "updating role", http.StatusOK, http.StatusNoContent)
+	return
+}
@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	httpClient "github.com/infinispan/infinispan-operator/pkg/http"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/mime"
 )
 
 type xsite struct {
@@ -14,37 +16,155 @@ type xsite struct {
 	api.PathResolver
 }
 
+func (x *xsite) url() string {
+	return x.CacheManager("/x-site/backups")
+}
+
+func (x *xsite) BringOnline(site string) error {
+	return siteAction(x.HttpClient, x.url(), site, "bring-online", "bringing xsite online")
+}
+
+func (x *xsite) CancelPushState(site string) error {
+	return siteAction(x.HttpClient, x.url(), site, "cancel-push-state", "cancelling xsite state push")
+}
+
 func (x *xsite) PushAllState() (err error) {
-	xsitePath := x.CacheManager("/x-site/backups")
-	rsp, err := x.Get(xsitePath, nil)
-	if err = httpClient.ValidateResponse(rsp, err, "Retrieving xsite status", http.StatusOK); err != nil {
+	statuses, err := x.SiteStatuses()
+	if err != nil {
 		return
 	}
 
-	defer func() {
-		cerr := rsp.Body.Close()
-		if err == nil {
-			err = cerr
+	// Statuses will be empty if no xsite caches are configured
+	for site, status := range statuses {
+		if status == api.XsiteStatusOnline {
+			if err = x.PushState(site); err != nil {
+				return
+			}
 		}
+	}
+	return
+}
+
+func (x *xsite) PushState(site string) error {
+	return siteAction(x.HttpClient, x.url(), site, "start-push-state", "pushing xsite state")
+}
+
+func (x *xsite) SiteStatuses() (map[string]api.XsiteStatus, error) {
+	return siteStatuses(x.HttpClient, x.url())
+}
+
+func (x *xsite) TakeOffline(site string) error {
+	return siteAction(x.HttpClient, x.url(), site, "take-offline", "taking xsite offline")
+}
+
+type cacheXsite struct {
+	*cache
+	httpClient.HttpClient
+}
+
+func (x *cacheXsite) url() string {
+	return x.cache.url() + "/x-site"
+}
+
+func (x *cacheXsite) backupsUrl() string {
+	return x.url() + "/backups"
+}
+
+func (x *cacheXsite) BringOnline(site string) error {
+	return siteAction(x.HttpClient, x.backupsUrl(), site, "bring-online", "bringing cache xsite online")
+}
+
+func (x *cacheXsite) CancelPushState(site string) error {
+	return siteAction(x.HttpClient, x.backupsUrl(), site, "cancel-push-state", "cancelling cache xsite state push")
+}
+
+func (x *cacheXsite) ClearPushStateStatus() (err error) {
+	rsp, err := x.HttpClient.Post(x.url()+"/local?action=clear-push-state-status", "", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
 	}()
+	return httpClient.ValidateResponse(rsp, err, "clearing cache xsite push state status", http.StatusOK, http.StatusNoContent)
+}
 
-	type xsiteStatus struct {
-		Status string `json:"status"`
+func (x *cacheXsite) PushState(site string) error {
+	return siteAction(x.HttpClient, x.backupsUrl(), site, "start-push-state", "pushing cache xsite state")
+}
+
+func (x *cacheXsite) PushStateStatus() (statuses map[string]api.PushStateStatus, err error) {
+	rsp, err := x.HttpClient.Get(x.backupsUrl()+"?action=push-state-status", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "retrieving cache xsite push state status", http.StatusOK); err != nil {
+		return
 	}
-	var statuses map[string]xsiteStatus
-	if err := json.NewDecoder(rsp.Body).Decode(&statuses); err != nil {
-		return fmt.Errorf("unable to decode: %w", err)
+	if err = json.NewDecoder(rsp.Body).Decode(&statuses); err != nil {
+		return nil, fmt.Errorf("unable to decode: %w", err)
 	}
+	return
+}
 
-	// Statuses will be empty if no xsite caches are configured
-	for k, v := range statuses {
-		if v.Status == "online" {
-			url := fmt.Sprintf("%s/%s?action=start-push-state", xsitePath, k)
-			rsp, err = x.Post(url, "", nil)
-			if err = httpClient.ValidateResponse(rsp, err, "Pushing xsite state", http.StatusOK); err != nil {
-				return
-			}
-		}
+func (x *cacheXsite) SiteStatuses() (map[string]api.XsiteStatus, error) {
+	return siteStatuses(x.HttpClient, x.backupsUrl()+"/")
+}
+
+func (x *cacheXsite) TakeOffline(site string) error {
+	return siteAction(x.HttpClient, x.backupsUrl(), site, "take-offline", "taking cache xsite offline")
+}
+
+func (x *cacheXsite) takeOfflineConfigUrl(site string) string {
+	return fmt.Sprintf("%s/%s/take-offline-config", x.backupsUrl(), url.PathEscape(site))
+}
+
+func (x *cacheXsite) TakeOfflineConfig(site string) (config *api.TakeOfflineConfig, err error) {
+	rsp, err := x.HttpClient.Get(x.takeOfflineConfigUrl(site), nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "retrieving cache xsite take-offline config", http.StatusOK); err != nil {
+		return
+	}
+	config = &api.TakeOfflineConfig{}
+	if err = json.NewDecoder(rsp.Body).Decode(config); err != nil {
+		return nil, fmt.Errorf("unable to decode: %w", err)
+	}
+	return
+}
+
+func (x *cacheXsite) UpdateTakeOfflineConfig(site string, config *api.TakeOfflineConfig) (err error) {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return
+	}
+	headers := map[string]string{
+		"Content-Type": string(mime.ApplicationJson),
+	}
+	rsp, err := x.HttpClient.Put(x.takeOfflineConfigUrl(site), string(payload), headers)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	return httpClient.ValidateResponse(rsp, err, "updating cache xsite take-offline config", http.StatusOK, http.StatusNoContent)
+}
+
+func siteAction(client httpClient.HttpClient, backupsUrl, site, action, entity string) (err error) {
+	path := fmt.Sprintf("%s/%s?action=%s", backupsUrl, url.PathEscape(site), action)
+	rsp, err := client.Post(path, "", nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	return httpClient.ValidateResponse(rsp, err, entity, http.StatusOK, http.StatusNoContent)
+}
+
+func siteStatuses(client httpClient.HttpClient, path string) (statuses map[string]api.XsiteStatus, err error) {
+	rsp, err := client.Get(path, nil)
+	defer func() {
+		err = httpClient.CloseBody(rsp, err)
+	}()
+	if err = httpClient.ValidateResponse(rsp, err, "retrieving xsite status", http.StatusOK); err != nil {
+		return
+	}
+	if err = json.NewDecoder(rsp.Body).Decode(&statuses); err != nil {
+		return nil, fmt.Errorf("unable to decode: %w", err)
 	}
 	return
 }
@@ -0,0 +1,176 @@
+package container
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type CacheConfig struct {
+	DistributedCache *Cache `json:"distributed-cache,omitempty"`
+	ReplicatedCache  *Cache `json:"replicated-cache,omitempty"`
+}
+
+type Cache struct {
+	Mode        string             `json:"mode"`
+	Owners      *int32             `json:"owners,omitempty"`
+	Statistics  bool               `json:"statistics"`
+	Encoding    *Encoding          `json:"encoding,omitempty"`
+	Memory      *Memory            `json:"memory,omitempty"`
+	Expiration  *Expiration        `json:"expiration,omitempty"`
+	Locking     *Locking           `json:"locking,omitempty"`
+	Indexing    *Indexing          `json:"indexing,omitempty"`
+	Backups     map[string]*Backup `json:"backups,omitempty"`
+	Persistence *Persistence       `json:"persistence,omitempty"`
+}
+
+type Encoding struct {
+	Key   *MediaType `json:"key,omitempty"`
+	Value *MediaType `json:"value,omitempty"`
+}
+
+type MediaType struct {
+	MediaType string `json:"media-type"`
+}
+
+type Memory struct {
+	Storage  string `json:"storage,omitempty"`
+	MaxCount *int64 `json:"max-count,omitempty"`
+	MaxSize  string `json:"max-size,omitempty"`
+	WhenFull string `json:"when-full,omitempty"`
+}
+
+type Expiration struct {
+	Lifespan *int64 `json:"lifespan,omitempty"`
+	MaxIdle  *int64 `json:"max-idle,omitempty"`
+	Interval *int64 `json:"interval,omitempty"`
+}
+
+type Locking struct {
+	Isolation        string `json:"isolation,omitempty"`
+	Striping         bool   `json:"striping"`
+	AcquireTimeout   *int64 `json:"acquire-timeout,omitempty"`
+	ConcurrencyLevel *int32 `json:"concurrency-level,omitempty"`
+}
+
+type Indexing struct {
+	Enabled         bool     `json:"enabled"`
+	Storage         string   `json:"storage,omitempty"`
+	IndexedEntities []string `json:"indexed-entities,omitempty"`
+}
+
+type Backup struct {
+	Backup *BackupConfig `json:"backup"`
+}
+
+type BackupConfig struct {
+	Strategy      string `json:"strategy,omitempty"`
+	FailurePolicy string `json:"failure-policy,omitempty"`
+	Timeout       *int64 `json:"timeout,omitempty"`
+}
+
+// CreateCacheConfig returns the JSON representation of the provided cache configuration
+func CreateCacheConfig(cfg *CacheConfig) (string, error) {
+	doc, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
+// ApplyAliases sets the aliases of the provided JSON cache configuration, returning the updated JSON document
+func ApplyAliases(config string, aliases ...string) (string, error) {
+	return updateCacheDefinition(config, func(cache map[string]interface{}) {
+		cache["aliases"] = aliases
+	})
+}
+
+// RemoveAliases removes the aliases from the provided JSON cache configuration, returning the updated JSON document
+func RemoveAliases(config string) (string, error) {
+	return updateCacheDefinition(config, func(cache map[string]interface{}) {
+		delete(cache, "aliases")
+	})
+}
+
+// AddAuthorizationRoles adds the roles to the roles allowed to access the provided JSON cache configuration, returning
+// the updated JSON document. Caches that do not restrict their authorization roles are already accessible by every
+// role, so their configuration is returned unchanged
+func AddAuthorizationRoles(config string, roles ...string) (string, error) {
+	return updateCacheDefinition(config, func(cache map[string]interface{}) {
+		authz := authorization(cache)
+		cacheRoles := authorizationRoles(authz)
+		if authz == nil || len(cacheRoles) == 0 {
+			return
+		}
+		for _, role := range roles {
+			if !containsRole(cacheRoles, role) {
+				cacheRoles = append(cacheRoles, role)
+			}
+		}
+		authz["roles"] = cacheRoles
+	})
+}
+
+// RemoveAuthorizationRoles removes the roles from the roles allowed to access the provided JSON cache configuration,
+// returning the updated JSON document
+func RemoveAuthorizationRoles(config string, roles ...string) (string, error) {
+	return updateCacheDefinition(config, func(cache map[string]interface{}) {
+		authz := authorization(cache)
+		if authz == nil {
+			return
+		}
+		var cacheRoles []interface{}
+		for _, r := range authorizationRoles(authz) {
+			if !containsRole(toInterfaces(roles), r) {
+				cacheRoles = append(cacheRoles, r)
+			}
+		}
+		if len(cacheRoles) == 0 {
+			delete(authz, "roles")
+			return
+		}
+		authz["roles"] = cacheRoles
+	})
+}
+
+// HasAuthorizationRole returns true if the role is one of the roles allowed to access the provided JSON cache configuration
+func HasAuthorizationRole(config, role string) (bool, error) {
+	var doc map[string]map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &doc); err != nil {
+		return false, fmt.Errorf("unable to unmarshal cache configuration: %w", err)
+	}
+	for _, cache := range doc {
+		if containsRole(authorizationRoles(authorization(cacheDefinition(cache))), role) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// authorization returns the authorization element of the cache definition, or nil if it does not exist
+func authorization(cache map[string]interface{}) map[string]interface{} {
+	security, _ := cache["security"].(map[string]interface{})
+	authz, _ := security["authorization"].(map[string]interface{})
+	return authz
+}
+
+func authorizationRoles(authz map[string]interface{}) []interface{} {
+	roles, _ := authz["roles"].([]interface{})
+	return roles
+}
+
+func containsRole(roles []interface{}, role interface{}) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func toInterfaces(s []string) []interface{} {
+	i := make([]interface{}, len(s))
+	for n, v := range s {
+		i[n] = v
+	}
+	return i
+}
@@ -0,0 +1,109 @@
+package container
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+)
+
+func TestCreateCacheConfig(t *testing.T) {
+	cfg := &CacheConfig{
+		DistributedCache: &Cache{
+			Mode:   "SYNC",
+			Owners: pointer.Int32(2),
+			Encoding: &Encoding{
+				Value: &MediaType{MediaType: "application/x-protostream"},
+			},
+			Memory: &Memory{MaxCount: pointer.Int64(100), WhenFull: "REMOVE"},
+			Backups: map[string]*Backup{
+				"site-b": {Backup: &BackupConfig{Strategy: "ASYNC"}},
+			},
+		},
+	}
+
+	config, err := CreateCacheConfig(cfg)
+	assert.Nil(t, err)
+
+	var doc map[string]map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(config), &doc))
+	assert.NotContains(t, doc, "replicated-cache")
+
+	cache := doc["distributed-cache"]
+	assert.Equal(t, "SYNC", cache["mode"])
+	assert.Equal(t, float64(2), cache["owners"])
+	assert.NotContains(t, cache, "expiration")
+	assert.NotContains(t, cache, "persistence")
+
+	value := cache["encoding"].(map[string]interface{})["value"].(map[string]interface{})
+	assert.Equal(t, "application/x-protostream", value["media-type"])
+
+	memory := cache["memory"].(map[string]interface{})
+	assert.Equal(t, float64(100), memory["max-count"])
+	assert.NotContains(t, memory, "max-size")
+
+	backup := cache["backups"].(map[string]interface{})["site-b"].(map[string]interface{})["backup"].(map[string]interface{})
+	assert.Equal(t, "ASYNC", backup["strategy"])
+}
+
+func TestApplyAliases(t *testing.T) {
+	config := `{"replicated-cache":{"mode":"SYNC"}}`
+
+	updated, err := ApplyAliases(config, "example")
+	assert.Nil(t, err)
+
+	var doc map[string]map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(updated), &doc))
+	cache := doc["replicated-cache"]
+	assert.Equal(t, "SYNC", cache["mode"])
+	assert.Equal(t, []interface{}{"example"}, cache["aliases"])
+
+	removed, err := RemoveAliases(updated)
+	assert.Nil(t, err)
+	assert.JSONEq(t, config, removed)
+}
+
+func TestAuthorizationRoles(t *testing.T) {
+	config := `{"distributed-cache":{"mode":"SYNC","security":{"authorization":{"enabled":true,"roles":["admin"]}}}}`
+
+	updated, err := AddAuthorizationRoles(config, "reader", "writer")
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"distributed-cache":{"mode":"SYNC","security":{"authorization":{"enabled":true,"roles":["admin","reader","writer"]}}}}`, updated)
+
+	exists, err := HasAuthorizationRole(updated, "reader")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	// Adding an existing role is a no-op
+	again, err := AddAuthorizationRoles(updated, "reader")
+	assert.Nil(t, err)
+	assert.JSONEq(t, updated, again)
+
+	removed, err := RemoveAuthorizationRoles(updated, "reader", "writer")
+	assert.Nil(t, err)
+	assert.JSONEq(t, config, removed)
+
+	exists, err = HasAuthorizationRole(removed, "reader")
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	// Caches that do not restrict their roles are already accessible by every role
+	unrestricted := `{"replicated-cache":{"mode":"SYNC","security":{"authorization":{"enabled":true}}}}`
+	updated, err = AddAuthorizationRoles(unrestricted, "reader")
+	assert.Nil(t, err)
+	assert.JSONEq(t, unrestricted, updated)
+
+	updated, err = AddAuthorizationRoles(`{"replicated-cache":{"mode":"SYNC"}}`, "reader")
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"replicated-cache":{"mode":"SYNC"}}`, updated)
+
+	// The configuration returned by the server is wrapped with the cache name
+	updated, err = AddAuthorizationRoles(`{"example":{"local-cache":{"security":{"authorization":{"roles":["admin"]}}}}}`, "reader")
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"example":{"local-cache":{"security":{"authorization":{"roles":["admin","reader"]}}}}}`, updated)
+
+	exists, err = HasAuthorizationRole(updated, "reader")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+}
@@ -0,0 +1,171 @@
+package container
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type Persistence struct {
+	Passivation          bool                  `json:"passivation"`
+	StringKeyedJdbcStore *StringKeyedJdbcStore `json:"string-keyed-jdbc-store,omitempty"`
+	TableJdbcStore       *TableJdbcStore       `json:"table-jdbc-store,omitempty"`
+	QueryJdbcStore       *QueryJdbcStore       `json:"query-jdbc-store,omitempty"`
+	FileStore            *FileStore            `json:"file-store,omitempty"`
+}
+
+type Store struct {
+	Shared  bool `json:"shared"`
+	Preload bool `json:"preload"`
+	Purge   bool `json:"purge"`
+}
+
+// ConnectionPool a JDBC connection pool owned by a single store, so that stores do not depend on data sources defined
+// in the server configuration
+type ConnectionPool struct {
+	ConnectionUrl string `json:"connection-url"`
+	Driver        string `json:"driver"`
+	Username      string `json:"username,omitempty"`
+	// Password a CredentialReference to the password in the user credential store
+	Password string `json:"password,omitempty"`
+}
+
+type StringKeyedJdbcStore struct {
+	Store
+	Dialect          string            `json:"dialect,omitempty"`
+	ConnectionPool   *ConnectionPool   `json:"connection-pool"`
+	StringKeyedTable *StringKeyedTable `json:"string-keyed-table"`
+}
+
+type StringKeyedTable struct {
+	Prefix          string  `json:"prefix,omitempty"`
+	CreateOnStart   bool    `json:"create-on-start"`
+	DropOnExit      bool    `json:"drop-on-exit"`
+	IdColumn        *Column `json:"id-column"`
+	DataColumn      *Column `json:"data-column"`
+	TimestampColumn *Column `json:"timestamp-column"`
+	SegmentColumn   *Column `json:"segment-column,omitempty"`
+}
+
+type Column struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+}
+
+type TableJdbcStore struct {
+	Store
+	Dialect        string          `json:"dialect,omitempty"`
+	ConnectionPool *ConnectionPool `json:"connection-pool"`
+	TableName      string          `json:"table-name"`
+}
+
+type QueryJdbcStore struct {
+	Store
+	Dialect        string          `json:"dialect,omitempty"`
+	ConnectionPool *ConnectionPool `json:"connection-pool"`
+	KeyColumns     string          `json:"key-columns"`
+	Queries        *Queries        `json:"queries"`
+}
+
+type Queries struct {
+	SelectSingle string `json:"select-single"`
+	SelectAll    string `json:"select-all"`
+	Size         string `json:"size"`
+	Upsert       string `json:"upsert,omitempty"`
+	DeleteSingle string `json:"delete-single,omitempty"`
+	DeleteAll    string `json:"delete-all,omitempty"`
+}
+
+type FileStore struct {
+	Preload bool   `json:"preload"`
+	Purge   bool   `json:"purge"`
+	Path    string `json:"path,omitempty"`
+}
+
+// ApplyPersistence replaces the persistence configuration of the provided JSON cache configuration, returning the
+// updated JSON document
+func ApplyPersistence(config string, persistence *Persistence) (string, error) {
+	return updateCacheDefinition(config, func(cache map[string]interface{}) {
+		cache["persistence"] = persistence
+	})
+}
+
+// RemovePersistence removes the persistence configuration from the provided JSON cache configuration, returning the
+// updated JSON document
+func RemovePersistence(config string) (string, error) {
+	return updateCacheDefinition(config, func(cache map[string]interface{}) {
+		delete(cache, "persistence")
+	})
+}
+
+// CredentialReference returns the expression that resolves the alias from the user credential store of the server, so
+// that a password is never included in a cache configuration
+func CredentialReference(alias string) string {
+	return fmt.Sprintf("${credential-store:credentials:%s}", alias)
+}
+
+func updateCacheDefinition(config string, update func(map[string]interface{})) (string, error) {
+	var doc map[string]map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &doc); err != nil {
+		return "", fmt.Errorf("unable to unmarshal cache configuration: %w", err)
+	}
+
+	if len(doc) != 1 {
+		return "", fmt.Errorf("expected a single cache definition in configuration, found %d", len(doc))
+	}
+
+	for _, cache := range doc {
+		update(cacheDefinition(cache))
+	}
+
+	updated, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(updated), nil
+}
+
+// cacheDefinition returns the cache definition of a configuration wrapped with the cache name, as returned by the
+// server, or the provided definition if it is not wrapped
+func cacheDefinition(cache map[string]interface{}) map[string]interface{} {
+	if len(cache) == 1 {
+		for key, value := range cache {
+			if definition, ok := value.(map[string]interface{}); ok && strings.HasSuffix(key, "-cache") {
+				return definition
+			}
+		}
+	}
+	return cache
+}
+
+// HasPersistence returns true if the provided JSON cache configuration defines at least one cache store. Both the
+// plain cache definition and the definition wrapped with the cache name, as returned by the server, are supported
+func HasPersistence(config string) (bool, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(config), &doc); err != nil {
+		return false, fmt.Errorf("unable to unmarshal cache configuration: %w", err)
+	}
+	return hasStore(doc), nil
+}
+
+func hasStore(definition map[string]interface{}) bool {
+	for key, value := range definition {
+		element, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if key == "persistence" {
+			// Stores are the only object elements of the persistence configuration, all other elements are attributes
+			for _, store := range element {
+				if _, ok := store.(map[string]interface{}); ok {
+					return true
+				}
+			}
+			return false
+		}
+		if hasStore(element) {
+			return true
+		}
+	}
+	return false
+}
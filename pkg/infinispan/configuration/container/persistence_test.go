@@ -0,0 +1,76 @@
+package container
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyPersistence(t *testing.T) {
+	config := `{"distributed-cache":{"mode":"SYNC","persistence":{"file-store":{}}}}`
+	persistence := &Persistence{
+		StringKeyedJdbcStore: &StringKeyedJdbcStore{
+			Store: Store{Shared: true},
+			ConnectionPool: &ConnectionPool{
+				ConnectionUrl: "jdbc:postgresql://postgres:5432/db",
+				Driver:        "org.postgresql.Driver",
+				Username:      "user",
+				Password:      CredentialReference("books-store-1"),
+			},
+			StringKeyedTable: &StringKeyedTable{
+				Prefix:          "ispn",
+				CreateOnStart:   true,
+				IdColumn:        &Column{Name: "id", Type: "VARCHAR(255)"},
+				DataColumn:      &Column{Name: "data", Type: "BYTEA"},
+				TimestampColumn: &Column{Name: "ts", Type: "BIGINT"},
+			},
+		},
+	}
+
+	updated, err := ApplyPersistence(config, persistence)
+	assert.Nil(t, err)
+
+	var doc map[string]map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(updated), &doc))
+	cache := doc["distributed-cache"]
+	assert.Equal(t, "SYNC", cache["mode"])
+
+	persistenceDoc := cache["persistence"].(map[string]interface{})
+	assert.NotContains(t, persistenceDoc, "file-store")
+	store := persistenceDoc["string-keyed-jdbc-store"].(map[string]interface{})
+	assert.Equal(t, true, store["shared"])
+	pool := store["connection-pool"].(map[string]interface{})
+	assert.Equal(t, "jdbc:postgresql://postgres:5432/db", pool["connection-url"])
+	assert.Equal(t, "user", pool["username"])
+	assert.Equal(t, "${credential-store:credentials:books-store-1}", pool["password"])
+
+	removed, err := RemovePersistence(updated)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"distributed-cache":{"mode":"SYNC"}}`, removed)
+}
+
+func TestApplyPersistenceMultipleCaches(t *testing.T) {
+	_, err := ApplyPersistence(`{"a":{},"b":{}}`, &Persistence{})
+	assert.NotNil(t, err)
+}
+
+func TestHasPersistence(t *testing.T) {
+	for _, tc := range []struct {
+		config     string
+		persistent bool
+	}{
+		{`{"distributed-cache":{"mode":"SYNC"}}`, false},
+		{`{"distributed-cache":{"mode":"SYNC","persistence":{"passivation":false}}}`, false},
+		{`{"distributed-cache":{"mode":"SYNC","persistence":{"passivation":false,"file-store":{}}}}`, true},
+		{`{"example":{"replicated-cache":{"mode":"SYNC","persistence":{"table-jdbc-store":{"table-name":"t"}}}}}`, true},
+		{`{"example":{"local-cache":{"statistics":true}}}`, false},
+	} {
+		persistent, err := HasPersistence(tc.config)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.persistent, persistent, tc.config)
+	}
+
+	_, err := HasPersistence("<infinispan/>")
+	assert.NotNil(t, err)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/blang/semver"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/version"
@@ -14,6 +15,8 @@ type Spec struct {
 	StatefulSetName     string
 	Infinispan          Infinispan
 	JGroups             JGroups
+	LDAP                *LDAPRealm
+	TokenRealm          *TokenRealm
 	CloudEvents         *CloudEvents
 	Endpoints           Endpoints
 	Keystore            Keystore
@@ -21,6 +24,37 @@ type Spec struct {
 	Truststore          Truststore
 	UserCredentialStore bool
 	XSite               *XSite
+	// PreviousAdminCredentials and PreviousUserCredentials add a properties realm containing the passwords replaced by a
+	// credential rotation, so that they remain valid until the grace period of the rotation ends. The realms are defined
+	// whilst a rotation is not in progress, so that rotations do not change the configuration
+	PreviousAdminCredentials bool
+	PreviousUserCredentials  bool
+}
+
+// LDAPRealm an LDAP directory used by the default security realm to authenticate users alongside the users of the
+// properties realm, with the password of the bind DN stored in the user credential store
+type LDAPRealm struct {
+	URL             string
+	Principal       string
+	CredentialAlias string
+	// Truststore the path of the CA certificates trusted by LDAPS connections. The default truststore of the JVM is used if empty
+	Truststore     string
+	UserSearchDN   string
+	UserFilter     string
+	UserAttribute  string
+	GroupSearchDN  string
+	GroupFilter    string
+	GroupAttribute string
+}
+
+// TokenRealm an identity provider whose JWT bearer tokens are used by the default security realm to authenticate users
+// alongside the users of the properties realm
+type TokenRealm struct {
+	IssuerURL      string
+	ClientID       string
+	PublicKey      string
+	PrincipalClaim string
+	RoleClaim      string
 }
 
 type Infinispan struct {
@@ -30,9 +64,11 @@ type Infinispan struct {
 }
 
 type Authorization struct {
-	Enabled    bool
-	RoleMapper string
-	Roles      []AuthorizationRole
+	Enabled bool
+	// PermissionMapper enables the roles to be defined at runtime, which is only required by Role CRs
+	PermissionMapper bool
+	RoleMapper       string
+	Roles            []AuthorizationRole
 }
 
 type AuthorizationRole struct {
@@ -59,6 +95,30 @@ type JGroups struct {
 	Diagnostics bool
 	FastMerge   bool
 	Version     semver.Version
+	// Discovery the protocol used to discover cluster members, one of DNS, Kubernetes or Static
+	Discovery   string
+	StaticHosts []string
+	// PodLabel the label used to select the cluster pods with Kubernetes discovery
+	PodLabel         string
+	FailureDetection *JGroupsFailureDetection
+	Merge            *JGroupsMerge
+	Encryption       *JGroupsEncryption
+}
+
+type JGroupsFailureDetection struct {
+	Interval int64
+	Timeout  int64
+}
+
+type JGroupsMerge struct {
+	MinInterval int64
+	MaxInterval int64
+}
+
+type JGroupsEncryption struct {
+	// Type the encryption protocol, one of Symmetric or Asymmetric
+	Type     string
+	Keystore Keystore
 }
 
 type CloudEvents struct {
@@ -95,6 +155,30 @@ type TransportTLS struct {
 type Endpoints struct {
 	Authenticate bool
 	ClientCert   string
+	Connectors   []Connector
+}
+
+// Connector an endpoint serving a single protocol on a dedicated socket binding
+type Connector struct {
+	Name          string
+	Type          string
+	Port          int32
+	SecurityRealm string
+}
+
+// Element returns the name of the server configuration element of the connector
+func (c Connector) Element() string {
+	switch c.Type {
+	case "HotRod":
+		return "hotrod-connector"
+	case "REST":
+		return "rest-connector"
+	case "RESP":
+		return "resp-connector"
+	case "Memcached":
+		return "memcached-connector"
+	}
+	return ""
 }
 
 // Generate the base and admin configuration files used by the Infinispan server
@@ -145,6 +229,11 @@ func mapVersionsToSpec(operand version.Operand, spec *Spec) {
 	}
 }
 
+// InitialHosts returns the static hosts as a TCPPING initial_hosts value
+func (jgroups JGroups) InitialHosts() string {
+	return strings.Join(jgroups.StaticHosts, ",")
+}
+
 func (xSite XSite) RemoteSites() string {
 	var ret string
 	first := true
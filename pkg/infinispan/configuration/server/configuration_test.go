@@ -2,6 +2,7 @@ package server
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/blang/semver"
@@ -49,6 +50,215 @@ func TestGenerateAndGenerateZeroCapacity_v14(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestGenerateEndpointConnectors(t *testing.T) {
+	spec := Spec{
+		Infinispan: Infinispan{Authorization: &Authorization{}},
+		Endpoints: Endpoints{
+			Authenticate: true,
+			ClientCert:   "None",
+			Connectors: []Connector{
+				{Name: "redis", Type: "RESP", Port: 6379, SecurityRealm: "default"},
+				{Name: "hr", Type: "HotRod", Port: 11000, SecurityRealm: "default"},
+			},
+		},
+	}
+
+	for _, major := range []uint64{14, 15} {
+		vers := semver.Version{Major: major}
+		baseCfg, _, err := Generate(version.Operand{UpstreamVersion: &vers}, &spec)
+		assert.Nil(t, err)
+		assert.Contains(t, baseCfg, `<socket-binding name="redis" port="6379"/>`)
+		assert.Contains(t, baseCfg, `<socket-binding name="hr" port="11000"/>`)
+		assert.Contains(t, baseCfg, `<endpoint socket-binding="redis" security-realm="default" >`)
+		assert.Contains(t, baseCfg, `<resp-connector name="redis"/>`)
+	}
+}
+
+func TestGeneratePermissionMapper(t *testing.T) {
+	spec := Spec{Infinispan: Infinispan{Authorization: &Authorization{Enabled: true, PermissionMapper: true}}}
+
+	for _, major := range []uint64{14, 15} {
+		vers := semver.Version{Major: major}
+		baseCfg, _, err := Generate(version.Operand{UpstreamVersion: &vers}, &spec)
+		assert.Nil(t, err)
+		assert.Contains(t, baseCfg, "<cluster-permission-mapper />")
+	}
+}
+
+func TestGenerateJGroupsStack(t *testing.T) {
+	spec := Spec{
+		Namespace:       "ns",
+		StatefulSetName: "example",
+		Infinispan:      Infinispan{Authorization: &Authorization{}},
+		JGroups: JGroups{
+			Discovery: "Kubernetes",
+			PodLabel:  "app.kubernetes.io/created-by=example",
+			FailureDetection: &JGroupsFailureDetection{
+				Interval: 1000,
+				Timeout:  5000,
+			},
+			Merge: &JGroupsMerge{
+				MinInterval: 2000,
+				MaxInterval: 4000,
+			},
+			Encryption: &JGroupsEncryption{
+				Type: "Symmetric",
+				Keystore: Keystore{
+					Alias:    "key",
+					Password: "secret",
+					Path:     "/etc/encrypt/jgroups-encryption/keystore.p12",
+				},
+			},
+		},
+	}
+
+	vers := semver.Version{Major: 15}
+	_, adminCfg, err := Generate(version.Operand{UpstreamVersion: &vers}, &spec)
+	assert.Nil(t, err)
+	assert.Contains(t, adminCfg, `<kubernetes.KUBE_PING namespace="ns" labels="app.kubernetes.io/created-by=example" stack.combine="REPLACE" stack.position="dns.DNS_PING" />`)
+	assert.NotContains(t, adminCfg, "dns.DNS_PING dns_query")
+	assert.Contains(t, adminCfg, `<FD_ALL3 interval="1000" timeout="5000" stack.combine="COMBINE" />`)
+	assert.Contains(t, adminCfg, `<MERGE3 min_interval="2000" max_interval="4000" stack.combine="COMBINE" />`)
+	assert.Contains(t, adminCfg, `<SYM_ENCRYPT keystore_name="/etc/encrypt/jgroups-encryption/keystore.p12" keystore_type="PKCS12" store_password="secret" key_password="secret" alias="key" stack.combine="INSERT_BEFORE" stack.position="pbcast.NAKACK2" />`)
+
+	spec.JGroups = JGroups{
+		Discovery:   "Static",
+		StaticHosts: []string{"host1[7800]", "host2[7800]"},
+		Encryption:  &JGroupsEncryption{Type: "Asymmetric"},
+	}
+	_, adminCfg, err = Generate(version.Operand{UpstreamVersion: &vers}, &spec)
+	assert.Nil(t, err)
+	assert.Contains(t, adminCfg, `<TCPPING initial_hosts="host1[7800],host2[7800]" port_range="0" stack.combine="REPLACE" stack.position="dns.DNS_PING" />`)
+	assert.Contains(t, adminCfg, `<ASYM_ENCRYPT`)
+	assert.NotContains(t, adminCfg, "FD_ALL3")
+}
+
+func TestGenerateLDAPRealm(t *testing.T) {
+	spec := Spec{
+		Infinispan:          Infinispan{Authorization: &Authorization{}},
+		Endpoints:           Endpoints{Authenticate: true, ClientCert: "None"},
+		UserCredentialStore: true,
+		LDAP: &LDAPRealm{
+			URL:             "ldaps://ldap:636",
+			Principal:       "cn=admin,dc=example,dc=org",
+			CredentialAlias: "ldap-bind-password",
+			UserSearchDN:    "ou=users,dc=example,dc=org",
+			UserFilter:      "(&(objectClass=person)(uid={0}))",
+			UserAttribute:   "uid",
+		},
+	}
+
+	for _, major := range []uint64{14, 15} {
+		vers := semver.Version{Major: major}
+		baseCfg, _, err := Generate(version.Operand{UpstreamVersion: &vers}, &spec)
+		assert.Nil(t, err)
+		assert.Contains(t, baseCfg, `<ldap-realm name="ldap" url="ldaps://ldap:636" principal="cn=admin,dc=example,dc=org" direct-verification="true" >`)
+		assert.Contains(t, baseCfg, `<credential-reference store="credentials" alias="ldap-bind-password"/>`)
+		assert.Contains(t, baseCfg, `<identity-mapping rdn-identifier="uid" search-dn="ou=users,dc=example,dc=org" filter-name="(&amp;(objectClass=person)(uid={0}))" search-recursive="true">`)
+		assert.NotContains(t, baseCfg, "<attribute-mapping>")
+		// The operator managed users remain valid alongside the LDAP users
+		assert.Contains(t, baseCfg, `<properties-realm groups-attribute="Roles">`)
+		assert.Contains(t, baseCfg, "<distributed-realm/>")
+		assert.NotContains(t, baseCfg, `<security-realm name="ldap-client">`)
+		// The LDAP users can also be authenticated on their own by connectors with securityRealm 'ldap'
+		assert.Contains(t, baseCfg, `<security-realm name="ldap">`)
+		assert.Equal(t, 2, strings.Count(baseCfg, "<ldap-realm "))
+	}
+
+	spec.LDAP.Truststore = "/opt/infinispan/server/conf/operator-security/ldap-truststore.pem"
+	spec.LDAP.GroupSearchDN = "ou=groups,dc=example,dc=org"
+	spec.LDAP.GroupFilter = "(member={1})"
+	spec.LDAP.GroupAttribute = "cn"
+	vers := semver.Version{Major: 15}
+	baseCfg, _, err := Generate(version.Operand{UpstreamVersion: &vers}, &spec)
+	assert.Nil(t, err)
+	assert.Contains(t, baseCfg, `direct-verification="true" client-ssl-context="ldap-client">`)
+	assert.Contains(t, baseCfg, `<security-realm name="ldap-client">`)
+	assert.Contains(t, baseCfg, `<truststore path="/opt/infinispan/server/conf/operator-security/ldap-truststore.pem"/>`)
+	assert.Contains(t, baseCfg, `<attribute from="cn" to="Roles" filter="(member={1})" filter-dn="ou=groups,dc=example,dc=org"/>`)
+}
+
+func TestGenerateTokenRealm(t *testing.T) {
+	spec := Spec{
+		Infinispan: Infinispan{Authorization: &Authorization{}},
+		Endpoints: Endpoints{
+			Authenticate: true,
+			ClientCert:   "None",
+			Connectors: []Connector{
+				{Name: "hr", Type: "HotRod", Port: 11000, SecurityRealm: "default"},
+				{Name: "rest", Type: "REST", Port: 11001, SecurityRealm: "default"},
+				{Name: "hr-token", Type: "HotRod", Port: 11002, SecurityRealm: "token"},
+				{Name: "rest-token", Type: "REST", Port: 11003, SecurityRealm: "token"},
+			},
+		},
+		TokenRealm: &TokenRealm{
+			IssuerURL:      "https://keycloak/realms/infinispan",
+			ClientID:       "infinispan",
+			PublicKey:      "MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA",
+			PrincipalClaim: "preferred_username",
+			RoleClaim:      "groups",
+		},
+	}
+
+	for _, major := range []uint64{14, 15} {
+		vers := semver.Version{Major: major}
+		baseCfg, adminCfg, err := Generate(version.Operand{UpstreamVersion: &vers}, &spec)
+		assert.Nil(t, err)
+		assert.Contains(t, baseCfg, `<token-realm name="token" auth-server-url="https://keycloak/realms/infinispan" client-id="infinispan" principal-claim="preferred_username" groups-attribute="groups">`)
+		assert.Contains(t, baseCfg, `<jwt issuer="https://keycloak/realms/infinispan" audience="infinispan" public-key="MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA"/>`)
+		assert.Contains(t, baseCfg, `<hotrod-connector name="hr">`)
+		assert.Contains(t, baseCfg, `<rest-connector name="rest">`)
+		// Bearer tokens are accepted alongside the passwords of the users in the properties realm
+		assert.Contains(t, baseCfg, `<sasl mechanisms="OAUTHBEARER SCRAM-SHA-512 SCRAM-SHA-384 SCRAM-SHA-256 SCRAM-SHA-1 DIGEST-SHA-512 DIGEST-SHA-384 DIGEST-SHA-256 DIGEST-SHA DIGEST-MD5 PLAIN" qop="auth" server-name="infinispan"/>`)
+		assert.Contains(t, baseCfg, `<authentication mechanisms="BEARER_TOKEN DIGEST BASIC"/>`)
+		assert.Contains(t, baseCfg, `<properties-realm groups-attribute="Roles">`)
+		assert.Contains(t, baseCfg, "<distributed-realm/>")
+		// Connectors of the token realm only accept bearer tokens
+		assert.Contains(t, baseCfg, `<security-realm name="token">`)
+		assert.Contains(t, baseCfg, `<endpoint socket-binding="hr-token" security-realm="token" >`)
+		assert.Contains(t, baseCfg, `<sasl mechanisms="OAUTHBEARER" qop="auth" server-name="infinispan"/>`)
+		assert.Contains(t, baseCfg, `<authentication mechanisms="BEARER_TOKEN"/>`)
+
+		// The operator's admin endpoint must continue to use the admin realm
+		assert.Contains(t, adminCfg, `<authentication mechanisms="BASIC DIGEST"/>`)
+		assert.Contains(t, adminCfg, `<user-properties path="cli-admin-users.properties"`)
+		assert.NotContains(t, adminCfg, "token-realm")
+	}
+}
+
+func TestGeneratePreviousCredentials(t *testing.T) {
+	spec := Spec{
+		Infinispan:               Infinispan{Authorization: &Authorization{}},
+		Endpoints:                Endpoints{Authenticate: true, ClientCert: "None"},
+		PreviousAdminCredentials: true,
+		PreviousUserCredentials:  true,
+	}
+
+	for _, major := range []uint64{14, 15} {
+		vers := semver.Version{Major: major}
+		ope := version.Operand{UpstreamVersion: &vers}
+		baseCfg, adminCfg, err := Generate(ope, &spec)
+		assert.Nil(t, err)
+		assert.Contains(t, baseCfg, `<user-properties path="cli-users.properties" relative-to="infinispan.server.config.path"/>`)
+		assert.Contains(t, baseCfg, `<user-properties path="cli-users-previous.properties" relative-to="infinispan.server.config.path"/>`)
+		assert.Contains(t, baseCfg, "<distributed-realm/>")
+		assert.Contains(t, adminCfg, `<user-properties path="cli-admin-users-previous.properties" relative-to="infinispan.server.config.path"/>`)
+		assert.Contains(t, adminCfg, "<distributed-realm/>")
+
+		zeroCfg, err := GenerateZeroCapacity(ope, &spec)
+		assert.Nil(t, err)
+		assert.Contains(t, zeroCfg, `<user-properties path="cli-admin-users-previous.properties" relative-to="infinispan.server.config.path"/>`)
+	}
+
+	// The previous user credentials remain valid alongside the LDAP users
+	spec.LDAP = &LDAPRealm{URL: "ldap://ldap:389"}
+	vers := semver.Version{Major: 15}
+	baseCfg, _, err := Generate(version.Operand{UpstreamVersion: &vers}, &spec)
+	assert.Nil(t, err)
+	assert.Contains(t, baseCfg, "cli-users-previous.properties")
+	assert.Equal(t, 1, strings.Count(baseCfg, "<distributed-realm/>"))
+}
+
 func readFile(name string) (content string) {
 	data, err := os.ReadFile(name)
 	if err != nil {
@@ -0,0 +1,14 @@
+package security
+
+import "fmt"
+
+// AddCredentialCli returns the CLI command that adds the credential to the credential store at path. The alias and
+// credential are quoted, as they are provided by an external secret store
+func AddCredentialCli(alias, credential, path string) string {
+	return fmt.Sprintf("credentials add %s -c %s -p secret --path=%s\n", cliQuote(alias), cliQuote(credential), cliQuote(path))
+}
+
+// RemoveCredentialCli returns the CLI command that removes the alias from the credential store at path
+func RemoveCredentialCli(alias, path string) string {
+	return fmt.Sprintf("credentials remove %s -p secret --path=%s\n", cliQuote(alias), cliQuote(path))
+}
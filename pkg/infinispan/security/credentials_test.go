@@ -0,0 +1,17 @@
+package security
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddCredentialCli(t *testing.T) {
+	cmd := AddCredentialCli("db-password", `pa"ss word`, "/opt/infinispan/server/conf/credentials.pfx")
+	assert.Equal(t, `credentials add "db-password" -c "pa\"ss word" -p secret --path="/opt/infinispan/server/conf/credentials.pfx"`+"\n", cmd)
+}
+
+func TestRemoveCredentialCli(t *testing.T) {
+	cmd := RemoveCredentialCli("db password", "/opt/infinispan/server/conf/credentials.pfx")
+	assert.Equal(t, `credentials remove "db password" -p secret --path="/opt/infinispan/server/conf/credentials.pfx"`+"\n", cmd)
+}
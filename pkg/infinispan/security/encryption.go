@@ -1,16 +1,43 @@
 package security
 
 import (
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"time"
 
 	certUtil "k8s.io/client-go/util/cert"
 
 	p12 "software.sslmate.com/src/go-pkcs12"
 )
 
-func GenerateTruststore(pemFiles [][]byte, password string) ([]byte, error) {
+// GenerateTruststore creates a PKCS#12 truststore containing the certificates of the provided pem files. Certificates
+// of a previous truststore that have not expired are retained, so that both the old and the new CA are trusted whilst
+// updated certificates are rotated.
+func GenerateTruststore(pemFiles [][]byte, password string, previous []*x509.Certificate) ([]byte, error) {
+	certs, err := PemCertificates(pemFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, prev := range previous {
+		if now.After(prev.NotAfter) || containsCertificate(certs, prev) {
+			continue
+		}
+		certs = append(certs, prev)
+	}
+
+	truststore, err := p12.Modern2023.EncodeTrustStore(certs, password)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create truststore with user provided cert files: %w", err)
+	}
+	return truststore, nil
+}
+
+// PemCertificates parses all of the certificates contained in the provided pem files
+func PemCertificates(pemFiles [][]byte) ([]*x509.Certificate, error) {
 	var certs []*x509.Certificate
 	for _, pemFile := range pemFiles {
 		pemRaw := pemFile
@@ -32,9 +59,31 @@ func GenerateTruststore(pemFiles [][]byte, password string) ([]byte, error) {
 			pemRaw = rest
 		}
 	}
-	truststore, err := p12.Modern2023.EncodeTrustStore(certs, password)
+	return certs, nil
+}
+
+// KeystoreCertificate returns the certificate contained in the keystore. The keystore is either a PEM file containing
+// the private key and certificate chain, or a PKCS#12 store.
+func KeystoreCertificate(keystorePem, keystore []byte, password string) (*x509.Certificate, error) {
+	if keystorePem != nil {
+		cert, err := tls.X509KeyPair(keystorePem, keystorePem)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load keystore pem file: %w", err)
+		}
+		return x509.ParseCertificate(cert.Certificate[0])
+	}
+	_, cert, _, err := p12.DecodeChain(keystore, password)
 	if err != nil {
-		return nil, fmt.Errorf("Unable to create truststore with user provided cert files: %w", err)
+		return nil, fmt.Errorf("Unable to decode keystore: %w", err)
 	}
-	return truststore, nil
+	return cert, nil
+}
+
+func containsCertificate(certs []*x509.Certificate, cert *x509.Certificate) bool {
+	for _, c := range certs {
+		if c.Equal(cert) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,68 @@
+package security
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	p12 "software.sslmate.com/src/go-pkcs12"
+)
+
+// TestGenerateTruststoreRetainsPrevious tests that the non-expired certificates of a previous truststore are retained.
+func TestGenerateTruststoreRetainsPrevious(t *testing.T) {
+	oldCA := selfSignedCert(t, "old-ca", time.Now().Add(time.Hour))
+	expiredCA := selfSignedCert(t, "expired-ca", time.Now().Add(-time.Hour))
+	newCA := selfSignedCert(t, "new-ca", time.Now().Add(time.Hour))
+
+	previous := []*x509.Certificate{oldCA, expiredCA, newCA}
+	truststore, err := GenerateTruststore([][]byte{certPem(newCA)}, "password", previous)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	certs, err := p12.DecodeTrustStore(truststore, "password")
+	if err != nil {
+		t.Fatalf("unable to decode truststore: %v", err)
+	}
+	var names []string
+	for _, cert := range certs {
+		names = append(names, cert.Subject.CommonName)
+	}
+	if len(names) != 2 || names[0] != "new-ca" || names[1] != "old-ca" {
+		t.Errorf("expected truststore to contain [new-ca old-ca], got %v", names)
+	}
+}
+
+func selfSignedCert(t *testing.T, name string, notAfter time.Time) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             notAfter.Add(-24 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func certPem(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
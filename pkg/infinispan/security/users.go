@@ -96,6 +96,28 @@ func GetUserCredentials() ([]byte, error) {
 	return CreateIdentitiesFor(consts.DefaultDeveloperUser, pass)
 }
 
+// GeneratePassword generates a random password
+func GeneratePassword() (string, error) {
+	return getRandomStringForAuth(16)
+}
+
+// RotatePasswords generates a new random password for each of the credentials in the provided identities yaml
+func RotatePasswords(descriptor []byte) ([]byte, error) {
+	var identities Identities
+	if err := yaml.Unmarshal(descriptor, &identities); err != nil {
+		return nil, err
+	}
+
+	for i := range identities.Credentials {
+		pass, err := getRandomStringForAuth(16)
+		if err != nil {
+			return nil, err
+		}
+		identities.Credentials[i].Password = pass
+	}
+	return yaml.Marshal(identities)
+}
+
 // FindPassword finds a user's password
 func FindPassword(usr string, descriptor []byte) (string, error) {
 	var identities Identities
@@ -151,3 +173,38 @@ func IdentitiesCliFileFromSecret(buf []byte, realm, usersFile, groupsFile string
 	}
 	return b.String(), nil
 }
+
+// ReplaceIdentitiesCliFromSecret returns the CLI commands that remove and re-create the identities in the properties
+// files of the realm, so that the passwords and groups of the identities can be updated on a running server
+func ReplaceIdentitiesCliFromSecret(buf []byte, realm, usersFile, groupsFile string) (string, error) {
+	var creds IdentitiesYaml
+	if err := yaml.Unmarshal(buf, &creds); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, cred := range creds.Credentials {
+		b.WriteString(RemoveUserCli(cred.Username, realm, usersFile, groupsFile))
+		b.WriteString(CreateUserCli(cred.Username, cred.Password, cred.Roles, realm, usersFile, groupsFile))
+	}
+	return b.String(), nil
+}
+
+// CreateUserCli returns the CLI command that adds the user to the properties files of the realm. The username, password
+// and groups are quoted, as they are provided by the User CRs
+func CreateUserCli(username, password string, groups []string, realm, usersFile, groupsFile string) string {
+	cmd := fmt.Sprintf("user create %s --realm %s -p %s --users-file %s --groups-file %s", cliQuote(username), realm, cliQuote(password), usersFile, groupsFile)
+	if len(groups) > 0 {
+		cmd += fmt.Sprintf(" --groups %s", cliQuote(strings.Join(groups, ",")))
+	}
+	return cmd + "\n"
+}
+
+// RemoveUserCli returns the CLI command that removes the user from the properties files of the realm
+func RemoveUserCli(username, realm, usersFile, groupsFile string) string {
+	return fmt.Sprintf("user remove %s --realm %s --users-file %s --groups-file %s\n", cliQuote(username), realm, usersFile, groupsFile)
+}
+
+// cliQuote returns the value as a double-quoted CLI argument
+func cliQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
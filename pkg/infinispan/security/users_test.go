@@ -0,0 +1,53 @@
+package security
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+// TestRotatePasswords tests that the password of every user is replaced, whilst the usernames and roles are retained.
+func TestRotatePasswords(t *testing.T) {
+	identities := Identities{
+		Credentials: []Credentials{
+			{Username: "developer", Password: "password1", Roles: []string{"admin"}},
+			{Username: "monitor", Password: "password2", Roles: []string{"monitor"}},
+		},
+	}
+	descriptor, err := yaml.Marshal(identities)
+	require.NoError(t, err)
+
+	rotated, err := RotatePasswords(descriptor)
+	require.NoError(t, err)
+
+	var result Identities
+	require.NoError(t, yaml.Unmarshal(rotated, &result))
+	require.Len(t, result.Credentials, len(identities.Credentials))
+	for i, cred := range result.Credentials {
+		prev := identities.Credentials[i]
+		assert.Equal(t, prev.Username, cred.Username)
+		assert.Equal(t, prev.Roles, cred.Roles)
+		assert.NotEmpty(t, cred.Password)
+		assert.NotEqual(t, prev.Password, cred.Password, "expected password of user '%s' to be rotated", cred.Username)
+	}
+}
+
+func TestCreateUserCli(t *testing.T) {
+	cmd := CreateUserCli("developer", `pa"ss\word`, []string{"admin", "reader"}, "default", "cli-users.properties", "cli-groups.properties")
+	assert.Equal(t, `user create "developer" --realm default -p "pa\"ss\\word" --users-file cli-users.properties --groups-file cli-groups.properties --groups "admin,reader"`+"\n", cmd)
+
+	cmd = RemoveUserCli("developer", "default", "cli-users.properties", "cli-groups.properties")
+	assert.Equal(t, `user remove "developer" --realm default --users-file cli-users.properties --groups-file cli-groups.properties`+"\n", cmd)
+}
+
+func TestReplaceIdentitiesCliFromSecret(t *testing.T) {
+	descriptor, err := CreateIdentitiesFor("operator", "password")
+	require.NoError(t, err)
+
+	batch, err := ReplaceIdentitiesCliFromSecret(descriptor, "admin", "cli-admin-users-previous.properties", "cli-admin-groups-previous.properties")
+	require.NoError(t, err)
+	assert.Equal(t, RemoveUserCli("operator", "admin", "cli-admin-users-previous.properties", "cli-admin-groups-previous.properties")+
+		CreateUserCli("operator", "password", []string{"admin", "controlRole"}, "admin", "cli-admin-users-previous.properties", "cli-admin-groups-previous.properties"), batch)
+}
@@ -2,6 +2,8 @@ package upgrades
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -10,11 +12,13 @@ import (
 	"github.com/infinispan/infinispan-operator/pkg/mime"
 )
 
+var syncCountRegexp = regexp.MustCompile(`\d+`)
+
 // ConnectCaches Connects caches from a cluster (target) to another (source) via Remote Stores. Caches are created in the target cluster if needed.
 func ConnectCaches(user, adminPasswordSource, sourceIp string, sourceClient, targetClient api.Infinispan, logger logr.Logger) error {
 
 	// Obtain all cache names from the source cluster
-	names, err := cacheNames(sourceClient)
+	names, err := CacheNames(sourceClient)
 	if err != nil {
 		return fmt.Errorf("failed to get cache names from the source cluster: %w", err)
 	}
@@ -66,32 +70,48 @@ func ConnectCaches(user, adminPasswordSource, sourceIp string, sourceClient, tar
 	return nil
 }
 
-// SyncCaches Do a sync data operation for each cache in a cluster
-func SyncCaches(sourceClient, targetClient api.Infinispan, logger logr.Logger) error {
-	names, err := cacheNames(sourceClient)
+// SyncCache copies all entries of a cache from the source cluster to the target cluster and then disconnects the
+// source cluster, returning the number of entries migrated
+func SyncCache(targetClient api.Infinispan, cacheName string, logger logr.Logger) (int64, error) {
+	cache := targetClient.Cache(cacheName)
+	upgrade := cache.RollingUpgrade()
+	connected, err := upgrade.SourceConnected()
 	if err != nil {
-		return fmt.Errorf("failed to get cache names from the target cluster: %w", err)
+		return 0, fmt.Errorf("failed to call source-connected from target cluster for cache '%s': %w", cacheName, err)
 	}
-	logger.Info(fmt.Sprintf("Cache names in the target cluster '%s'", names))
 
-	for _, cacheName := range names {
-		upgrade := targetClient.Cache(cacheName).RollingUpgrade()
-		count, err := upgrade.SyncData()
+	var migrated int64
+	if connected {
+		result, err := upgrade.SyncData()
 		if err != nil {
-			return fmt.Errorf("failed to sync data for cache'%s': %w", cacheName, err)
+			return 0, fmt.Errorf("failed to sync data for cache '%s': %w", cacheName, err)
 		}
-		logger.Info(fmt.Sprintf("Sync result for '%s' %s:'", cacheName, count))
+		logger.Info(fmt.Sprintf("Sync result for '%s' %s:'", cacheName, result))
 
 		if err = upgrade.DisconnectSource(); err != nil {
-			return fmt.Errorf("failed to disconnect source for cache'%s': %w", cacheName, err)
+			return 0, fmt.Errorf("failed to disconnect source for cache '%s': %w", cacheName, err)
 		}
 		logger.Info("Disconnected source")
+
+		if count := syncCountRegexp.FindString(result); count != "" {
+			migrated, _ = strconv.ParseInt(count, 10, 64)
+			return migrated, nil
+		}
+	} else {
+		// The source was disconnected by a previous attempt, so the number of migrated entries is no longer available
+		logger.Info(fmt.Sprintf("Cache '%s' already disconnected from source cluster", cacheName))
 	}
-	return nil
+
+	size, err := cache.Size()
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve size of cache '%s': %w", cacheName, err)
+	}
+	return int64(size), nil
 }
 
-// We must ensure that internal Infinispan caches are processed before user caches
-func cacheNames(targetClient api.Infinispan) ([]string, error) {
+// CacheNames returns the names of all caches in a cluster. We must ensure that internal Infinispan caches are processed
+// before user caches
+func CacheNames(targetClient api.Infinispan) ([]string, error) {
 	names, err := targetClient.Caches().Names()
 	if err != nil {
 		return nil, err
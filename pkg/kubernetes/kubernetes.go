@@ -112,6 +112,8 @@ type ExecOptions struct {
 	Command   []string
 	Namespace string
 	PodName   string
+	// Stdin is streamed to the command if not nil, so that sensitive values are not passed as arguments
+	Stdin io.Reader
 }
 
 type execError struct {
@@ -123,6 +125,10 @@ func (e *execError) Error() string {
 	return fmt.Sprintf("stderr: %s, err: %s", e.stdErr, e.err.Error())
 }
 
+func (e *execError) Unwrap() error {
+	return e.err
+}
+
 // ExecWithOptions executes command on pod
 // command example { "/usr/bin/ls", "folderName" }
 func (k Kubernetes) ExecWithOptions(options ExecOptions) (bytes.Buffer, error) {
@@ -135,7 +141,7 @@ func (k Kubernetes) ExecWithOptions(options ExecOptions) (bytes.Buffer, error) {
 		VersionedParams(&corev1.PodExecOptions{
 			Container: options.Container,
 			Command:   options.Command,
-			Stdin:     false,
+			Stdin:     options.Stdin != nil,
 			Stdout:    true,
 			Stderr:    true,
 			TTY:       false,
@@ -148,7 +154,7 @@ func (k Kubernetes) ExecWithOptions(options ExecOptions) (bytes.Buffer, error) {
 	}
 	// Run the command
 	err = exec.Stream(remotecommand.StreamOptions{
-		Stdin:  nil,
+		Stdin:  options.Stdin,
 		Stdout: &execOut,
 		Stderr: &execErr,
 		Tty:    false,
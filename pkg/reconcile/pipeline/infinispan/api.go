@@ -226,13 +226,27 @@ type ConfigFiles struct {
 	Log4j                  string
 	UserIdentities         []byte
 	AdminIdentities        *AdminIdentities
+	CredentialRotation     *CredentialRotation
 	CredentialStoreEntries map[string][]byte
-	IdentitiesBatch        string
-	UserConfig             UserConfig
-	Keystore               *Keystore
-	Truststore             *Truststore
-	Transport              Transport
-	XSite                  *XSite
+	// ExternalCredentialStoreEntries are retrieved from an external secret store and added to the credential store of
+	// running pods, so that they are never persisted in a Secret
+	ExternalCredentialStoreEntries map[string][]byte
+	// ExternalCredentialStoreVersion identifies the version of the ExternalCredentialStoreEntries in the external secret store
+	ExternalCredentialStoreVersion string
+	IdentitiesBatch                string
+	// CacheStoreCredentials the passwords of the Cache CR stores keyed by their alias. They are added to the credential
+	// store of running pods, and to the identities batch for restarted pods, as updating the CredentialStoreEntries
+	// restarts the cluster
+	CacheStoreCredentials map[string][]byte
+	// Users the identities of the User CRs of the cluster, which are added to the default realm of every server
+	Users      []User
+	UserConfig UserConfig
+	Keystore   *Keystore
+	Truststore *Truststore
+	// LDAPTruststore the PEM encoded CA certificates trusted by LDAPS connections
+	LDAPTruststore []byte
+	Transport      Transport
+	XSite          *XSite
 }
 
 type UserConfig struct {
@@ -241,6 +255,17 @@ type UserConfig struct {
 	Log4j                string
 }
 
+// User an identity defined by a User CR
+type User struct {
+	Name     string
+	Password string
+	Groups   []string
+	// PasswordSecretVersion the resource version of the secret containing the Password
+	PasswordSecretVersion string
+	// Removed is true if the User CR has been deleted, so that the user must be removed from the servers
+	Removed bool
+}
+
 type AdminIdentities struct {
 	Username       string
 	Password       string
@@ -248,6 +273,32 @@ type AdminIdentities struct {
 	CliProperties  string
 }
 
+// CredentialRotation holds the identities whose passwords remain valid during a credential rotation
+type CredentialRotation struct {
+	PreviousAdminIdentities []byte
+	// PreviousAdminPassword is only set until the rotated admin password has been applied to every pod
+	PreviousAdminPassword  string
+	PreviousUserIdentities []byte
+}
+
+// PreviousAdminIdentities returns the admin identities of the realm containing the previous passwords, which are the
+// current identities unless a credential rotation is in progress
+func (c *ConfigFiles) PreviousAdminIdentities() []byte {
+	if c.CredentialRotation != nil && c.CredentialRotation.PreviousAdminIdentities != nil {
+		return c.CredentialRotation.PreviousAdminIdentities
+	}
+	return c.AdminIdentities.IdentitiesFile
+}
+
+// PreviousUserIdentities returns the user identities of the realm containing the previous passwords, which are the
+// current identities unless a credential rotation is in progress
+func (c *ConfigFiles) PreviousUserIdentities() []byte {
+	if c.CredentialRotation != nil && c.CredentialRotation.PreviousUserIdentities != nil {
+		return c.CredentialRotation.PreviousUserIdentities
+	}
+	return c.UserIdentities
+}
+
 type Keystore struct {
 	Alias    string
 	File     []byte
@@ -262,11 +313,15 @@ type Truststore struct {
 	Password string
 	Path     string
 	Type     string
+	// SourceHash the hash of the certificates that the truststore was generated from, empty if the truststore was provided by the user
+	SourceHash string
 }
 
 type Transport struct {
 	Keystore   *Keystore
 	Truststore *Truststore
+	// EncryptionKeystore the keystore containing the secret key used by SYM_ENCRYPT
+	EncryptionKeystore *Keystore
 }
 
 type XSite struct {
@@ -296,4 +351,5 @@ var (
 	RouteGVK          = routev1.SchemeGroupVersion.WithKind("Route")
 	IngressGVK        = ingressv1.SchemeGroupVersion.WithKind("Ingress")
 	ServiceMonitorGVK = monitoringv1.SchemeGroupVersion.WithKind("ServiceMonitor")
+	CertificateGVK    = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
 )
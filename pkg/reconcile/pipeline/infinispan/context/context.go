@@ -7,7 +7,9 @@ import (
 	"github.com/go-logr/logr"
 	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
 	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	httpClient "github.com/infinispan/infinispan-operator/pkg/http"
 	"github.com/infinispan/infinispan-operator/pkg/http/curl"
+	"github.com/infinispan/infinispan-operator/pkg/http/native"
 	ispnClient "github.com/infinispan/infinispan-operator/pkg/infinispan/client"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/version"
@@ -109,13 +111,11 @@ func (c *contextImpl) InfinispanClient() (api.Infinispan, error) {
 }
 
 func (c *contextImpl) InfinispanClientForPod(podName string) api.Infinispan {
-	curlClient := c.curlClient(podName)
-	return ispnClient.New(c.Operand(), curlClient)
+	return ispnClient.New(c.Operand(), c.httpClient(podName))
 }
 
 func (c *contextImpl) InfinispanClientUnknownVersion(podName string) (api.Infinispan, error) {
-	curlClient := c.curlClient(podName)
-	return ispnClient.NewUnknownVersion(curlClient)
+	return ispnClient.NewUnknownVersion(c.httpClient(podName))
 }
 
 func (c *contextImpl) InfinispanPods() (*corev1.PodList, error) {
@@ -142,16 +142,56 @@ func (c *contextImpl) InfinispanPods() (*corev1.PodList, error) {
 	return c.ispnPods.DeepCopy(), nil
 }
 
+func (c *contextImpl) httpClient(podName string) httpClient.HttpClient {
+	if c.infinispan.IsNativeHttpTransport() {
+		return c.nativeClient(podName)
+	}
+	return c.curlClient(podName)
+}
+
+// adminPassword returns the password used by the operator to authenticate with the server. The previous password is
+// used until the rotated password of a credential rotation has been applied to every pod, as it remains valid on all
+// pods irrespective of whether they have been updated
+func (c *contextImpl) adminPassword() string {
+	if rotation := c.ispnConfig.CredentialRotation; rotation != nil && rotation.PreviousAdminPassword != "" {
+		return rotation.PreviousAdminPassword
+	}
+	return c.ispnConfig.AdminIdentities.Password
+}
+
+func (c *contextImpl) nativeClient(podName string) *native.Client {
+	config := native.Config{
+		Credentials: &native.Credentials{
+			Username: c.ispnConfig.AdminIdentities.Username,
+			Password: c.adminPassword(),
+		},
+		Podname:   podName,
+		Namespace: c.infinispan.Namespace,
+		Protocol:  consts.InfinispanAdminProtocol,
+		Port:      consts.InfinispanAdminPort,
+	}
+	// Reuse the pod IP of the cached pod list, so that the pod is not retrieved again by the client
+	if c.ispnPods != nil {
+		for _, pod := range c.ispnPods.Items {
+			if pod.Name == podName {
+				config.PodIP = pod.Status.PodIP
+				break
+			}
+		}
+	}
+	return native.New(config, c.kubernetes)
+}
+
 func (c *contextImpl) curlClient(podName string) *curl.Client {
 	return curl.New(curl.Config{
 		Credentials: &curl.Credentials{
 			Username: c.ispnConfig.AdminIdentities.Username,
-			Password: c.ispnConfig.AdminIdentities.Password,
+			Password: c.adminPassword(),
 		},
 		Container: provision.InfinispanContainer,
 		Podname:   podName,
 		Namespace: c.infinispan.Namespace,
-		Protocol:  "http",
+		Protocol:  consts.InfinispanAdminProtocol,
 		Port:      consts.InfinispanAdminPort,
 	}, c.kubernetes)
 }
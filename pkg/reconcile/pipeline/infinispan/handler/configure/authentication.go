@@ -1,15 +1,21 @@
 package configure
 
 import (
+	"bytes"
 	"fmt"
 	"net/url"
+	"sort"
+	"time"
 
 	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
 	consts "github.com/infinispan/infinispan-operator/controllers/constants"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/security"
 	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 func UserAuthenticationSecret(i *ispnv1.Infinispan, ctx pipeline.Context) {
@@ -44,6 +50,165 @@ func AdminSecret(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	}
 }
 
+// CredentialRotation generates new passwords for the operator and generated application users when a rotation is due.
+// The previous identities are stored in the security Secret, and the start of the rotation is recorded in the status,
+// before the rotated passwords are provisioned, so that a requeued reconciliation resumes the rotation instead of
+// rotating the passwords again. Until the previous passwords are revoked, the previous identities are loaded so that
+// they remain valid on the server
+func CredentialRotation(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	configFiles := ctx.ConfigFiles()
+	if configFiles.AdminIdentities == nil {
+		// The credentials have not been generated yet
+		return
+	}
+
+	// The updated Secrets are not provisioned whilst an upgrade is in progress
+	upgrading := i.IsUpgradeCondition() || i.IsHotRodUpgrade()
+
+	var rotation *pipeline.CredentialRotation
+	if i.IsCredentialRotationInProgress() {
+		if !i.IsCredentialRotationStarted() && !time.Now().Before(i.Status.CredentialRotation.GracePeriodEndTime.Time) {
+			// The grace period has ended, so the previous passwords are replaced with the current passwords
+			return
+		}
+		if rotation = loadCredentialRotation(i, ctx); rotation == nil {
+			return
+		}
+	} else {
+		if upgrading {
+			return
+		}
+		if !i.IsCredentialRotationRequested() {
+			if next := i.GetNextCredentialRotationTime(); next == nil || time.Now().Before(*next) {
+				return
+			}
+		}
+		if rotation = startCredentialRotation(i, ctx); rotation == nil {
+			return
+		}
+	}
+	configFiles.CredentialRotation = rotation
+
+	if !i.IsCredentialRotationStarted() || upgrading {
+		// The rotated passwords have already been applied to every pod, or cannot be provisioned yet
+		return
+	}
+
+	user := i.GetOperatorUser()
+	password, err := security.FindPassword(user, rotation.PreviousAdminIdentities)
+	if err != nil {
+		ctx.Requeue(fmt.Errorf("unable to read previous admin credentials: %w", err))
+		return
+	}
+	// The operator authenticates with the previous password until the rotated password has been applied to every pod
+	rotation.PreviousAdminPassword = password
+
+	// Only generate the passwords that have not been provisioned by a previous reconciliation of the rotation
+	adminIdentities := configFiles.AdminIdentities
+	if adminIdentities.Password == "" || adminIdentities.Password == password {
+		newPassword, err := security.GeneratePassword()
+		if err != nil {
+			ctx.Requeue(err)
+			return
+		}
+		// The AdminIdentities handler regenerates the identities file with the new password
+		adminIdentities.Password = newPassword
+	}
+
+	// Only the passwords of the application users in a generated Secret are rotated
+	if rotation.PreviousUserIdentities != nil && bytes.Equal(configFiles.UserIdentities, rotation.PreviousUserIdentities) {
+		userIdentities, err := security.RotatePasswords(configFiles.UserIdentities)
+		if err != nil {
+			ctx.Requeue(fmt.Errorf("unable to rotate user credentials: %w", err))
+			return
+		}
+		configFiles.UserIdentities = userIdentities
+	}
+}
+
+// startCredentialRotation stores the current identities as the previous identities of a rotation in the security
+// Secret, and then records the start of the rotation, so that the previous passwords are never lost
+func startCredentialRotation(i *ispnv1.Infinispan, ctx pipeline.Context) *pipeline.CredentialRotation {
+	configFiles := ctx.ConfigFiles()
+	user := i.GetOperatorUser()
+	password := configFiles.AdminIdentities.Password
+	if password == "" {
+		var err error
+		if password, err = security.FindPassword(user, configFiles.AdminIdentities.IdentitiesFile); err != nil {
+			ctx.Requeue(err)
+			return nil
+		}
+	}
+	previousAdminIdentities, err := security.CreateIdentitiesFor(user, password)
+	if err != nil {
+		ctx.Requeue(err)
+		return nil
+	}
+
+	rotation := &pipeline.CredentialRotation{
+		PreviousAdminIdentities: previousAdminIdentities,
+	}
+	if i.IsAuthenticationEnabled() && i.IsGeneratedSecret() && configFiles.UserIdentities != nil {
+		rotation.PreviousUserIdentities = configFiles.UserIdentities
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      i.GetInfinispanSecuritySecretName(),
+			Namespace: i.Namespace,
+		},
+	}
+	mutateFn := func() error {
+		if secret.CreationTimestamp.IsZero() {
+			return errors.NewNotFound(corev1.Resource("secret"), secret.Name)
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[consts.ServerPreviousAdminIdentitiesFilename] = rotation.PreviousAdminIdentities
+		if rotation.PreviousUserIdentities != nil {
+			secret.Data[consts.ServerPreviousIdentitiesFilename] = rotation.PreviousUserIdentities
+		} else {
+			delete(secret.Data, consts.ServerPreviousIdentitiesFilename)
+		}
+		return nil
+	}
+	if _, err := ctx.Resources().CreateOrPatch(secret, false, mutateFn, pipeline.RetryOnErr); err != nil {
+		return nil
+	}
+
+	now := metav1.Now()
+	if err := ctx.UpdateInfinispan(func() {
+		delete(i.Annotations, consts.CredentialRotationAnnotation)
+		if i.Status.CredentialRotation == nil {
+			i.Status.CredentialRotation = &ispnv1.CredentialRotationStatus{}
+		}
+		i.Status.CredentialRotation.RotationStartTime = &now
+	}); err != nil {
+		return nil
+	}
+	ctx.Log().Info("Rotating generated credentials")
+	return rotation
+}
+
+// loadCredentialRotation loads the previous identities of the rotation in progress from the security Secret
+func loadCredentialRotation(i *ispnv1.Infinispan, ctx pipeline.Context) *pipeline.CredentialRotation {
+	secret := &corev1.Secret{}
+	if err := ctx.Resources().Load(i.GetInfinispanSecuritySecretName(), secret, pipeline.RetryOnErr); err != nil {
+		return nil
+	}
+
+	previousAdminIdentities, ok := secret.Data[consts.ServerPreviousAdminIdentitiesFilename]
+	if !ok {
+		ctx.Requeue(fmt.Errorf("security secret '%s' missing the previous admin identities of the credential rotation", secret.Name))
+		return nil
+	}
+	return &pipeline.CredentialRotation{
+		PreviousAdminIdentities: previousAdminIdentities,
+		PreviousUserIdentities:  secret.Data[consts.ServerPreviousIdentitiesFilename],
+	}
+}
+
 func AdminIdentities(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	configFiles := ctx.ConfigFiles()
 
@@ -105,6 +270,54 @@ func UserIdentities(_ *ispnv1.Infinispan, ctx pipeline.Context) {
 	}
 }
 
+// UserResources loads the identities of the User CRs of the cluster. User CRs whose password secret is not available are
+// ignored, as the User controller reports them as not Ready. The users of deleted User CRs are retained until the User
+// finalizer is removed, once they have been removed from every server
+func UserResources(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	userList := &v2alpha1.UserList{}
+	if err := ctx.Kubernetes().ResourcesListByField(i.Namespace, "spec.clusterName", i.Name, userList, ctx.Ctx()); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to list User CRs: %w", err))
+		return
+	}
+
+	configFiles := ctx.ConfigFiles()
+	configFiles.Users = nil
+	for _, user := range userList.Items {
+		if !user.GetDeletionTimestamp().IsZero() {
+			if controllerutil.ContainsFinalizer(&user, consts.InfinispanFinalizer) {
+				configFiles.Users = append(configFiles.Users, pipeline.User{Name: user.GetUsername(), Removed: true})
+			}
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := ctx.Resources().Load(user.Spec.PasswordSecretName, secret, pipeline.SkipEventRec); err != nil {
+			if !errors.IsNotFound(err) {
+				ctx.Requeue(fmt.Errorf("unable to load password secret of User '%s': %w", user.Name, err))
+				return
+			}
+			continue
+		}
+		password := secret.Data[consts.UserPasswordKey]
+		if len(password) == 0 {
+			continue
+		}
+
+		groups := append([]string(nil), user.Spec.Groups...)
+		sort.Strings(groups)
+		configFiles.Users = append(configFiles.Users, pipeline.User{
+			Name:                  user.GetUsername(),
+			Password:              string(password),
+			Groups:                groups,
+			PasswordSecretVersion: secret.ResourceVersion,
+		})
+	}
+	// Removed users must be processed first, so that a user can be recreated by another User CR
+	sort.SliceStable(configFiles.Users, func(a, b int) bool {
+		return configFiles.Users[a].Removed && !configFiles.Users[b].Removed
+	})
+}
+
 func IdentitiesBatch(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	configFiles := ctx.ConfigFiles()
 
@@ -123,6 +336,34 @@ func IdentitiesBatch(i *ispnv1.Infinispan, ctx pipeline.Context) {
 			return
 		}
 		batch += usersCliBatch
+
+		// The users of User CRs are defined on startup, so that they are retained when a server restarts
+		for _, user := range configFiles.Users {
+			if !user.Removed {
+				batch += security.CreateUserCli(user.Name, user.Password, user.Groups, "default", "cli-users.properties", "cli-groups.properties")
+			}
+		}
+	}
+
+	// Define the previous identities of a credential rotation in separate properties files, so that the replaced
+	// passwords remain valid until the grace period of the rotation ends. The current identities are defined when a
+	// rotation is not in progress, so that the realms can be defined irrespective of whether a rotation is in progress
+	if i.IsCredentialRotationEnabled() {
+		previousCliBatch, err := security.IdentitiesCliFileFromSecret(configFiles.PreviousAdminIdentities(), "admin", "cli-admin-users-previous.properties", "cli-admin-groups-previous.properties")
+		if err != nil {
+			ctx.Requeue(fmt.Errorf("unable to read previous admin credentials: %w", err))
+			return
+		}
+		batch += previousCliBatch
+
+		if i.IsAuthenticationEnabled() && i.IsGeneratedSecret() {
+			previousCliBatch, err = security.IdentitiesCliFileFromSecret(configFiles.PreviousUserIdentities(), "default", "cli-users-previous.properties", "cli-groups-previous.properties")
+			if err != nil {
+				ctx.Requeue(fmt.Errorf("unable to read previous user credentials: %w", err))
+				return
+			}
+			batch += previousCliBatch
+		}
 	}
 
 	if i.IsEncryptionEnabled() {
@@ -139,11 +380,12 @@ func IdentitiesBatch(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		}
 	}
 
-	// Add user provided credentials to credential-store
-	if i.IsCredentialStoreSecretDefined() {
-		for alias, cred := range ctx.ConfigFiles().CredentialStoreEntries {
-			batch += fmt.Sprintf("credentials add \"%s\" -c \"%s\" -p \"secret\"\n", alias, cred)
-		}
+	// Add user provided credentials and Cache CR store credentials to credential-store
+	for alias, cred := range ctx.ConfigFiles().CredentialStoreEntries {
+		batch += fmt.Sprintf("credentials add \"%s\" -c \"%s\" -p \"secret\"\n", alias, cred)
+	}
+	for alias, cred := range ctx.ConfigFiles().CacheStoreCredentials {
+		batch += fmt.Sprintf("credentials add \"%s\" -c \"%s\" -p \"secret\"\n", alias, cred)
 	}
 
 	configFiles.IdentitiesBatch = batch
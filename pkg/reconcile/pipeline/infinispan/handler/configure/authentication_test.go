@@ -0,0 +1,167 @@
+package configure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/security"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const previousPassword = "previousPassword"
+
+func newRotationInfinispan() *ispnv1.Infinispan {
+	i := &ispnv1.Infinispan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "example",
+			Namespace:   "default",
+			Annotations: map[string]string{consts.CredentialRotationAnnotation: ""},
+		},
+	}
+	i.Spec.Security.EndpointSecretName = i.GenerateSecretName()
+	return i
+}
+
+func newRotationConfigFiles(t *testing.T) *pipeline.ConfigFiles {
+	adminIdentities, err := security.CreateIdentitiesFor("operator", previousPassword)
+	require.NoError(t, err)
+	userIdentities, err := security.CreateIdentitiesFor("developer", previousPassword)
+	require.NoError(t, err)
+	return &pipeline.ConfigFiles{
+		AdminIdentities: &pipeline.AdminIdentities{
+			Username:       "operator",
+			Password:       previousPassword,
+			IdentitiesFile: adminIdentities,
+		},
+		UserIdentities: userIdentities,
+	}
+}
+
+func newRotationContext(ctrl *gomock.Controller, configFiles *pipeline.ConfigFiles) (*pipeline.MockContext, *pipeline.MockResources) {
+	resources := pipeline.NewMockResources(ctrl)
+	ctx := pipeline.NewMockContext(ctrl)
+	ctx.EXPECT().ConfigFiles().AnyTimes().Return(configFiles)
+	ctx.EXPECT().Resources().AnyTimes().Return(resources)
+	ctx.EXPECT().Log().AnyTimes().Return(logr.Discard())
+	ctx.EXPECT().UpdateInfinispan(gomock.Any()).AnyTimes().DoAndReturn(func(updateFn func()) error {
+		updateFn()
+		return nil
+	})
+	return ctx, resources
+}
+
+// TestCredentialRotationStart tests that the previous identities and the start of a rotation are persisted before the
+// passwords are rotated
+func TestCredentialRotationStart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	configFiles := newRotationConfigFiles(t)
+	previousUserIdentities := configFiles.UserIdentities
+	ctx, resources := newRotationContext(ctrl, configFiles)
+
+	securitySecret := &corev1.Secret{}
+	resources.EXPECT().CreateOrPatch(gomock.Any(), false, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(obj client.Object, _ bool, mutate func() error, _ ...func(*pipeline.ResourcesConfig)) (pipeline.OperationResult, error) {
+			secret := obj.(*corev1.Secret)
+			secret.CreationTimestamp = metav1.Now()
+			require.NoError(t, mutate())
+			securitySecret = secret
+			return pipeline.OperationResultUpdated, nil
+		})
+
+	i := newRotationInfinispan()
+	CredentialRotation(i, ctx)
+
+	assert.Equal(t, i.GetInfinispanSecuritySecretName(), securitySecret.Name)
+	assert.Equal(t, previousUserIdentities, securitySecret.Data[consts.ServerPreviousIdentitiesFilename])
+	password, err := security.FindPassword("operator", securitySecret.Data[consts.ServerPreviousAdminIdentitiesFilename])
+	require.NoError(t, err)
+	assert.Equal(t, previousPassword, password)
+
+	assert.True(t, i.IsCredentialRotationStarted())
+	assert.False(t, i.IsCredentialRotationRequested())
+
+	rotation := configFiles.CredentialRotation
+	require.NotNil(t, rotation)
+	assert.Equal(t, previousPassword, rotation.PreviousAdminPassword)
+	assert.NotEqual(t, previousPassword, configFiles.AdminIdentities.Password)
+	assert.NotEqual(t, previousUserIdentities, configFiles.UserIdentities)
+}
+
+// TestCredentialRotationResume tests that a requeued rotation retains the passwords that have already been provisioned
+func TestCredentialRotationResume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	configFiles := newRotationConfigFiles(t)
+	previousAdminIdentities := configFiles.AdminIdentities.IdentitiesFile
+	previousUserIdentities := configFiles.UserIdentities
+	ctx, resources := newRotationContext(ctrl, configFiles)
+
+	// The Secrets have been provisioned with the rotated passwords by a previous reconciliation
+	rotatedUserIdentities, err := security.RotatePasswords(previousUserIdentities)
+	require.NoError(t, err)
+	configFiles.AdminIdentities.Password = "rotatedPassword"
+	configFiles.UserIdentities = rotatedUserIdentities
+
+	resources.EXPECT().Load(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(name string, obj client.Object, _ ...func(*pipeline.ResourcesConfig)) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{
+				consts.ServerPreviousAdminIdentitiesFilename: previousAdminIdentities,
+				consts.ServerPreviousIdentitiesFilename:      previousUserIdentities,
+			}
+			return nil
+		})
+
+	i := newRotationInfinispan()
+	delete(i.Annotations, consts.CredentialRotationAnnotation)
+	i.Status.CredentialRotation = &ispnv1.CredentialRotationStatus{RotationStartTime: &metav1.Time{Time: time.Now()}}
+	CredentialRotation(i, ctx)
+
+	rotation := configFiles.CredentialRotation
+	require.NotNil(t, rotation)
+	assert.Equal(t, previousPassword, rotation.PreviousAdminPassword)
+	assert.Equal(t, previousUserIdentities, rotation.PreviousUserIdentities)
+	assert.Equal(t, "rotatedPassword", configFiles.AdminIdentities.Password)
+	assert.Equal(t, rotatedUserIdentities, configFiles.UserIdentities)
+}
+
+// TestCredentialRotationGracePeriod tests that the previous identities are only loaded until the grace period ends, and
+// that the operator authenticates with the rotated password during the grace period
+func TestCredentialRotationGracePeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	configFiles := newRotationConfigFiles(t)
+	ctx, resources := newRotationContext(ctrl, configFiles)
+
+	resources.EXPECT().Load(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(name string, obj client.Object, _ ...func(*pipeline.ResourcesConfig)) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{
+				consts.ServerPreviousAdminIdentitiesFilename: configFiles.AdminIdentities.IdentitiesFile,
+			}
+			return nil
+		})
+
+	i := newRotationInfinispan()
+	delete(i.Annotations, consts.CredentialRotationAnnotation)
+	gracePeriodEnd := metav1.NewTime(time.Now().Add(time.Hour))
+	i.Status.CredentialRotation = &ispnv1.CredentialRotationStatus{GracePeriodEndTime: &gracePeriodEnd}
+	CredentialRotation(i, ctx)
+
+	rotation := configFiles.CredentialRotation
+	require.NotNil(t, rotation)
+	assert.Empty(t, rotation.PreviousAdminPassword)
+	assert.Equal(t, previousPassword, configFiles.AdminIdentities.Password)
+
+	// The previous passwords are replaced once the grace period has ended
+	configFiles.CredentialRotation = nil
+	gracePeriodEnd = metav1.NewTime(time.Now().Add(-time.Second))
+	CredentialRotation(i, ctx)
+	assert.Nil(t, configFiles.CredentialRotation)
+	assert.Equal(t, configFiles.AdminIdentities.IdentitiesFile, configFiles.PreviousAdminIdentities())
+}
@@ -2,17 +2,182 @@ package configure
 
 import (
 	"fmt"
+	"os"
 
 	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
 	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	"github.com/infinispan/infinispan-operator/pkg/vault"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 )
 
-func CredentialStoreSecret(i *ispnv1.Infinispan, ctx pipeline.Context) {
+// CredentialSource provides the alias and password combinations that are added to the server credential store
+type CredentialSource interface {
+	// Entries returns the current credential store entries keyed by alias, and an identifier of their version
+	Entries() (map[string][]byte, string, error)
+}
+
+// secretCredentialSource provides the entries of the Secret referenced by spec.security.credentialStoreSecretName
+type secretCredentialSource struct {
+	ctx  pipeline.Context
+	name string
+}
+
+func (s *secretCredentialSource) Entries() (map[string][]byte, string, error) {
 	secret := &corev1.Secret{}
-	if err := ctx.Resources().Load(i.GetCredentialStoreSecretName(), secret); err != nil {
-		ctx.Requeue(fmt.Errorf("unable to load CredentialStore secret %s: %w", i.GetCredentialStoreSecretName(), err))
+	if err := s.ctx.Resources().Load(s.name, secret); err != nil {
+		return nil, "", fmt.Errorf("unable to load CredentialStore secret %s: %w", s.name, err)
+	}
+	return secret.Data, secret.ResourceVersion, nil
+}
+
+// vaultCredentialSource provides the keys and values of a HashiCorp Vault KV version 2 secret
+type vaultCredentialSource struct {
+	client *vault.Client
+	mount  string
+	path   string
+}
+
+func (v *vaultCredentialSource) Entries() (map[string][]byte, string, error) {
+	secret, err := v.client.ReadKV(v.mount, v.path)
+	if err != nil {
+		return nil, "", err
+	}
+	entries := make(map[string][]byte, len(secret.Data))
+	for alias, cred := range secret.Data {
+		entries[alias] = []byte(cred)
+	}
+	return entries, fmt.Sprintf("%s/%s@%d", v.mount, v.path, secret.Version), nil
+}
+
+// vaultTokens caches the tokens obtained with the Vault Kubernetes auth method across reconciliations
+var vaultTokens = vault.NewTokenCache()
+
+// credentialSource returns the CredentialSource configured for the Infinispan CR, or nil if no user provided entries
+// are added to the credential store
+func credentialSource(i *ispnv1.Infinispan, ctx pipeline.Context) (CredentialSource, error) {
+	if i.IsCredentialStoreSecretDefined() {
+		return &secretCredentialSource{ctx: ctx, name: i.GetCredentialStoreSecretName()}, nil
+	}
+	if !i.IsCredentialStoreSourceDefined() {
+		return nil, nil
+	}
+
+	spec := i.Spec.Security.CredentialStoreSource.Vault
+	config := vault.Config{
+		Address:        spec.URL,
+		KubernetesRole: spec.KubernetesRole,
+		Tokens:         vaultTokens,
+	}
+	if spec.KubernetesRole != "" {
+		jwt, err := os.ReadFile(consts.ServiceAccountTokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the operator ServiceAccount token: %w", err)
+		}
+		config.KubernetesJWT = string(jwt)
+	} else {
+		secret := &corev1.Secret{}
+		if err := ctx.Resources().Load(spec.TokenSecretName, secret); err != nil {
+			return nil, fmt.Errorf("unable to load Vault token secret %s: %w", spec.TokenSecretName, err)
+		}
+		token, ok := secret.Data[consts.VaultTokenKey]
+		if !ok {
+			return nil, fmt.Errorf("the '%s' key must be provided in Vault token secret %s", consts.VaultTokenKey, secret.Name)
+		}
+		config.Token = string(token)
+	}
+
+	client, err := vault.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &vaultCredentialSource{client: client, mount: spec.Mount, path: spec.Path}, nil
+}
+
+func CredentialStore(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	entries := map[string][]byte{}
+	source, err := credentialSource(i, ctx)
+	if err != nil {
+		ctx.Requeue(err)
+		return
+	}
+
+	var externalEntries map[string][]byte
+	var externalVersion string
+	if source != nil {
+		userEntries, version, err := source.Entries()
+		if err != nil {
+			ctx.Requeue(err)
+			return
+		}
+		if i.IsCredentialStoreSourceDefined() {
+			// Entries of an external secret store are added to running pods, instead of the identities batch which is
+			// persisted in a Secret
+			externalEntries = userEntries
+			externalVersion = version
+		} else {
+			for alias, cred := range userEntries {
+				entries[alias] = cred
+			}
+		}
+	}
+
+	cacheStoreCredentials, err := cacheStoreCredentials(i, ctx)
+	if err != nil {
+		ctx.Requeue(err)
 		return
 	}
-	ctx.ConfigFiles().CredentialStoreEntries = secret.Data
+
+	if i.IsLDAPRealmEnabled() {
+		secret := &corev1.Secret{}
+		if err := ctx.Resources().Load(i.GetLDAPBindCredentialSecretName(), secret); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to load LDAP bind credential secret %s: %w", i.GetLDAPBindCredentialSecretName(), err))
+			return
+		}
+		password, ok := secret.Data[consts.LDAPBindPasswordKey]
+		if !ok {
+			ctx.Requeue(fmt.Errorf("the '%s' key must be provided in LDAP bind credential secret %s", consts.LDAPBindPasswordKey, secret.Name))
+			return
+		}
+		entries[consts.LDAPBindCredentialAlias] = password
+	}
+
+	configFiles := ctx.ConfigFiles()
+	if len(entries) > 0 {
+		configFiles.CredentialStoreEntries = entries
+	}
+	configFiles.ExternalCredentialStoreEntries = externalEntries
+	configFiles.ExternalCredentialStoreVersion = externalVersion
+	configFiles.CacheStoreCredentials = cacheStoreCredentials
+}
+
+// cacheStoreCredentials returns the password of every Cache CR store of the cluster that connects to a database, keyed
+// by the alias that the store's connection pool references
+func cacheStoreCredentials(i *ispnv1.Infinispan, ctx pipeline.Context) (map[string][]byte, error) {
+	cacheList := &v2alpha1.CacheList{}
+	if err := ctx.Kubernetes().ResourcesListByField(i.Namespace, "spec.clusterName", i.Name, cacheList, ctx.Ctx()); err != nil {
+		return nil, fmt.Errorf("unable to retrieve existing Cache resources: %w", err)
+	}
+
+	credentials := map[string][]byte{}
+	for _, cache := range cacheList.Items {
+		connection := cache.PersistenceConnection()
+		if connection == nil || !cache.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := ctx.Resources().Load(connection.CredentialsSecret, secret, pipeline.SkipEventRec); err != nil {
+			if errors.IsNotFound(err) {
+				msg := fmt.Sprintf("Secret '%s' referenced by Cache '%s' not found", connection.CredentialsSecret, cache.Name)
+				ctx.EventRecorder().Event(i, corev1.EventTypeWarning, "CacheCredentialsNotFound", msg)
+				continue
+			}
+			return nil, fmt.Errorf("unable to load database credentials for Cache '%s': %w", cache.Name, err)
+		}
+		credentials[cache.GetStoreCredentialAlias(secret.ResourceVersion)] = secret.Data["password"]
+	}
+	return credentials, nil
 }
@@ -5,11 +5,13 @@ import (
 	"strings"
 
 	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
 	consts "github.com/infinispan/infinispan-operator/controllers/constants"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/configuration/logging"
 	config "github.com/infinispan/infinispan-operator/pkg/infinispan/configuration/server"
 	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
 )
 
 func UserConfigMap(i *ispnv1.Infinispan, ctx pipeline.Context) {
@@ -68,12 +70,59 @@ func InfinispanServer(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		JGroups: config.JGroups{
 			Diagnostics: consts.JGroupsDiagnosticsFlag,
 			FastMerge:   consts.JGroupsFastMerge,
+			Discovery:   string(i.GetJGroupsDiscoveryType()),
 		},
 		Endpoints: config.Endpoints{
 			Authenticate: i.IsAuthenticationEnabled(),
 			ClientCert:   string(ispnv1.ClientCertNone),
 		},
-		UserCredentialStore: len(configFiles.CredentialStoreEntries) > 0,
+		// The credential store is always declared with an external source, so that entries can be added to running pods
+		UserCredentialStore: len(configFiles.CredentialStoreEntries) > 0 || len(configFiles.CacheStoreCredentials) > 0 || i.IsCredentialStoreSourceDefined(),
+	}
+
+	for _, e := range i.Spec.Endpoints {
+		configSpec.Endpoints.Connectors = append(configSpec.Endpoints.Connectors, config.Connector{
+			Name:          e.Name,
+			Type:          string(e.Type),
+			Port:          e.Port,
+			SecurityRealm: e.GetSecurityRealm(),
+		})
+	}
+
+	if jgroups := i.Spec.JGroups; jgroups != nil {
+		if i.IsJGroupsKubernetesDiscovery() {
+			configSpec.JGroups.PodLabel = fmt.Sprintf("%s=%s", consts.StatefulSetPodLabel, i.GetStatefulSetName())
+		} else if jgroups.Discovery != nil {
+			configSpec.JGroups.StaticHosts = jgroups.Discovery.Hosts
+		}
+
+		if fd := jgroups.FailureDetection; fd != nil {
+			configSpec.JGroups.FailureDetection = &config.JGroupsFailureDetection{
+				Interval: pointer.Int64Deref(fd.Interval, consts.DefaultJGroupsFDInterval),
+				Timeout:  pointer.Int64Deref(fd.Timeout, consts.DefaultJGroupsFDTimeout),
+			}
+		}
+
+		if merge := jgroups.Merge; merge != nil {
+			configSpec.JGroups.Merge = &config.JGroupsMerge{
+				MinInterval: pointer.Int64Deref(merge.MinInterval, consts.DefaultJGroupsMergeMinInterval),
+				MaxInterval: pointer.Int64Deref(merge.MaxInterval, consts.DefaultJGroupsMergeMaxInterval),
+			}
+		}
+
+		if i.IsJGroupsEncryptionEnabled() {
+			encryption := &config.JGroupsEncryption{
+				Type: string(jgroups.Encryption.Type),
+			}
+			if ks := configFiles.Transport.EncryptionKeystore; ks != nil {
+				encryption.Keystore = config.Keystore{
+					Alias:    ks.Alias,
+					Password: ks.Password,
+					Path:     ks.Path,
+				}
+			}
+			configSpec.JGroups.Encryption = encryption
+		}
 	}
 
 	if i.HasSites() {
@@ -125,6 +174,17 @@ func InfinispanServer(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		configSpec.Infinispan.Authorization.Roles = confRoles
 	}
 
+	// The roles of Role CRs are defined at runtime, which requires the cluster permission mapper. The mapper is only
+	// configured whilst Role CRs exist for the cluster, as adding it to the server configuration restarts the cluster
+	if i.IsAuthorizationEnabled() {
+		roleList := &v2alpha1.RoleList{}
+		if err := ctx.Kubernetes().ResourcesListByField(i.Namespace, "spec.clusterName", i.Name, roleList, ctx.Ctx()); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to list Role CRs: %w", err))
+			return
+		}
+		configSpec.Infinispan.Authorization.PermissionMapper = len(roleList.Items) > 0
+	}
+
 	if i.Spec.CloudEvents != nil {
 		configSpec.CloudEvents = &config.CloudEvents{
 			Acks:              i.Spec.CloudEvents.Acks,
@@ -146,6 +206,37 @@ func InfinispanServer(i *ispnv1.Infinispan, ctx pipeline.Context) {
 			configSpec.Truststore.Path = fmt.Sprintf("%s/%s", consts.ServerEncryptTruststoreRoot, consts.EncryptTruststoreKey)
 		}
 	}
+	if i.IsLDAPRealmEnabled() {
+		ldap := i.Spec.Security.LDAP
+		configSpec.LDAP = &config.LDAPRealm{
+			URL:             ldap.URL,
+			Principal:       ldap.BindDN,
+			CredentialAlias: consts.LDAPBindCredentialAlias,
+			UserSearchDN:    ldap.UserSearchDN,
+			UserFilter:      ldap.UserFilter,
+			UserAttribute:   ldap.UserNameAttribute,
+			GroupSearchDN:   ldap.GroupSearchDN,
+			GroupFilter:     ldap.GroupFilter,
+			GroupAttribute:  ldap.GroupNameAttribute,
+		}
+		if configFiles.LDAPTruststore != nil {
+			configSpec.LDAP.Truststore = consts.ServerOperatorSecurity + "/" + consts.ServerLDAPTruststoreFilename
+		}
+	}
+	if i.IsTokenRealmEnabled() {
+		token := i.Spec.Security.TokenRealm
+		configSpec.TokenRealm = &config.TokenRealm{
+			IssuerURL:      token.IssuerURL,
+			ClientID:       token.ClientID,
+			PublicKey:      token.PublicKey,
+			PrincipalClaim: token.PrincipalClaim,
+			RoleClaim:      token.RoleClaim,
+		}
+	}
+	if i.IsCredentialRotationEnabled() {
+		configSpec.PreviousAdminCredentials = true
+		configSpec.PreviousUserCredentials = i.IsAuthenticationEnabled() && i.IsGeneratedSecret()
+	}
 	// Save the spec for later so that we can reuse it for HR rolling upgrades
 	configFiles.ConfigSpec = *configSpec
 
@@ -0,0 +1,36 @@
+package configure
+
+import (
+	"fmt"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func JGroupsEncryption(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	secret := &corev1.Secret{}
+	if err := ctx.Resources().Load(i.Spec.JGroups.Encryption.SecretName, secret, pipeline.RetryOnErr); err != nil {
+		return
+	}
+
+	password := string(secret.Data[consts.JGroupsEncryptPasswordKey])
+	alias := string(secret.Data[consts.JGroupsEncryptAliasKey])
+	if _, ok := secret.Data[consts.JGroupsEncryptKeyStoreKey]; !ok {
+		ctx.Stop(fmt.Errorf("%s is required for the JGroups encryption Keystore stored in Secret %s", consts.JGroupsEncryptKeyStoreKey, secret.Name))
+		return
+	}
+	if err := validateXSiteTLSKeyStore(secret.Name, consts.JGroupsEncryptKeyStoreKey, password, alias); err != nil {
+		ctx.Stop(err)
+		return
+	}
+
+	ctx.ConfigFiles().Transport.EncryptionKeystore = &pipeline.Keystore{
+		Alias:    alias,
+		Password: password,
+		Path:     fmt.Sprintf("%s/%s", consts.JGroupsEncryptKeyStoreRoot, consts.JGroupsEncryptKeyStoreKey),
+		Type:     "pkcs12",
+	}
+	ctx.Log().WithName("jgroups").Info("Transport encryption configured.", "Secret Name", secret.Name)
+}
@@ -1,20 +1,27 @@
 package configure
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
 	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/hash"
 	"github.com/infinispan/infinispan-operator/pkg/infinispan/security"
 	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	p12 "software.sslmate.com/src/go-pkcs12"
 )
 
 const (
 	EncryptPkcs12KeystoreName = "keystore.p12"
 	EncryptPemKeystoreName    = "keystore.pem"
+	// CertManagerCAKey the key in a cert-manager issued secret containing the CA certificate
+	CertManagerCAKey = "ca.crt"
 )
 
 func Keystore(i *ispnv1.Infinispan, ctx pipeline.Context) {
@@ -58,29 +65,86 @@ func Keystore(i *ispnv1.Infinispan, ctx pipeline.Context) {
 			return
 		}
 	}
-	_ = ctx.UpdateInfinispan(func() {
-		i.SetCondition(ispnv1.ConditionTLSSecretValid, metav1.ConditionTrue, "")
-	})
+	if keystore.PemFile == nil && keystore.File == nil {
+		_ = ctx.UpdateInfinispan(func() {
+			i.SetCondition(ispnv1.ConditionTLSSecretValid, metav1.ConditionTrue, "")
+		})
+	} else if !keystoreValidity(i, ctx, keystore) {
+		return
+	}
 	ctx.ConfigFiles().Keystore = keystore
 }
 
+// keystoreValidity updates the TLS status with the validity period of the keystore certificate, reporting when the
+// certificate has expired or is about to expire. Returns false if the pipeline should not continue.
+func keystoreValidity(i *ispnv1.Infinispan, ctx pipeline.Context, keystore *pipeline.Keystore) bool {
+	cert, err := security.KeystoreCertificate(keystore.PemFile, keystore.File, keystore.Password)
+	if err != nil {
+		errMsg := fmt.Sprintf("Failed to setup TLS. Unable to load the certificate from Secret %s: %v", i.GetKeystoreSecretName(), err)
+		_ = ctx.UpdateInfinispan(func() {
+			i.SetCondition(ispnv1.ConditionTLSSecretValid, metav1.ConditionFalse, errMsg)
+		})
+		ctx.Requeue(nil)
+		return false
+	}
+
+	// Periodically check the certificate, so that the expiry warning is reported without the Secret being updated
+	ctx.RequeueEventually(consts.DefaultTLSCertificateCheckInterval)
+
+	now := time.Now()
+	notAfter := cert.NotAfter.Format(time.RFC3339)
+	expiring := metav1.ConditionFalse
+	var expiringMsg string
+	if remaining := cert.NotAfter.Sub(now); remaining < consts.DefaultTLSCertificateExpiryWarning {
+		expiring = metav1.ConditionTrue
+		expiringMsg = fmt.Sprintf("The certificate in Secret %s expires at %s", i.GetKeystoreSecretName(), notAfter)
+		if existing := i.GetCondition(ispnv1.ConditionTLSCertificateExpiring); existing.Status != metav1.ConditionTrue {
+			ctx.EventRecorder().Event(i, corev1.EventTypeWarning, "TLSCertificateExpiring", expiringMsg)
+		}
+	}
+
+	err = ctx.UpdateInfinispan(func() {
+		if now.After(cert.NotAfter) {
+			i.SetCondition(ispnv1.ConditionTLSSecretValid, metav1.ConditionFalse, fmt.Sprintf("The certificate in Secret %s expired at %s", i.GetKeystoreSecretName(), notAfter))
+		} else if now.Before(cert.NotBefore) {
+			i.SetCondition(ispnv1.ConditionTLSSecretValid, metav1.ConditionFalse, fmt.Sprintf("The certificate in Secret %s is not valid before %s", i.GetKeystoreSecretName(), cert.NotBefore.Format(time.RFC3339)))
+		} else {
+			i.SetCondition(ispnv1.ConditionTLSSecretValid, metav1.ConditionTrue, "")
+		}
+		i.SetCondition(ispnv1.ConditionTLSCertificateExpiring, expiring, expiringMsg)
+		if i.Status.TLS == nil {
+			i.Status.TLS = &ispnv1.TLSStatus{}
+		}
+		i.Status.TLS.NotBefore = &metav1.Time{Time: cert.NotBefore}
+		i.Status.TLS.NotAfter = &metav1.Time{Time: cert.NotAfter}
+	})
+	return err == nil
+}
+
 func Truststore(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	trustSecret := &corev1.Secret{}
-	if err := ctx.Resources().Load(i.GetTruststoreSecretName(), trustSecret, pipeline.RetryOnErr); err != nil {
+	if i.IsEncryptionCertFromCertManager() {
+		// The CA of the issued certificate is trusted, so a client certificate secret is optional
+		if err := ctx.Resources().Load(i.GetTruststoreSecretName(), trustSecret, pipeline.RetryOnErr, pipeline.IgnoreNotFound); err != nil {
+			return
+		}
+	} else if err := ctx.Resources().Load(i.GetTruststoreSecretName(), trustSecret, pipeline.RetryOnErr); err != nil {
 		return
 	}
 
 	passwordBytes, passwordProvided := trustSecret.Data[consts.EncryptTruststorePasswordKey]
 	password := string(passwordBytes)
 
-	// If Truststore and password already exist, nothing to do
-	if truststore, exists := trustSecret.Data[consts.EncryptTruststoreKey]; exists {
+	// If Truststore and password were provided by the user, nothing to do
+	existingTruststore, truststoreExists := trustSecret.Data[consts.EncryptTruststoreKey]
+	existingSourceHash, generated := trustSecret.Data[consts.EncryptTruststoreSourceHashKey]
+	if truststoreExists && !generated {
 		if !passwordProvided {
 			ctx.Requeue(fmt.Errorf("the '%s' key must be provided when configuring an existing Truststore", consts.EncryptTruststorePasswordKey))
 			return
 		}
 		ctx.ConfigFiles().Truststore = &pipeline.Truststore{
-			File:     truststore,
+			File:     existingTruststore,
 			Password: password,
 		}
 		return
@@ -94,18 +158,78 @@ func Truststore(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	caPem := trustSecret.Data["trust.ca"]
 	certs := [][]byte{caPem}
 
+	if i.IsEncryptionCertFromCertManager() {
+		keystoreSecret := &corev1.Secret{}
+		if err := ctx.Resources().Load(i.GetKeystoreSecretName(), keystoreSecret, pipeline.RetryOnErr); err != nil {
+			return
+		}
+		certs = append(certs, keystoreSecret.Data[CertManagerCAKey])
+	}
+
+	var certKeys []string
 	for certKey := range trustSecret.Data {
 		if strings.HasPrefix(certKey, "trust.cert.") {
-			certs = append(certs, trustSecret.Data[certKey])
+			certKeys = append(certKeys, certKey)
 		}
 	}
-	truststore, err := security.GenerateTruststore(certs, password)
+	sort.Strings(certKeys)
+	for _, certKey := range certKeys {
+		certs = append(certs, trustSecret.Data[certKey])
+	}
+	sourceHash := hash.HashByte(bytes.Join(certs, nil))
+
+	truststore, err := generatedTruststore(i, certs, password, existingTruststore, string(existingSourceHash) == sourceHash)
 	if err != nil {
 		ctx.Requeue(err)
 		return
 	}
 	ctx.ConfigFiles().Truststore = &pipeline.Truststore{
-		File:     truststore,
-		Password: password,
+		File:       truststore,
+		Password:   password,
+		SourceHash: sourceHash,
+	}
+}
+
+// LDAPTruststore loads the CA certificates trusted by LDAPS connections
+func LDAPTruststore(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	secret := &corev1.Secret{}
+	if err := ctx.Resources().Load(i.GetLDAPCASecretName(), secret, pipeline.RetryOnErr); err != nil {
+		return
+	}
+
+	ca, ok := secret.Data[consts.LDAPCAKey]
+	if !ok {
+		ctx.Requeue(fmt.Errorf("the '%s' key must be provided in LDAP CA secret %s", consts.LDAPCAKey, secret.Name))
+		return
+	}
+	ctx.ConfigFiles().LDAPTruststore = ca
+}
+
+// generatedTruststore returns the truststore for the provided certificates. When the certificates are updated, the
+// certificates of the existing truststore are retained so that both the old and the new CA are trusted whilst the pods
+// are rotated. Once the rotation has completed, the truststore is regenerated with only the provided certificates.
+func generatedTruststore(i *ispnv1.Infinispan, certs [][]byte, password string, existing []byte, unchanged bool) ([]byte, error) {
+	if existing == nil {
+		return security.GenerateTruststore(certs, password, nil)
+	}
+
+	previous, err := p12.DecodeTrustStore(existing, password)
+	if err != nil {
+		// The existing truststore cannot be read, e.g. the password was updated, so generate a new one
+		return security.GenerateTruststore(certs, password, nil)
+	}
+
+	if !unchanged {
+		return security.GenerateTruststore(certs, password, previous)
+	}
+
+	current, err := security.PemCertificates(certs)
+	if err != nil {
+		return nil, err
+	}
+	if i.IsTLSRotationInProgress() || len(previous) <= len(current) {
+		// Reuse the existing truststore, as the encoding of a generated truststore is not deterministic
+		return existing, nil
 	}
+	return security.GenerateTruststore(certs, password, nil)
 }
@@ -274,7 +274,10 @@ func TransportTLS(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	}
 
 	keyStoreFileName := i.GetSiteTransportKeyStoreFileName()
-	password := string(keyStoreSecret.Data["password"])
+	password, err := siteKeyStorePassword(i, ctx, keyStoreSecret)
+	if err != nil {
+		return
+	}
 	alias := i.GetSiteTransportKeyStoreAlias()
 
 	if err := validateXSiteTLSKeyStore(keyStoreSecret.Name, keyStoreFileName, password, alias); err != nil {
@@ -285,7 +288,7 @@ func TransportTLS(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	configFiles := ctx.ConfigFiles()
 	configFiles.Transport.Keystore = &pipeline.Keystore{
 		Alias:    i.GetSiteTransportKeyStoreAlias(),
-		Password: string(keyStoreSecret.Data["password"]),
+		Password: password,
 		Path:     fmt.Sprintf("%s/%s", consts.SiteTransportKeyStoreRoot, keyStoreFileName),
 		Type:     consts.GetWithDefault(string(keyStoreSecret.Data["type"]), "pkcs12"),
 	}
@@ -307,7 +310,9 @@ func TransportTLS(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		return
 	}
 	trustStoreFileName := i.GetSiteTrustStoreFileName()
-	password = string(trustStoreSecret.Data["password"])
+	if password, err = siteKeyStorePassword(i, ctx, trustStoreSecret); err != nil {
+		return
+	}
 
 	if err := validateXSiteTLSTrustStore(trustStoreSecret.Name, trustStoreFileName, password); err != nil {
 		ctx.Stop(err)
@@ -329,7 +334,10 @@ func GossipRouterTLS(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	}
 
 	filename := i.GetSiteRouterKeyStoreFileName()
-	password := string(keyStoreSecret.Data["password"])
+	password, err := siteKeyStorePassword(i, ctx, keyStoreSecret)
+	if err != nil {
+		return
+	}
 	alias := i.GetSiteRouterKeyStoreAlias()
 
 	if err := validateXSiteTLSKeyStore(keyStoreSecret.Name, filename, password, alias); err != nil {
@@ -358,6 +366,19 @@ func GossipRouterTLS(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	}
 }
 
+// siteKeyStorePassword returns the password of a keystore or truststore secret. The keystores created by cert-manager
+// do not contain the password, so it is retrieved from the operator generated password secret instead.
+func siteKeyStorePassword(i *ispnv1.Infinispan, ctx pipeline.Context, secret *corev1.Secret) (string, error) {
+	if password, exists := secret.Data["password"]; exists || !i.IsSiteCertFromCertManager() {
+		return string(password), nil
+	}
+	passwordSecret := &corev1.Secret{}
+	if err := ctx.Resources().Load(i.GetSiteKeyStorePasswordSecretName(), passwordSecret, pipeline.RetryOnErr); err != nil {
+		return "", err
+	}
+	return string(passwordSecret.Data[consts.SiteCertManagerKeyStorePasswordKey]), nil
+}
+
 func validateXSiteTLSKeyStore(secretName, filename, password, alias string) error {
 	if len(filename) == 0 {
 		return fmt.Errorf("filename is required for Keystore stored in Secret %s", secretName)
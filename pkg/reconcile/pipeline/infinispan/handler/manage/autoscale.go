@@ -0,0 +1,219 @@
+package manage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MemoryAutoscaling adds or removes a replica based upon the heap usage reported by the cluster's metrics endpoint.
+// Replicas are only added when usage exceeds spec.autoscale.maxMemUsagePercent and removed when usage is below
+// spec.autoscale.minMemUsagePercent, with spec.autoscale.cooldown required between two scaling operations. Updating
+// spec.replicas triggers the ScalingUp/ScalingDown conditions managed by ClusterScaling.
+func MemoryAutoscaling(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	// Memory usage must be evaluated periodically, so always requeue once the pipeline has completed
+	ctx.RequeueEventually(consts.DefaultAutoscaleInterval)
+
+	// Only scale a stable cluster
+	if i.Spec.Replicas == 0 || i.Status.Replicas == nil || *i.Status.Replicas != i.Spec.Replicas ||
+		!i.IsConditionTrue(ispnv1.ConditionWellFormed) || i.IsConditionTrue(ispnv1.ConditionScalingUp) ||
+		i.IsConditionTrue(ispnv1.ConditionScalingDown) || i.IsUpgradeCondition() || i.IsHotRodUpgrade() {
+		return
+	}
+
+	autoscale := i.Spec.Autoscale
+	if status := i.Status.Autoscale; status != nil && status.LastScaleTime != nil && autoscale.Cooldown != nil {
+		if time.Since(status.LastScaleTime.Time) < autoscale.Cooldown.Duration {
+			return
+		}
+	}
+
+	var replicas int32
+	var reason string
+	switch {
+	case i.Spec.Replicas < autoscale.MinReplicas:
+		replicas, reason = autoscale.MinReplicas, "replicas below spec.autoscale.minReplicas"
+	case i.Spec.Replicas > autoscale.MaxReplicas:
+		replicas, reason = autoscale.MaxReplicas, "replicas above spec.autoscale.maxReplicas"
+	default:
+		metrics, err := clusterMemoryMetrics(ctx)
+		if err != nil {
+			ctx.Log().Error(err, "unable to evaluate cluster memory usage for autoscaling")
+			return
+		}
+		replicas, reason = desiredReplicas(autoscale, i.Spec.Replicas, i.GetAutoscaleReplicationFactor(), metrics)
+	}
+
+	if replicas == i.Spec.Replicas {
+		if reason != "" {
+			ctx.Log().Info("Not autoscaling cluster", "reason", reason)
+		}
+		return
+	}
+
+	msg := fmt.Sprintf("Autoscaling from %d to %d replicas, %s", i.Spec.Replicas, replicas, reason)
+	ctx.Log().Info(msg)
+	ctx.EventRecorder().Event(i, corev1.EventTypeNormal, "Autoscale", msg)
+	ctx.Requeue(
+		ctx.UpdateInfinispan(func() {
+			i.Spec.Replicas = replicas
+			if i.Status.Autoscale == nil {
+				i.Status.Autoscale = &ispnv1.AutoscaleStatus{}
+			}
+			i.Status.Autoscale.LastScaleTime = &metav1.Time{Time: time.Now()}
+		}),
+	)
+}
+
+// desiredReplicas returns the number of replicas required by the cluster's current memory usage and the reason for any
+// change. A replica is only removed if the remaining replicas are able to hold the cluster's data with the configured
+// number of owners without exceeding maxMemUsagePercent.
+func desiredReplicas(autoscale *ispnv1.Autoscale, replicas, owners int32, metrics []memoryMetrics) (int32, string) {
+	var heapUsed, heapMax, dataUsed float64
+	for _, m := range metrics {
+		heapUsed += m.heapUsed
+		heapMax += m.heapMax
+		dataUsed += m.dataUsed
+	}
+	if heapMax <= 0 {
+		return replicas, ""
+	}
+
+	usage := heapUsed / heapMax * 100
+	switch {
+	case usage > float64(autoscale.MaxMemUsagePercent) && replicas < autoscale.MaxReplicas:
+		return replicas + 1, fmt.Sprintf("heap usage %.1f%% above %d%%", usage, autoscale.MaxMemUsagePercent)
+	case usage < float64(autoscale.MinMemUsagePercent) && replicas > autoscale.MinReplicas:
+		if dataUsed <= 0 {
+			// Data container metrics are only reported when memory based eviction is configured, so fallback to the
+			// heap usage which is an upper bound of the memory used by the data
+			dataUsed = heapUsed
+		}
+		// The measured data includes all copies of each entry, so determine the size of a single copy
+		primary := dataUsed / float64(copies(owners, replicas))
+		target := replicas - 1
+		required := primary * float64(copies(owners, target)) / float64(target)
+		capacity := heapMax / float64(len(metrics)) * float64(autoscale.MaxMemUsagePercent) / 100
+		if required > capacity {
+			return replicas, fmt.Sprintf("heap usage %.1f%% below %d%%, but %d replicas are unable to hold the data with %d owners",
+				usage, autoscale.MinMemUsagePercent, target, owners)
+		}
+		return target, fmt.Sprintf("heap usage %.1f%% below %d%%", usage, autoscale.MinMemUsagePercent)
+	}
+	return replicas, ""
+}
+
+// copies returns the number of copies of each entry stored by a cluster of the given size
+func copies(owners, replicas int32) int32 {
+	if replicas < owners {
+		return replicas
+	}
+	return owners
+}
+
+// memoryMetrics contains the memory usage of a single server in bytes
+type memoryMetrics struct {
+	heapUsed float64
+	heapMax  float64
+	dataUsed float64
+}
+
+func clusterMemoryMetrics(ctx pipeline.Context) ([]memoryMetrics, error) {
+	podList, err := ctx.InfinispanPods()
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []memoryMetrics
+	for _, pod := range podList.Items {
+		if !kube.IsPodReady(pod) {
+			continue
+		}
+		buf, err := ctx.InfinispanClientForPod(pod.Name).Metrics().Get("")
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve metrics of pod '%s': %w", pod.Name, err)
+		}
+		m, err := parseMemoryMetrics(buf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse metrics of pod '%s': %w", pod.Name, err)
+		}
+		metrics = append(metrics, *m)
+	}
+	if len(metrics) == 0 {
+		return nil, fmt.Errorf("no ready pods")
+	}
+	return metrics, nil
+}
+
+// parseMemoryMetrics extracts the heap and data container memory usage from metrics in the Prometheus text format.
+// Both the MicroProfile compatible and the Micrometer JVM metric names are supported.
+func parseMemoryMetrics(r io.Reader) (*memoryMetrics, error) {
+	m := &memoryMetrics{}
+	var jvmUsed, jvmMax float64
+	var hasBase bool
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var name, labels, rest string
+		if idx := strings.IndexByte(line, '{'); idx > 0 {
+			end := strings.LastIndexByte(line, '}')
+			if end < idx {
+				continue
+			}
+			name, labels, rest = line[:idx], line[idx+1:end], line[end+1:]
+		} else if idx := strings.IndexByte(line, ' '); idx > 0 {
+			name, rest = line[:idx], line[idx:]
+		} else {
+			continue
+		}
+
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil || value < 0 {
+			// Undefined values, such as the max of an unbounded memory pool, are reported as -1
+			continue
+		}
+
+		heap := strings.Contains(labels, `area="heap"`)
+		switch {
+		case name == "base_memory_usedHeap_bytes":
+			m.heapUsed, hasBase = value, true
+		case name == "base_memory_maxHeap_bytes":
+			m.heapMax, hasBase = value, true
+		case name == "jvm_memory_used_bytes" && heap:
+			jvmUsed += value
+		case name == "jvm_memory_max_bytes" && heap:
+			jvmMax += value
+		case strings.HasSuffix(name, "_statistics_data_memory_used") || strings.HasSuffix(name, "_statistics_data_memory_used_bytes"):
+			m.dataUsed += value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if !hasBase {
+		m.heapUsed, m.heapMax = jvmUsed, jvmMax
+	}
+	if m.heapMax <= 0 {
+		return nil, fmt.Errorf("heap metrics not available")
+	}
+	return m, nil
+}
@@ -0,0 +1,89 @@
+package manage
+
+import (
+	"strings"
+	"testing"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mib = 1024 * 1024
+
+func TestParseMemoryMetrics(t *testing.T) {
+	metrics := `# TYPE base_memory_usedHeap_bytes gauge
+base_memory_usedHeap_bytes 1.048576E8
+# TYPE base_memory_maxHeap_bytes gauge
+base_memory_maxHeap_bytes 2.097152E8
+vendor_cache_manager_default_cache_a_statistics_data_memory_used 1024.0
+vendor_cache_manager_default_cache_b_statistics_data_memory_used{node="pod-0"} 2048.0 1680000000000
+vendor_cache_manager_default_cache_b_statistics_data_memory_used_ratio 1.0
+`
+	m, err := parseMemoryMetrics(strings.NewReader(metrics))
+	require.NoError(t, err)
+	assert.Equal(t, memoryMetrics{heapUsed: 100 * mib, heapMax: 200 * mib, dataUsed: 3072}, *m)
+}
+
+func TestParseMicrometerMemoryMetrics(t *testing.T) {
+	metrics := `# HELP jvm_memory_used_bytes The amount of used memory
+jvm_memory_used_bytes{area="heap",id="G1 Eden Space"} 1.048576E7
+jvm_memory_used_bytes{area="heap",id="G1 Old Gen"} 4.194304E7
+jvm_memory_used_bytes{area="nonheap",id="Metaspace"} 9.437184E7
+jvm_memory_max_bytes{area="heap",id="G1 Eden Space"} -1.0
+jvm_memory_max_bytes{area="heap",id="G1 Old Gen"} 2.097152E8
+jvm_memory_max_bytes{area="nonheap",id="Metaspace"} -1.0
+`
+	m, err := parseMemoryMetrics(strings.NewReader(metrics))
+	require.NoError(t, err)
+	assert.Equal(t, memoryMetrics{heapUsed: 50 * mib, heapMax: 200 * mib}, *m)
+}
+
+func TestParseMemoryMetricsMissingHeap(t *testing.T) {
+	_, err := parseMemoryMetrics(strings.NewReader("vendor_some_metric 1.0\n"))
+	assert.Error(t, err)
+}
+
+func TestDesiredReplicas(t *testing.T) {
+	autoscale := &ispnv1.Autoscale{
+		MinReplicas:        1,
+		MaxReplicas:        4,
+		MinMemUsagePercent: 30,
+		MaxMemUsagePercent: 70,
+	}
+	pods := func(n int, m memoryMetrics) []memoryMetrics {
+		metrics := make([]memoryMetrics, n)
+		for i := range metrics {
+			metrics[i] = m
+		}
+		return metrics
+	}
+
+	tests := []struct {
+		name     string
+		replicas int32
+		owners   int32
+		metrics  []memoryMetrics
+		expected int32
+	}{
+		{"scale up above max usage", 2, 2, pods(2, memoryMetrics{heapUsed: 80, heapMax: 100}), 3},
+		{"no scale up beyond max replicas", 4, 2, pods(4, memoryMetrics{heapUsed: 80, heapMax: 100}), 4},
+		{"no scaling within usage bounds", 2, 2, pods(2, memoryMetrics{heapUsed: 50, heapMax: 100}), 2},
+		{"scale down below min usage", 3, 2, pods(3, memoryMetrics{heapUsed: 20, heapMax: 100, dataUsed: 10}), 2},
+		{"no scale down below min replicas", 1, 2, pods(1, memoryMetrics{heapUsed: 20, heapMax: 100}), 1},
+		// 3 pods holding 25 each with 2 owners is 37.5 per pod on 2 replicas, within the capacity of 70
+		{"scale down when remaining replicas hold the data", 3, 2, pods(3, memoryMetrics{heapUsed: 25, heapMax: 100, dataUsed: 25}), 2},
+		// 4 pods holding 25 each with 4 owners is 25 of primary data, which must be held by all 3 remaining pods
+		{"scale down with owners greater than replicas", 4, 4, pods(4, memoryMetrics{heapUsed: 25, heapMax: 100, dataUsed: 25}), 3},
+		// 2 pods holding 40 of off-heap data each with 1 owner is 80, which exceeds the capacity of a single pod
+		{"no scale down when data does not fit", 2, 1, pods(2, memoryMetrics{heapUsed: 20, heapMax: 100, dataUsed: 40}), 2},
+		// Without data container metrics the heap usage is used, 3 pods using 20 each with 2 owners is 30 per pod on 2 replicas
+		{"scale down using heap usage fallback", 3, 2, pods(3, memoryMetrics{heapUsed: 20, heapMax: 100}), 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replicas, _ := desiredReplicas(autoscale, tt.replicas, tt.owners, tt.metrics)
+			assert.Equal(t, tt.expected, replicas)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package manage
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/hash"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/security"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	"github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan/handler/provision"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+const serverCliPath = "/opt/infinispan/bin/cli.sh"
+
+// appliedCredentialStore the version and aliases of the credential store entries added to a running server container
+type appliedCredentialStore struct {
+	Version string   `json:"version"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// CredentialStore adds the entries retrieved from an external secret store and the passwords of the Cache CR stores to
+// the credential store of every ready server, without restarting the pods. The entries are executed as a CLI batch
+// streamed to the server container, so that they are not passed as arguments, and the aliases that are no longer
+// entries are removed from the credential store. The version and aliases of the entries added to a server container
+// are recorded on its pod, so that the entries are only added again when they are updated or the container restarts.
+// With an external secret store, reconciliation is requeued after the refresh interval so that its updates are applied.
+func CredentialStore(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	podList, err := ctx.InfinispanPods()
+	if err != nil {
+		return
+	}
+
+	configFiles := ctx.ConfigFiles()
+	entries := make(map[string][]byte, len(configFiles.ExternalCredentialStoreEntries)+len(configFiles.CacheStoreCredentials))
+	for alias, cred := range configFiles.ExternalCredentialStoreEntries {
+		entries[alias] = cred
+	}
+	for alias, cred := range configFiles.CacheStoreCredentials {
+		entries[alias] = cred
+	}
+	version := credentialStoreVersion(configFiles)
+	for _, pod := range podList.Items {
+		containerID := serverContainerID(pod)
+		if containerID == "" || !kube.IsPodReady(pod) {
+			continue
+		}
+
+		applied := credentialStoreApplied(pod, containerID)
+		if applied.Version == version || len(entries) == 0 && len(applied.Aliases) == 0 {
+			continue
+		}
+
+		_, err := ctx.Kubernetes().ExecWithOptions(kube.ExecOptions{
+			Container: provision.InfinispanContainer,
+			Command:   []string{serverCliPath, "--file=-"},
+			Namespace: i.Namespace,
+			PodName:   pod.Name,
+			Stdin:     strings.NewReader(credentialStoreCliBatch(entries, applied.Aliases)),
+		})
+		if err != nil {
+			ctx.Requeue(fmt.Errorf("unable to add credential store entries to pod '%s': %w", pod.Name, err))
+			return
+		}
+
+		annotation, err := credentialStoreAnnotation(containerID, version, entries)
+		if err != nil {
+			ctx.Requeue(err)
+			return
+		}
+		mutateFn := func() error {
+			if pod.CreationTimestamp.IsZero() {
+				return errors.NewNotFound(corev1.Resource(""), pod.Name)
+			}
+			if pod.Annotations == nil {
+				pod.Annotations = map[string]string{}
+			}
+			pod.Annotations[consts.CredentialStoreAnnotation] = annotation
+			return nil
+		}
+		if _, err := ctx.Resources().CreateOrUpdate(&pod, false, mutateFn, pipeline.IgnoreNotFound, pipeline.RetryOnErr); err != nil {
+			return
+		}
+		ctx.Log().Info("Added credential store entries", "pod", pod.Name, "version", version)
+	}
+	if i.IsCredentialStoreSourceDefined() {
+		ctx.RequeueEventually(i.GetCredentialStoreRefreshInterval())
+	}
+}
+
+// credentialStoreVersion identifies the version of the entries added to running servers. The aliases of the Cache CR
+// store passwords include the version of their Secret, so that the passwords themselves are not hashed
+func credentialStoreVersion(configFiles *pipeline.ConfigFiles) string {
+	return hash.HashString(append([]string{configFiles.ExternalCredentialStoreVersion}, credentialStoreAliases(configFiles.CacheStoreCredentials)...)...)
+}
+
+// credentialStoreCliBatch returns the CLI batch that removes the previously added aliases which are no longer entries,
+// and adds the entries to the credential store of the server
+func credentialStoreCliBatch(entries map[string][]byte, previousAliases []string) string {
+	var batch strings.Builder
+	for _, alias := range previousAliases {
+		if _, ok := entries[alias]; !ok {
+			batch.WriteString(security.RemoveCredentialCli(alias, consts.ServerCredentialStorePath))
+		}
+	}
+	for _, alias := range credentialStoreAliases(entries) {
+		batch.WriteString(security.AddCredentialCli(alias, string(entries[alias]), consts.ServerCredentialStorePath))
+	}
+	return batch.String()
+}
+
+// credentialStoreAliases returns the sorted aliases of the entries, so that the batch is identical for every pod
+func credentialStoreAliases(entries map[string][]byte) []string {
+	aliases := make([]string, 0, len(entries))
+	for alias := range entries {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+// credentialStoreApplied returns the entries added to the running server container of the pod. Nothing is returned when
+// the annotation was recorded for a previous container, as a restarted server only has the entries of the identities batch
+func credentialStoreApplied(pod corev1.Pod, containerID string) appliedCredentialStore {
+	applied := appliedCredentialStore{}
+	id, value, found := strings.Cut(pod.Annotations[consts.CredentialStoreAnnotation], ";")
+	if !found || id != containerID {
+		return applied
+	}
+	if err := json.Unmarshal([]byte(value), &applied); err != nil {
+		return appliedCredentialStore{}
+	}
+	return applied
+}
+
+// credentialStoreAnnotation returns the annotation value recording the version and aliases of the entries added to the
+// server container
+func credentialStoreAnnotation(containerID, version string, entries map[string][]byte) (string, error) {
+	value, err := json.Marshal(appliedCredentialStore{Version: version, Aliases: credentialStoreAliases(entries)})
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal applied credential store entries: %w", err)
+	}
+	return fmt.Sprintf("%s;%s", containerID, value), nil
+}
+
+// serverContainerID returns the ID of the running server container of the pod, which changes when the container restarts
+func serverContainerID(pod corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == provision.InfinispanContainer && status.State.Running != nil {
+			return status.ContainerID
+		}
+	}
+	return ""
+}
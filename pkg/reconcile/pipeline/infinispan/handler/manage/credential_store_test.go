@@ -0,0 +1,55 @@
+package manage
+
+import (
+	"testing"
+
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/security"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCredentialStoreCliBatch(t *testing.T) {
+	entries := map[string][]byte{
+		"ldap-bind-password": []byte("ldap"),
+		"db-password":        []byte("db"),
+	}
+	// The entries are sorted, so that the batch is identical for every pod
+	assert.Equal(t, security.AddCredentialCli("db-password", "db", consts.ServerCredentialStorePath)+
+		security.AddCredentialCli("ldap-bind-password", "ldap", consts.ServerCredentialStorePath), credentialStoreCliBatch(entries, nil))
+
+	// Aliases removed from the external secret store are removed from the credential store
+	assert.Equal(t, security.RemoveCredentialCli("old-password", consts.ServerCredentialStorePath)+
+		security.AddCredentialCli("db-password", "db", consts.ServerCredentialStorePath),
+		credentialStoreCliBatch(map[string][]byte{"db-password": []byte("db")}, []string{"db-password", "old-password"}))
+	assert.Empty(t, credentialStoreCliBatch(nil, nil))
+}
+
+func TestCredentialStoreApplied(t *testing.T) {
+	annotation, err := credentialStoreAnnotation("containerd://1", "secret/infinispan@2", map[string][]byte{"b": nil, "a": nil})
+	require.NoError(t, err)
+	pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{consts.CredentialStoreAnnotation: annotation}}}
+
+	assert.Equal(t, appliedCredentialStore{Version: "secret/infinispan@2", Aliases: []string{"a", "b"}}, credentialStoreApplied(pod, "containerd://1"))
+	// A restarted container has none of the entries
+	assert.Equal(t, appliedCredentialStore{}, credentialStoreApplied(pod, "containerd://2"))
+	assert.Equal(t, appliedCredentialStore{}, credentialStoreApplied(corev1.Pod{}, "containerd://1"))
+}
+
+func TestCredentialStoreVersion(t *testing.T) {
+	configFiles := &pipeline.ConfigFiles{
+		ExternalCredentialStoreVersion: "secret/infinispan@1",
+		CacheStoreCredentials:          map[string][]byte{"books-store-1": []byte("changeme")},
+	}
+	version := credentialStoreVersion(configFiles)
+
+	// The version identifies the aliases, which include the version of the Cache CR credentials Secret
+	configFiles.CacheStoreCredentials["books-store-1"] = []byte("updated")
+	assert.Equal(t, version, credentialStoreVersion(configFiles))
+
+	configFiles.CacheStoreCredentials = map[string][]byte{"books-store-2": []byte("updated")}
+	assert.NotEqual(t, version, credentialStoreVersion(configFiles))
+}
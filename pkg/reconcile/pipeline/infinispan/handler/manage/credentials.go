@@ -0,0 +1,172 @@
+package manage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/hash"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/security"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	"github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan/handler/provision"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CredentialRotation applies the generated identities to every ready server of a cluster whose credentials can be
+// rotated, so that rotations do not restart the pods. The grace period of a rotation starts once the rotated passwords
+// have been applied to every pod, and the rotation completes once the grace period has ended and the previous passwords
+// have been revoked on every pod. Otherwise, the reconciliation is requeued for the next scheduled rotation.
+func CredentialRotation(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	if !i.IsCredentialRotationEnabled() || i.IsUpgradeCondition() || i.IsHotRodUpgrade() {
+		// The rotated passwords are only provisioned once an upgrade has completed
+		return
+	}
+
+	if !applyIdentities(i, ctx) {
+		return
+	}
+
+	if i.IsCredentialRotationStarted() {
+		gracePeriod := i.GetCredentialRotationGracePeriod()
+		gracePeriodEnd := metav1.NewTime(time.Now().Add(gracePeriod))
+		if err := ctx.UpdateInfinispan(func() {
+			rotation := i.Status.CredentialRotation
+			rotation.LastRotationTime = rotation.RotationStartTime
+			rotation.RotationStartTime = nil
+			rotation.GracePeriodEndTime = &gracePeriodEnd
+		}); err != nil {
+			return
+		}
+		msg := fmt.Sprintf("Generated credentials rotated. The previous passwords are revoked after %s", gracePeriodEnd.Format(time.RFC3339))
+		ctx.EventRecorder().Event(i, corev1.EventTypeNormal, "CredentialsRotated", msg)
+		ctx.RequeueEventually(gracePeriod)
+		return
+	}
+
+	if i.IsCredentialRotationInProgress() {
+		if ctx.ConfigFiles().CredentialRotation != nil {
+			// The previous identities are only replaced once the grace period has ended
+			remaining := time.Until(i.Status.CredentialRotation.GracePeriodEndTime.Time)
+			if remaining < 0 {
+				remaining = 0
+			}
+			ctx.RequeueEventually(remaining)
+			return
+		}
+
+		ctx.Log().Info("Credential rotation grace period ended, revoked previous passwords")
+		if err := ctx.UpdateInfinispan(func() {
+			i.Status.CredentialRotation.GracePeriodEndTime = nil
+		}); err != nil {
+			return
+		}
+		ctx.EventRecorder().Event(i, corev1.EventTypeNormal, "PreviousCredentialsRevoked", "The previous passwords of the generated credentials have been revoked")
+	}
+
+	if next := i.GetNextCredentialRotationTime(); next != nil {
+		if remaining := time.Until(*next); remaining > 0 {
+			ctx.RequeueEventually(remaining)
+		}
+	}
+}
+
+// applyIdentities executes the CLI batch that defines the generated identities and their previous passwords on every
+// ready server. The batch is streamed to the server container, so that the passwords are not passed as arguments, and
+// the version of the identities applied to a server container is recorded on its pod, so that the identities are only
+// applied again when they are updated or the container restarts. Returns true once every pod has the current identities
+func applyIdentities(i *ispnv1.Infinispan, ctx pipeline.Context) bool {
+	podList, err := ctx.InfinispanPods()
+	if err != nil {
+		return false
+	}
+
+	batch, err := identitiesCliBatch(i, ctx.ConfigFiles())
+	if err != nil {
+		ctx.Requeue(fmt.Errorf("unable to create identities batch: %w", err))
+		return false
+	}
+
+	applied := true
+	identitiesVersion := hash.HashString(batch)
+	for _, pod := range podList.Items {
+		containerID := serverContainerID(pod)
+		if containerID == "" || !kube.IsPodReady(pod) {
+			applied = false
+			continue
+		}
+
+		version := fmt.Sprintf("%s;%s", containerID, identitiesVersion)
+		if pod.Annotations[consts.IdentitiesAnnotation] == version {
+			continue
+		}
+
+		_, err := ctx.Kubernetes().ExecWithOptions(kube.ExecOptions{
+			Container: provision.InfinispanContainer,
+			Command:   []string{serverCliPath, "--file=-"},
+			Namespace: i.Namespace,
+			PodName:   pod.Name,
+			Stdin:     strings.NewReader(batch),
+		})
+		if err != nil {
+			ctx.Requeue(fmt.Errorf("unable to apply generated identities to pod '%s': %w", pod.Name, err))
+			return false
+		}
+
+		mutateFn := func() error {
+			if pod.CreationTimestamp.IsZero() {
+				return errors.NewNotFound(corev1.Resource(""), pod.Name)
+			}
+			if pod.Annotations == nil {
+				pod.Annotations = map[string]string{}
+			}
+			pod.Annotations[consts.IdentitiesAnnotation] = version
+			return nil
+		}
+		if _, err := ctx.Resources().CreateOrUpdate(&pod, false, mutateFn, pipeline.IgnoreNotFound, pipeline.RetryOnErr); err != nil {
+			return false
+		}
+		ctx.Log().Info("Applied generated identities", "pod", pod.Name)
+	}
+
+	if !applied {
+		// The pods that are not ready yet are updated on a subsequent reconciliation
+		ctx.RequeueEventually(0)
+	}
+	return applied
+}
+
+// identitiesCliBatch returns the CLI batch that replaces the admin identities, and the generated user identities, in
+// the properties files of the current and previous passwords
+func identitiesCliBatch(i *ispnv1.Infinispan, configFiles *pipeline.ConfigFiles) (string, error) {
+	type realmFiles struct {
+		identities []byte
+		realm      string
+		usersFile  string
+		groupsFile string
+	}
+	files := []realmFiles{
+		{configFiles.AdminIdentities.IdentitiesFile, "admin", "cli-admin-users.properties", "cli-admin-groups.properties"},
+		{configFiles.PreviousAdminIdentities(), "admin", "cli-admin-users-previous.properties", "cli-admin-groups-previous.properties"},
+	}
+	if i.IsAuthenticationEnabled() && provision.IsUserIdentitiesApplied(i) {
+		files = append(files,
+			realmFiles{configFiles.UserIdentities, "default", "cli-users.properties", "cli-groups.properties"},
+			realmFiles{configFiles.PreviousUserIdentities(), "default", "cli-users-previous.properties", "cli-groups-previous.properties"},
+		)
+	}
+
+	var batch strings.Builder
+	for _, f := range files {
+		cli, err := security.ReplaceIdentitiesCliFromSecret(f.identities, f.realm, f.usersFile, f.groupsFile)
+		if err != nil {
+			return "", err
+		}
+		batch.WriteString(cli)
+	}
+	return batch.String(), nil
+}
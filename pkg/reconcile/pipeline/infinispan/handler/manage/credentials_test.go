@@ -0,0 +1,126 @@
+package manage
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/security"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func newCredentialRotationContext(t *testing.T, configFiles *pipeline.ConfigFiles) (*pipeline.MockContext, *record.FakeRecorder) {
+	recorder := record.NewFakeRecorder(1)
+	ctx := pipeline.NewMockContext(gomock.NewController(t))
+	ctx.EXPECT().ConfigFiles().AnyTimes().Return(configFiles)
+	ctx.EXPECT().InfinispanPods().AnyTimes().Return(&corev1.PodList{}, nil)
+	ctx.EXPECT().EventRecorder().AnyTimes().Return(recorder)
+	ctx.EXPECT().Log().AnyTimes().Return(logr.Discard())
+	ctx.EXPECT().UpdateInfinispan(gomock.Any()).AnyTimes().DoAndReturn(func(updateFn func()) error {
+		updateFn()
+		return nil
+	})
+	return ctx, recorder
+}
+
+func newCredentialRotationConfigFiles(t *testing.T) *pipeline.ConfigFiles {
+	adminIdentities, err := security.CreateIdentitiesFor("operator", "password")
+	require.NoError(t, err)
+	return &pipeline.ConfigFiles{
+		AdminIdentities: &pipeline.AdminIdentities{IdentitiesFile: adminIdentities},
+	}
+}
+
+func newCredentialRotationInfinispan(status *ispnv1.CredentialRotationStatus) *ispnv1.Infinispan {
+	i := &ispnv1.Infinispan{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"},
+	}
+	i.Spec.Security.CredentialRotation = &ispnv1.CredentialRotationSpec{
+		GracePeriod: &metav1.Duration{Duration: time.Hour},
+	}
+	i.Status.CredentialRotation = status
+	return i
+}
+
+// TestCredentialRotationApplied tests that the grace period starts once the rotated passwords have been applied
+func TestCredentialRotationApplied(t *testing.T) {
+	start := metav1.NewTime(time.Now().Add(-time.Minute))
+	i := newCredentialRotationInfinispan(&ispnv1.CredentialRotationStatus{RotationStartTime: &start})
+	configFiles := newCredentialRotationConfigFiles(t)
+	configFiles.CredentialRotation = &pipeline.CredentialRotation{PreviousAdminIdentities: configFiles.AdminIdentities.IdentitiesFile}
+	ctx, recorder := newCredentialRotationContext(t, configFiles)
+	ctx.EXPECT().RequeueEventually(time.Hour)
+
+	CredentialRotation(i, ctx)
+
+	status := i.Status.CredentialRotation
+	assert.Nil(t, status.RotationStartTime)
+	assert.Equal(t, &start, status.LastRotationTime)
+	require.NotNil(t, status.GracePeriodEndTime)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), status.GracePeriodEndTime.Time, time.Minute)
+	assert.Contains(t, <-recorder.Events, "CredentialsRotated")
+}
+
+// TestCredentialRotationGracePeriodInProgress tests that the previous passwords are retained during the grace period
+func TestCredentialRotationGracePeriodInProgress(t *testing.T) {
+	gracePeriodEnd := metav1.NewTime(time.Now().Add(-time.Second))
+	i := newCredentialRotationInfinispan(&ispnv1.CredentialRotationStatus{GracePeriodEndTime: &gracePeriodEnd})
+	configFiles := newCredentialRotationConfigFiles(t)
+	// The previous identities were loaded before the grace period ended
+	configFiles.CredentialRotation = &pipeline.CredentialRotation{PreviousAdminIdentities: configFiles.AdminIdentities.IdentitiesFile}
+	ctx, _ := newCredentialRotationContext(t, configFiles)
+	ctx.EXPECT().RequeueEventually(time.Duration(0))
+
+	CredentialRotation(i, ctx)
+	assert.Equal(t, &gracePeriodEnd, i.Status.CredentialRotation.GracePeriodEndTime)
+}
+
+// TestCredentialRotationRevoked tests that the rotation completes once the previous passwords have been replaced
+func TestCredentialRotationRevoked(t *testing.T) {
+	last := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	gracePeriodEnd := metav1.NewTime(time.Now().Add(-time.Hour))
+	i := newCredentialRotationInfinispan(&ispnv1.CredentialRotationStatus{LastRotationTime: &last, GracePeriodEndTime: &gracePeriodEnd})
+	ctx, recorder := newCredentialRotationContext(t, newCredentialRotationConfigFiles(t))
+
+	CredentialRotation(i, ctx)
+
+	assert.False(t, i.IsCredentialRotationInProgress())
+	assert.Equal(t, &last, i.Status.CredentialRotation.LastRotationTime)
+	assert.Contains(t, <-recorder.Events, "PreviousCredentialsRevoked")
+}
+
+func TestIdentitiesCliBatch(t *testing.T) {
+	i := newCredentialRotationInfinispan(nil)
+	i.Spec.Security.EndpointSecretName = i.GenerateSecretName()
+	configFiles := newCredentialRotationConfigFiles(t)
+	userIdentities, err := security.CreateIdentitiesFor("developer", "password")
+	require.NoError(t, err)
+	previousUserIdentities, err := security.CreateIdentitiesFor("developer", "previous")
+	require.NoError(t, err)
+	configFiles.UserIdentities = userIdentities
+	configFiles.CredentialRotation = &pipeline.CredentialRotation{PreviousUserIdentities: previousUserIdentities}
+
+	batch, err := identitiesCliBatch(i, configFiles)
+	require.NoError(t, err)
+	assert.Contains(t, batch, security.CreateUserCli("operator", "password", []string{"admin", "controlRole"}, "admin", "cli-admin-users.properties", "cli-admin-groups.properties"))
+	// The current admin identities are used when the previous admin identities are not defined
+	assert.Contains(t, batch, security.CreateUserCli("operator", "password", []string{"admin", "controlRole"}, "admin", "cli-admin-users-previous.properties", "cli-admin-groups-previous.properties"))
+	assert.Contains(t, batch, security.CreateUserCli("developer", "password", []string{"admin", "controlRole"}, "default", "cli-users.properties", "cli-groups.properties"))
+	assert.Contains(t, batch, security.CreateUserCli("developer", "previous", []string{"admin", "controlRole"}, "default", "cli-users-previous.properties", "cli-groups-previous.properties"))
+	// Every user is removed before it is created, so that the batch can be applied to a running server
+	assert.Equal(t, strings.Count(batch, "user create"), strings.Count(batch, "user remove"))
+
+	// The user identities of a custom Secret are not applied
+	i.Spec.Security.EndpointSecretName = "custom"
+	batch, err = identitiesCliBatch(i, configFiles)
+	require.NoError(t, err)
+	assert.NotContains(t, batch, "developer")
+}
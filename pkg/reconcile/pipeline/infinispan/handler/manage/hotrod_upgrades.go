@@ -47,7 +47,16 @@ func subMapOf(f, s map[string]string) bool {
 func ScheduleHotRodRollingUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	log := hotRodRollingUpgradeLog(ctx.Log())
 
-	if i.IsHotRodUpgrade() || !UpgradeRequired(i, ctx) {
+	if i.IsHotRodUpgrade() {
+		return
+	}
+
+	if !UpgradeRequired(i, ctx) {
+		RemoveUpgradeCanaryState(i, ctx)
+		return
+	}
+
+	if i.IsUpgradeCanaryEnabled() && !UpgradeCanary(i, ctx) {
 		return
 	}
 
@@ -94,6 +103,14 @@ func HotRodRollingUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		currentStatefulSet: podList.Items[0].Labels[StatefulSetPodLabel],
 	}
 
+	if _, abort := i.Annotations[HotRodUpgradeAnnotationAbort]; abort {
+		if err := req.abort(); err != nil {
+			log.Error(err, "error encountered on abort")
+		}
+		ctx.RequeueEventually(0)
+		return
+	}
+
 	requestedOperand := ctx.Operand()
 	sourceOperand, _ := ctx.Operands().WithRef(upgradeStatus.SourceVersion)
 	// If an upgrade is in process, but the spec.Version is reverted to the original source version then rollback
@@ -123,6 +140,12 @@ func HotRodRollingUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
 
 func (r *HotRodRollingUpgradeRequest) handleMigration() error {
 	status := r.i.Status.HotRodRollingUpgradeStatus
+	if r.i.IsHotRodUpgradePausedBefore(status.Stage) {
+		if _, resume := r.i.Annotations[HotRodUpgradeAnnotationResume]; !resume {
+			return r.pause()
+		}
+	}
+
 	switch status.Stage {
 	case ispnv1.HotRodRollingStageStart:
 		r.log.Info(string(ispnv1.HotRodRollingStageStart))
@@ -214,17 +237,111 @@ func (r *HotRodRollingUpgradeRequest) redirectServices(statefulSet string) error
 			return err
 		}
 	}
+
+	for idx := range ispn.Spec.Endpoints {
+		e := &ispn.Spec.Endpoints[idx]
+		if e.IsExposed() && e.Expose.Type != ispnv1.ExposeTypeRoute {
+			if err := r.redirectServiceToStatefulSet(ispn.GetEndpointServiceExternalName(e), statefulSet); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
 // updateStage Updates the status of the Hot Rod Rolling Upgrade process
 func (r *HotRodRollingUpgradeRequest) updateStage(stage ispnv1.HotRodRollingUpgradeStage) error {
 	err := r.ctx.UpdateInfinispan(func() {
+		r.resumed()
 		r.i.Status.HotRodRollingUpgradeStatus.Stage = stage
 	})
 	return err
 }
 
+// resumed Removes the resume annotation once the stage it was added for has completed, so that a subsequent pause
+// requires a new approval
+func (r *HotRodRollingUpgradeRequest) resumed() {
+	delete(r.i.Annotations, HotRodUpgradeAnnotationResume)
+	r.i.Status.HotRodRollingUpgradeStatus.Paused = false
+}
+
+// pause Waits for the resume annotation before executing the current stage
+func (r *HotRodRollingUpgradeRequest) pause() error {
+	status := r.i.Status.HotRodRollingUpgradeStatus
+	if !status.Paused {
+		msg := fmt.Sprintf("Hot Rod Rolling Upgrade paused before %s. Add the '%s' annotation to resume", status.Stage, HotRodUpgradeAnnotationResume)
+		r.log.Info(msg)
+		r.ctx.EventRecorder().Event(r.i, corev1.EventTypeNormal, "HotRodRollingUpgradePaused", msg)
+		if err := r.ctx.UpdateInfinispan(func() {
+			r.i.Status.HotRodRollingUpgradeStatus.Paused = true
+		}); err != nil {
+			return err
+		}
+	}
+	r.ctx.RequeueEventually(DefaultWaitOnCluster)
+	return nil
+}
+
+// abort Reverts spec.version to the source version so that the upgrade is rolled back
+func (r *HotRodRollingUpgradeRequest) abort() error {
+	status := r.i.Status.HotRodRollingUpgradeStatus
+
+	var reason string
+	if status.SourceVersion == "" {
+		reason = "the source version is unknown"
+	} else if _, err := r.ctx.Operands().WithRef(status.SourceVersion); err != nil {
+		reason = fmt.Sprintf("the source version '%s' is no longer supported", status.SourceVersion)
+	} else if status.Stage == ispnv1.HotRodRollingStageCleanup {
+		reason = "the source cluster has already been replaced"
+	}
+
+	if reason != "" {
+		msg := fmt.Sprintf("Unable to abort Hot Rod Rolling Upgrade, %s", reason)
+		r.log.Info(msg)
+		r.ctx.EventRecorder().Event(r.i, corev1.EventTypeWarning, "HotRodRollingUpgradeAbortFailed", msg)
+	} else {
+		r.log.Info("Aborting Hot Rod Rolling Upgrade", "version", status.SourceVersion)
+	}
+
+	sourceVersion := status.SourceVersion
+	return r.ctx.UpdateInfinispan(func() {
+		delete(r.i.Annotations, HotRodUpgradeAnnotationAbort)
+		if reason == "" {
+			r.i.Spec.Version = sourceVersion
+		}
+	})
+}
+
+// updateCacheStatus Records the outcome of the latest attempt to migrate the entries of a cache
+func (r *HotRodRollingUpgradeRequest) updateCacheStatus(name string, entries int64, syncErr error) error {
+	return r.ctx.UpdateInfinispan(func() {
+		status := r.i.Status.HotRodRollingUpgradeStatus
+		idx := cacheStatusIndex(status.Caches, name)
+		if idx < 0 {
+			status.Caches = append(status.Caches, ispnv1.HotRodRollingUpgradeCacheStatus{Name: name})
+			idx = len(status.Caches) - 1
+		}
+		cache := &status.Caches[idx]
+		if syncErr != nil {
+			cache.State = ispnv1.HotRodRollingUpgradeCacheFailed
+			cache.Error = syncErr.Error()
+		} else {
+			cache.State = ispnv1.HotRodRollingUpgradeCacheSynced
+			cache.EntriesMigrated = entries
+			cache.Error = ""
+		}
+	})
+}
+
+func cacheStatusIndex(caches []ispnv1.HotRodRollingUpgradeCacheStatus, name string) int {
+	for idx := range caches {
+		if caches[idx].Name == name {
+			return idx
+		}
+	}
+	return -1
+}
+
 // generateNewStatefulSetName Derive a name for the new statefulSet.
 func generateNewStatefulSetName(ispn *ispnv1.Infinispan) string {
 	statefulSetName := ispn.GetStatefulSetName()
@@ -445,8 +562,24 @@ func (r *HotRodRollingUpgradeRequest) prepare() error {
 		return err
 	}
 
+	names, err := upgrades.CacheNames(sourceClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache names from the source cluster: %w", err)
+	}
+
 	// Move to next stage
-	return r.updateStage(ispnv1.HotRodRollingStageRedirect)
+	return ctx.UpdateInfinispan(func() {
+		status := r.i.Status.HotRodRollingUpgradeStatus
+		status.Caches = make([]ispnv1.HotRodRollingUpgradeCacheStatus, len(names))
+		for idx, name := range names {
+			status.Caches[idx] = ispnv1.HotRodRollingUpgradeCacheStatus{
+				Name:  name,
+				State: ispnv1.HotRodRollingUpgradeCacheConnected,
+			}
+		}
+		r.resumed()
+		status.Stage = ispnv1.HotRodRollingStageRedirect
+	})
 }
 
 // redirectService Redirects the user service to the new pods to avoid downtime when migrating data
@@ -480,8 +613,27 @@ func (r *HotRodRollingUpgradeRequest) syncData() error {
 	}
 	// Clone the source curl client as the credentials are the same, updating the pod to one from the target statefulset
 	targetClient := ctx.InfinispanClientForPod(podList.Items[0].Name)
-	if err = upgrades.SyncCaches(sourceClient, targetClient, r.log); err != nil {
-		return err
+
+	names, err := upgrades.CacheNames(sourceClient)
+	if err != nil {
+		return fmt.Errorf("failed to get cache names from the source cluster: %w", err)
+	}
+	r.log.Info(fmt.Sprintf("Cache names in the source cluster '%s'", names))
+
+	for _, name := range names {
+		// Skip caches migrated by a previous attempt, as their source has already been disconnected
+		caches := r.i.Status.HotRodRollingUpgradeStatus.Caches
+		if idx := cacheStatusIndex(caches, name); idx > -1 && caches[idx].State == ispnv1.HotRodRollingUpgradeCacheSynced {
+			continue
+		}
+
+		entries, syncErr := upgrades.SyncCache(targetClient, name, r.log)
+		if err := r.updateCacheStatus(name, entries, syncErr); err != nil {
+			return err
+		}
+		if syncErr != nil {
+			return syncErr
+		}
 	}
 
 	// Move to next stage
@@ -500,6 +652,7 @@ func (r *HotRodRollingUpgradeRequest) replaceStatefulSet() error {
 
 	// Change statefulSet reference in the Infinispan resource and move to next stage
 	return r.ctx.UpdateInfinispan(func() {
+		r.resumed()
 		ispn.Status.Operand.Phase = ispnv1.OperandPhaseRunning
 		ispn.Status.StatefulSetName = targetStatefulSetName
 		ispn.Status.HotRodRollingUpgradeStatus.Stage = ispnv1.HotRodRollingStageCleanup
@@ -549,6 +702,7 @@ func (r *HotRodRollingUpgradeRequest) rollback() error {
 	// Remove HotRodRollingUpgradeStatus status from the Infinispan CR
 	err := ctx.UpdateInfinispan(func() {
 		rollingUpgradeStatus := r.i.Status.HotRodRollingUpgradeStatus
+		delete(r.i.Annotations, HotRodUpgradeAnnotationResume)
 		r.i.Status.StatefulSetName = rollingUpgradeStatus.SourceStatefulSetName
 		sourceOperand, _ := ctx.Operands().WithRef(rollingUpgradeStatus.SourceVersion)
 		r.i.Status.Operand = OperandStatus(r.i, ispnv1.OperandPhaseRunning, sourceOperand)
@@ -14,6 +14,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func StatefulSetRollingUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
@@ -36,6 +37,7 @@ func StatefulSetRollingUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
 
 	updateNeeded := false
 	rollingUpgrade := true
+	tlsRotation := false
 
 	// Ensure the deployment size is the same as the spec
 	replicas := i.Spec.Replicas
@@ -127,6 +129,21 @@ func StatefulSetRollingUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		updateNeeded = true
 	}
 
+	if ports := provision.PodPortsWithXsite(i); !reflect.DeepEqual(container.Ports, ports) {
+		container.Ports = ports
+		updateNeeded = true
+	}
+
+	// The ServiceAccountName is defaulted by the API server, so we must ignore the "default" ServiceAccount when
+	// the pods do not require a dedicated ServiceAccount
+	if serviceAccount := provision.PodServiceAccountName(i); spec.ServiceAccountName != serviceAccount &&
+		!(serviceAccount == "" && spec.ServiceAccountName == "default") {
+		spec.ServiceAccountName = serviceAccount
+		// The deprecated field must also be cleared, otherwise it is used to populate an empty ServiceAccountName
+		spec.DeprecatedServiceAccount = serviceAccount
+		updateNeeded = true
+	}
+
 	if spec.PriorityClassName != i.PriorityClassName() {
 		spec.PriorityClassName = i.PriorityClassName()
 		updateNeeded = true
@@ -170,11 +187,13 @@ func StatefulSetRollingUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
 
 	if i.IsAuthenticationEnabled() {
 		if provision.AddVolumeForUserAuthentication(i, spec) {
-			container.Env = append(container.Env,
-				corev1.EnvVar{Name: "IDENTITIES_HASH", Value: hash.HashByte(configFiles.UserIdentities)},
-			)
+			if !provision.IsUserIdentitiesApplied(i) {
+				container.Env = append(container.Env,
+					corev1.EnvVar{Name: "IDENTITIES_HASH", Value: hash.HashByte(configFiles.UserIdentities)},
+				)
+			}
 			updateNeeded = true
-		} else {
+		} else if !provision.IsUserIdentitiesApplied(i) {
 			// Validate Secret changes (by the hash of the identities.yaml key value)
 			updateNeeded = updateStatefulSetEnv(container, statefulSet, "IDENTITIES_HASH", hash.HashByte(configFiles.UserIdentities)) || updateNeeded
 		}
@@ -189,12 +208,28 @@ func StatefulSetRollingUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
 			updateNeeded = updateStatefulSetEnv(container, statefulSet, "KEYSTORE_HASH", hash.HashByte(configFiles.Keystore.PemFile)+hash.HashByte(configFiles.Keystore.File)) || updateNeeded
 
 			if i.IsClientCertEnabled() {
-				updateNeeded = updateStatefulSetEnv(container, statefulSet, "TRUSTSTORE_HASH", hash.HashByte(configFiles.Truststore.File)) || updateNeeded
+				updateNeeded = updateStatefulSetEnv(container, statefulSet, "TRUSTSTORE_HASH", provision.TruststoreHash(configFiles.Truststore)) || updateNeeded
 			}
 		}
+
+		// Track the certificates in use so that renewals are rotated one pod at a time by the TLSRotation handler.
+		// The annotation is only applied to the StatefulSet metadata, so updating it does not restart any pods
+		tlsHash := hash.HashByte(configFiles.Keystore.PemFile) + hash.HashByte(configFiles.Keystore.File)
+		if i.IsClientCertEnabled() {
+			tlsHash += provision.TruststoreHash(configFiles.Truststore)
+		}
+		if previousHash := statefulSet.Annotations[TLSChecksumAnnotation]; previousHash != tlsHash {
+			if statefulSet.Annotations == nil {
+				statefulSet.Annotations = make(map[string]string)
+			}
+			statefulSet.Annotations[TLSChecksumAnnotation] = tlsHash
+			tlsRotation = previousHash != "" && replicas > 0
+			updateNeeded = true
+		}
 	}
 
 	updateNeeded = provision.AddXSiteTLSVolumes(ctx, i, statefulSet) || updateNeeded
+	updateNeeded = provision.AddJGroupsEncryptionVolume(i, spec) || updateNeeded
 
 	if updateNeeded {
 		log.Info("updateNeeded")
@@ -204,9 +239,28 @@ func StatefulSetRollingUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
 			labelsForPod[consts.StatefulSetPodLabel] = i.GetStatefulSetName()
 			statefulSet.Spec.Template.Labels = labelsForPod
 		}
+		if tlsRotation {
+			// The rotation must be recorded before the StatefulSet is partitioned, otherwise a failed status update would
+			// leave the StatefulSet partitioned without TLSRotation ever completing the rotation. If the StatefulSet
+			// update fails, TLSRotation completes the recorded rotation and the next reconciliation starts it again
+			if err := ctx.UpdateInfinispan(func() {
+				if i.Status.TLS == nil {
+					i.Status.TLS = &ispnv1.TLSStatus{}
+				}
+				i.Status.TLS.RotationStartTime = &metav1.Time{Time: time.Now()}
+			}); err != nil {
+				ctx.Requeue(err)
+				return
+			}
+			// Prevent the StatefulSet controller from updating any pods until TLSRotation has verified the cluster health
+			log.Info("TLS certificates updated, rotating pods")
+			partition := replicas
+			statefulSet.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition}
+		}
 		err := ctx.Resources().Update(statefulSet, pipeline.RetryOnErr)
 		if err != nil {
 			log.Error(err, "failed to update StatefulSet", "StatefulSet.Name", statefulSet.Name)
+			return
 		}
 	}
 }
@@ -0,0 +1,109 @@
+package manage
+
+import (
+	"fmt"
+	"time"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// TLSChecksumAnnotation the StatefulSet annotation containing the hash of the keystore and truststore in use
+const TLSChecksumAnnotation = "checksum/tls"
+
+// TLSRotation rolls the cluster pods one at a time after the TLS certificates have been updated, waiting for the
+// cluster to be HEALTHY before the next pod is updated. Servers which automatically reload certificates are not
+// restarted, instead the rotation completes once the updated Secrets have had time to propagate to the pods.
+func TLSRotation(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	if !i.IsTLSRotationInProgress() {
+		return
+	}
+
+	statefulSet := &appsv1.StatefulSet{}
+	if err := ctx.Resources().Load(i.GetStatefulSetName(), statefulSet, pipeline.RetryOnErr, pipeline.InvalidateCache); err != nil {
+		return
+	}
+
+	replicas := *statefulSet.Spec.Replicas
+	if replicas > 0 {
+		if healthy, err := clusterHealthy(ctx, replicas); err != nil {
+			ctx.Log().Error(err, "unable to retrieve cluster health during TLS rotation")
+			ctx.RequeueAfter(consts.DefaultTLSRotationInterval, nil)
+			return
+		} else if !healthy {
+			ctx.RequeueAfter(consts.DefaultTLSRotationInterval, nil)
+			return
+		}
+	}
+
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil && *rollingUpdate.Partition > 0 {
+		partition := *rollingUpdate.Partition
+		if partition > replicas {
+			// The cluster has been scaled down since the rotation started
+			partition = replicas
+		}
+		// Wait for the StatefulSet controller to update the pods above the current partition
+		if statefulSet.Status.ObservedGeneration < statefulSet.Generation || statefulSet.Status.UpdatedReplicas < replicas-partition {
+			ctx.RequeueAfter(consts.DefaultTLSRotationInterval, nil)
+			return
+		}
+		if partition > 0 {
+			partition--
+		}
+		ctx.Log().Info(fmt.Sprintf("Rotating TLS certificates of pod '%s-%d'", statefulSet.Name, partition))
+		rollingUpdate.Partition = &partition
+		if err := ctx.Resources().Update(statefulSet, pipeline.RetryOnErr); err != nil {
+			return
+		}
+		ctx.RequeueAfter(consts.DefaultTLSRotationInterval, nil)
+		return
+	}
+
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation || statefulSet.Status.CurrentRevision != statefulSet.Status.UpdateRevision {
+		ctx.RequeueAfter(consts.DefaultTLSRotationInterval, nil)
+		return
+	}
+
+	if ctx.Operand().UpstreamVersion.GTE(consts.MinVersionAutomaticCertificateReloading) {
+		// Allow the kubelet to update the mounted Secrets and the server to reload the certificates
+		if remaining := time.Until(i.Status.TLS.RotationStartTime.Add(consts.DefaultTLSReloadPeriod)); remaining > 0 {
+			ctx.RequeueAfter(remaining, nil)
+			return
+		}
+	}
+
+	if statefulSet.Spec.UpdateStrategy.RollingUpdate != nil {
+		statefulSet.Spec.UpdateStrategy.RollingUpdate = nil
+		if err := ctx.Resources().Update(statefulSet, pipeline.RetryOnErr); err != nil {
+			return
+		}
+	}
+	ctx.Log().Info("TLS certificate rotation complete")
+	_ = ctx.UpdateInfinispan(func() {
+		i.Status.TLS.RotationStartTime = nil
+	})
+}
+
+// clusterHealthy returns true if all of the expected pods are ready and the cluster health is HEALTHY
+func clusterHealthy(ctx pipeline.Context, replicas int32) (bool, error) {
+	podList, err := ctx.InfinispanPods()
+	if err != nil {
+		return false, err
+	}
+	if int32(len(podList.Items)) != replicas || !kube.AreAllPodsReady(podList) {
+		return false, nil
+	}
+	ispnClient, err := ctx.InfinispanClient()
+	if err != nil {
+		return false, err
+	}
+	health, err := ispnClient.Container().HealthStatus()
+	if err != nil {
+		return false, err
+	}
+	return health == api.HealthStatusHealth, nil
+}
@@ -0,0 +1,414 @@
+package manage
+
+import (
+	"fmt"
+	"time"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/hash"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	config "github.com/infinispan/infinispan-operator/pkg/infinispan/configuration/server"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	"github.com/infinispan/infinispan-operator/pkg/mime"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	"github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan/handler/provision"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	upgradeCanaryPodApp    = "infinispan-canary-pod"
+	upgradeCanaryCacheName = "upgrade-canary"
+	upgradeCanaryCacheKey  = "canary"
+)
+
+var upgradeCanaryConditions = []ispnv1.ConditionType{
+	ispnv1.ConditionCanaryHealthCheckPassed,
+	ispnv1.ConditionCanaryCacheCheckPassed,
+	ispnv1.ConditionCanaryMetricsCheckPassed,
+}
+
+// upgradeCanaryCheck returns a non-empty reason if the canary pod does not behave as expected
+type upgradeCanaryCheck func(ispnClient api.Infinispan) (string, error)
+
+// UpgradeCanary returns true once the target Operand of an upgrade has been verified on a canary pod. The canary
+// StatefulSet is created with a single pod running the target Operand, isolated from the existing cluster, and the
+// result of each check is recorded as a condition. If the checks do not pass within spec.upgrades.canary.timeout the
+// upgrade is blocked until the target Operand is changed or the infinispan.org/upgrade-canary-retry annotation is added
+func UpgradeCanary(i *ispnv1.Infinispan, ctx pipeline.Context) bool {
+	image := OperandStatus(i, ispnv1.OperandPhasePending, ctx.Operand()).Image
+	canary := i.Status.UpgradeCanary
+
+	_, retry := i.Annotations[consts.UpgradeCanaryAnnotationRetry]
+	if canary == nil || canary.Image != image || retry {
+		if !removeUpgradeCanaryResources(i, ctx) {
+			return false
+		}
+		ctx.Log().Info("Starting upgrade canary verification", "image", image)
+		ctx.Requeue(
+			ctx.UpdateInfinispan(func() {
+				delete(i.Annotations, consts.UpgradeCanaryAnnotationRetry)
+				for _, condition := range upgradeCanaryConditions {
+					i.RemoveCondition(condition)
+				}
+				i.Status.UpgradeCanary = &ispnv1.UpgradeCanaryStatus{
+					Phase:     ispnv1.UpgradeCanaryPhaseVerifying,
+					Image:     image,
+					StartTime: &metav1.Time{Time: time.Now()},
+				}
+			}),
+		)
+		return false
+	}
+
+	switch canary.Phase {
+	case ispnv1.UpgradeCanaryPhaseSucceeded:
+		return true
+	case ispnv1.UpgradeCanaryPhaseFailed:
+		return false
+	}
+
+	pod, err := reconcileUpgradeCanaryStatefulSet(i, ctx)
+	if err != nil {
+		ctx.Requeue(fmt.Errorf("unable to reconcile upgrade canary: %w", err))
+		return false
+	}
+
+	podName := upgradeCanaryPodName(i)
+	if pod != nil {
+		if _, reason := upgradeCanaryImagePulled(pod); reason != "" {
+			failUpgradeCanary(i, ctx, reason)
+			return false
+		}
+	}
+
+	timeout := i.Spec.Upgrades.Canary.Timeout.Duration
+	timedOut := time.Since(canary.StartTime.Time) >= timeout
+	if pod == nil || !kube.IsPodReady(*pod) {
+		if timedOut {
+			failUpgradeCanary(i, ctx, fmt.Sprintf("Canary pod '%s' not ready within %s", podName, timeout))
+		} else {
+			ctx.RequeueAfter(consts.DefaultUpgradeCanaryInterval, nil)
+		}
+		return false
+	}
+
+	ispnClient := ctx.InfinispanClientForPod(podName)
+	checks := map[ispnv1.ConditionType]upgradeCanaryCheck{
+		ispnv1.ConditionCanaryHealthCheckPassed:  upgradeCanaryHealth,
+		ispnv1.ConditionCanaryCacheCheckPassed:   upgradeCanaryCache,
+		ispnv1.ConditionCanaryMetricsCheckPassed: upgradeCanaryMetrics,
+	}
+	reasons := make(map[ispnv1.ConditionType]string, len(checks))
+	passed := true
+	for _, condition := range upgradeCanaryConditions {
+		reason, err := checks[condition](ispnClient)
+		if err != nil {
+			reason = err.Error()
+		}
+		reasons[condition] = reason
+		passed = passed && reason == ""
+	}
+
+	err = ctx.UpdateInfinispan(func() {
+		for _, condition := range upgradeCanaryConditions {
+			if reason := reasons[condition]; reason == "" {
+				i.SetCondition(condition, metav1.ConditionTrue, "")
+			} else {
+				i.SetCondition(condition, metav1.ConditionFalse, reason)
+			}
+		}
+	})
+	if err != nil {
+		ctx.Requeue(err)
+		return false
+	}
+
+	if passed {
+		if !removeUpgradeCanaryResources(i, ctx) {
+			return false
+		}
+		msg := fmt.Sprintf("Canary verification of image '%s' succeeded", image)
+		ctx.Log().Info(msg)
+		ctx.EventRecorder().Event(i, corev1.EventTypeNormal, "UpgradeCanarySucceeded", msg)
+		ctx.Requeue(
+			ctx.UpdateInfinispan(func() {
+				i.Status.UpgradeCanary.Phase = ispnv1.UpgradeCanaryPhaseSucceeded
+				i.Status.UpgradeCanary.Message = msg
+			}),
+		)
+	} else if timedOut {
+		failUpgradeCanary(i, ctx, fmt.Sprintf("Canary checks did not pass within %s", timeout))
+	} else {
+		ctx.RequeueAfter(consts.DefaultUpgradeCanaryInterval, nil)
+	}
+	return false
+}
+
+// RemoveUpgradeCanaryState removes the canary resources and status of a verification that is no longer required
+// because the upgrade has been reverted
+func RemoveUpgradeCanaryState(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	canary := i.Status.UpgradeCanary
+	if canary == nil || canary.Phase == ispnv1.UpgradeCanaryPhaseSucceeded {
+		return
+	}
+
+	if !removeUpgradeCanaryResources(i, ctx) {
+		return
+	}
+	ctx.Requeue(
+		ctx.UpdateInfinispan(func() {
+			for _, condition := range upgradeCanaryConditions {
+				i.RemoveCondition(condition)
+			}
+			i.Status.UpgradeCanary = nil
+		}),
+	)
+}
+
+func failUpgradeCanary(i *ispnv1.Infinispan, ctx pipeline.Context, msg string) {
+	if !removeUpgradeCanaryResources(i, ctx) {
+		return
+	}
+	ctx.Log().Info("Upgrade blocked by canary verification", "reason", msg)
+	ctx.EventRecorder().Event(i, corev1.EventTypeWarning, "UpgradeCanaryFailed", msg)
+	ctx.Requeue(
+		ctx.UpdateInfinispan(func() {
+			i.Status.UpgradeCanary.Phase = ispnv1.UpgradeCanaryPhaseFailed
+			i.Status.UpgradeCanary.Message = msg
+		}),
+	)
+}
+
+func upgradeCanaryPodName(i *ispnv1.Infinispan) string {
+	return i.GetUpgradeCanaryStatefulSetName() + "-0"
+}
+
+// reconcileUpgradeCanaryStatefulSet creates the canary StatefulSet if it does not exist and returns the canary pod, or
+// nil if the pod has not been created yet. A StatefulSet created for a different image is removed
+func reconcileUpgradeCanaryStatefulSet(i *ispnv1.Infinispan, ctx pipeline.Context) (*corev1.Pod, error) {
+	name := i.GetUpgradeCanaryStatefulSetName()
+
+	existing := &appsv1.StatefulSet{}
+	if err := ctx.Resources().Load(name, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		configHash, err := reconcileUpgradeCanaryConfigMap(i, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		statefulSet, err := upgradeCanaryStatefulSet(i, ctx, configHash)
+		if err != nil {
+			return nil, err
+		}
+		if err := ctx.Resources().Create(statefulSet, true); err != nil {
+			return nil, fmt.Errorf("unable to create canary StatefulSet '%s': %w", name, err)
+		}
+		return nil, nil
+	}
+
+	if container := kube.GetContainer(provision.InfinispanContainer, &existing.Spec.Template.Spec); container == nil || container.Image != i.ImageName() {
+		// The target image has changed since the StatefulSet was created
+		if !removeUpgradeCanaryResources(i, ctx) {
+			return nil, fmt.Errorf("unable to remove canary StatefulSet '%s'", name)
+		}
+		return nil, nil
+	}
+
+	pod := &corev1.Pod{}
+	if err := ctx.Resources().Load(upgradeCanaryPodName(i), pod, pipeline.InvalidateCache); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pod, nil
+}
+
+// upgradeCanaryImagePulled returns true if the image of the canary pod has been pulled, or the reason that the image
+// can't be pulled
+func upgradeCanaryImagePulled(pod *corev1.Pod) (bool, string) {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != provision.InfinispanContainer {
+			continue
+		}
+		if status.ImageID != "" {
+			return true, ""
+		}
+		if waiting := status.State.Waiting; waiting != nil {
+			switch waiting.Reason {
+			case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+				return false, fmt.Sprintf("Unable to pull image '%s': %s", status.Image, waiting.Message)
+			}
+		}
+	}
+	return false, ""
+}
+
+// reconcileUpgradeCanaryConfigMap creates the server configuration of the canary pod for the target Operand. The
+// configuration uses a DNS query that matches no other pods, so the canary pod never joins the existing cluster
+func reconcileUpgradeCanaryConfigMap(i *ispnv1.Infinispan, ctx pipeline.Context) (string, error) {
+	name := i.GetUpgradeCanaryStatefulSetName()
+	configFiles := ctx.ConfigFiles()
+	configSpec := configFiles.ConfigSpec
+	configSpec.StatefulSetName = name
+	configSpec.JGroups.Discovery = "DNS"
+	configSpec.JGroups.StaticHosts = nil
+	configSpec.JGroups.PodLabel = ""
+	configSpec.XSite = nil
+
+	operand := ctx.Operand()
+	baseCfg, adminCfg, err := config.Generate(operand, &configSpec)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate infinispan.xml for upgrade canary: %w", err)
+	}
+
+	zeroConfig, err := config.GenerateZeroCapacity(operand, &configSpec)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate infinispan-zero.xml for upgrade canary: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-configuration", name),
+			Namespace: i.Namespace,
+		},
+	}
+	if _, err := ctx.Resources().CreateOrUpdate(cm, true, func() error {
+		provision.PopulateServerConfigMap(baseCfg, adminCfg, zeroConfig, configFiles.Log4j, cm)
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("unable to create canary ConfigMap: %w", err)
+	}
+	return hash.HashString(baseCfg, adminCfg), nil
+}
+
+// upgradeCanaryStatefulSet returns a single pod StatefulSet for the target Operand. The pods are not selected by the
+// cluster services and store their data on an emptyDir volume, so that the existing cluster is not affected
+func upgradeCanaryStatefulSet(i *ispnv1.Infinispan, ctx pipeline.Context, configHash string) (*appsv1.StatefulSet, error) {
+	name := i.GetUpgradeCanaryStatefulSetName()
+	statefulSet, err := provision.ClusterStatefulSetSpec(name, i, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create canary StatefulSet spec: %w", err)
+	}
+
+	statefulSet.Spec.Replicas = pointer.Int32(1)
+	statefulSet.Spec.Selector.MatchLabels["app"] = upgradeCanaryPodApp
+	statefulSet.Spec.Template.Labels["app"] = upgradeCanaryPodApp
+
+	spec := &statefulSet.Spec.Template.Spec
+	if statefulSet.Spec.VolumeClaimTemplates != nil {
+		statefulSet.Spec.VolumeClaimTemplates = nil
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: provision.DataMountVolume,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+
+	for _, volume := range spec.Volumes {
+		if volume.Name == provision.ConfigVolumeName {
+			volume.ConfigMap.Name = fmt.Sprintf("%s-configuration", name)
+		}
+	}
+
+	container := kube.GetContainer(provision.InfinispanContainer, spec)
+	container.Image = i.ImageName()
+	// The canary pod only serves the checks, so it does not require the resources of the cluster pods
+	container.Resources = corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    consts.DefaultUpgradeCanaryCPU,
+			corev1.ResourceMemory: consts.DefaultUpgradeCanaryMemory,
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: consts.DefaultUpgradeCanaryMemory,
+		},
+	}
+	for o, envVar := range container.Env {
+		if envVar.Name == "CONFIG_HASH" {
+			container.Env[o].Value = configHash
+		}
+	}
+	return statefulSet, nil
+}
+
+// removeUpgradeCanaryResources deletes the canary StatefulSet and ConfigMap, returning false if the deletion failed
+func removeUpgradeCanaryResources(i *ispnv1.Infinispan, ctx pipeline.Context) bool {
+	name := i.GetUpgradeCanaryStatefulSetName()
+	if err := ctx.Resources().Delete(name, &appsv1.StatefulSet{}, pipeline.RetryOnErr, pipeline.IgnoreNotFound); err != nil {
+		return false
+	}
+	if err := ctx.Resources().Delete(fmt.Sprintf("%s-configuration", name), &corev1.ConfigMap{}, pipeline.RetryOnErr, pipeline.IgnoreNotFound); err != nil {
+		return false
+	}
+	return true
+}
+
+// upgradeCanaryHealth ensures that the server of the canary pod reports a healthy state
+func upgradeCanaryHealth(ispnClient api.Infinispan) (string, error) {
+	health, err := ispnClient.Container().HealthStatus()
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve canary health: %w", err)
+	}
+	if health != api.HealthStatusHealth {
+		return fmt.Sprintf("The canary health is %s, expected %s", health, api.HealthStatusHealth), nil
+	}
+	return "", nil
+}
+
+// upgradeCanaryCache ensures that a cache can be created on the canary pod and that an entry written to it can be read
+func upgradeCanaryCache(ispnClient api.Infinispan) (string, error) {
+	cache := ispnClient.Cache(upgradeCanaryCacheName)
+	// Remove any cache left behind by a previous attempt
+	if err := cache.Delete(); err != nil {
+		return "", fmt.Errorf("unable to remove canary cache: %w", err)
+	}
+
+	config := `{"local-cache":{"encoding":{"media-type":"text/plain"}}}`
+	if err := cache.Create(config, mime.ApplicationJson, "VOLATILE"); err != nil {
+		return "", fmt.Errorf("unable to create canary cache: %w", err)
+	}
+
+	value := time.Now().String()
+	if err := cache.Put(upgradeCanaryCacheKey, value, mime.TextPlain); err != nil {
+		return "", fmt.Errorf("unable to write entry to canary cache: %w", err)
+	}
+
+	read, exists, err := cache.Get(upgradeCanaryCacheKey)
+	if err != nil {
+		return "", fmt.Errorf("unable to read entry from canary cache: %w", err)
+	}
+	if !exists || read != value {
+		return fmt.Sprintf("Entry read from canary cache was '%s', expected '%s'", read, value), nil
+	}
+
+	if err := cache.Delete(); err != nil {
+		return "", fmt.Errorf("unable to remove canary cache: %w", err)
+	}
+	return "", nil
+}
+
+// upgradeCanaryMetrics ensures that the canary pod exposes JVM memory metrics with valid values
+func upgradeCanaryMetrics(ispnClient api.Infinispan) (string, error) {
+	buf, err := ispnClient.Metrics().Get("")
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve canary metrics: %w", err)
+	}
+
+	m, err := parseMemoryMetrics(buf)
+	if err != nil {
+		return fmt.Sprintf("Invalid canary metrics: %s", err), nil
+	}
+	if m.heapUsed <= 0 || m.heapUsed > m.heapMax {
+		return fmt.Sprintf("Invalid canary heap metrics, used %.0f bytes of %.0f", m.heapUsed, m.heapMax), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,229 @@
+package manage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/configuration/container"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	"github.com/infinispan/infinispan-operator/pkg/mime"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	"github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan/handler/provision"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// upgradePreflightCheck returns a non-empty reason if the cluster must not be shutdown for the upgrade
+type upgradePreflightCheck func(i *ispnv1.Infinispan, ctx pipeline.Context, pods *corev1.PodList) (string, error)
+
+// UpgradePreflightChecks returns true once all the checks required before the cluster is shutdown for a Shutdown
+// upgrade have passed. If a check fails, ConditionUpgradePreflightChecksPassed=false is set with the reason and the
+// checks are retried after DefaultUpgradePreflightInterval
+func UpgradePreflightChecks(i *ispnv1.Infinispan, ctx pipeline.Context) bool {
+	pods, err := ctx.InfinispanPods()
+	if err != nil {
+		return false
+	}
+
+	checks := []upgradePreflightCheck{
+		preflightClusterHealth,
+		preflightCachePersistence,
+		preflightStorage,
+		preflightImagePull,
+	}
+	for _, check := range checks {
+		reason, err := check(i, ctx, pods)
+		if err != nil {
+			ctx.Requeue(fmt.Errorf("unable to perform upgrade preflight checks: %w", err))
+			return false
+		}
+		if reason != "" {
+			ctx.Log().Info("Upgrade blocked by preflight check", "reason", reason)
+			ctx.RequeueAfter(consts.DefaultUpgradePreflightInterval,
+				ctx.UpdateInfinispan(func() {
+					i.SetCondition(ispnv1.ConditionUpgradePreflightChecksPassed, metav1.ConditionFalse, reason)
+				}),
+			)
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveUpgradePreflightState removes the canary resources and condition of preflight checks that are no longer required
+// because the upgrade that they blocked has been reverted
+func RemoveUpgradePreflightState(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	if !i.IsConditionFalse(ispnv1.ConditionUpgradePreflightChecksPassed) || !i.HasCondition(ispnv1.ConditionUpgradePreflightChecksPassed) {
+		return
+	}
+
+	if !removeUpgradeCanaryResources(i, ctx) {
+		return
+	}
+	ctx.Requeue(
+		ctx.UpdateInfinispan(func() {
+			i.RemoveCondition(ispnv1.ConditionUpgradePreflightChecksPassed)
+		}),
+	)
+}
+
+func preflightClient(ctx pipeline.Context, pods *corev1.PodList) (api.Infinispan, error) {
+	for _, pod := range pods.Items {
+		if kube.IsPodReady(pod) {
+			return ctx.InfinispanClientUnknownVersion(pod.Name)
+		}
+	}
+	return nil, fmt.Errorf("no ready Infinispan pods")
+}
+
+// preflightClusterHealth ensures that the cluster is WellFormed and that the server reports a healthy state
+func preflightClusterHealth(i *ispnv1.Infinispan, ctx pipeline.Context, pods *corev1.PodList) (string, error) {
+	if !i.IsWellFormed() || len(pods.Items) != int(i.Spec.Replicas) {
+		return "The cluster is not WellFormed", nil
+	}
+
+	ispnClient, err := preflightClient(ctx, pods)
+	if err != nil {
+		return "", err
+	}
+
+	health, err := ispnClient.Container().HealthStatus()
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve cluster health: %w", err)
+	}
+	if health != api.HealthStatusHealth {
+		return fmt.Sprintf("The cluster health is %s, expected %s", health, api.HealthStatusHealth), nil
+	}
+	return "", nil
+}
+
+// preflightCachePersistence ensures that every cache either has a cache store or has been declared as volatile, so
+// that no data is lost unintentionally when the cluster is shutdown
+func preflightCachePersistence(i *ispnv1.Infinispan, ctx pipeline.Context, pods *corev1.PodList) (string, error) {
+	ispnClient, err := preflightClient(ctx, pods)
+	if err != nil {
+		return "", err
+	}
+
+	names, err := ispnClient.Caches().Names()
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve cache names: %w", err)
+	}
+
+	volatile := make(map[string]bool, len(i.Spec.Upgrades.Preflight.VolatileCaches))
+	for _, name := range i.Spec.Upgrades.Preflight.VolatileCaches {
+		volatile[name] = true
+	}
+
+	var unpersisted []string
+	for _, name := range names {
+		if volatile[name] || strings.HasPrefix(name, "___") {
+			continue
+		}
+		config, err := ispnClient.Cache(name).Config(mime.ApplicationJson)
+		if err != nil {
+			return "", fmt.Errorf("unable to retrieve configuration of cache '%s': %w", name, err)
+		}
+		persistent, err := container.HasPersistence(config)
+		if err != nil {
+			return "", err
+		}
+		if !persistent {
+			unpersisted = append(unpersisted, name)
+		}
+	}
+
+	if len(unpersisted) > 0 {
+		return fmt.Sprintf("Caches %v have no cache store and are not declared in spec.upgrades.preflight.volatileCaches", unpersisted), nil
+	}
+	return "", nil
+}
+
+// preflightStorage ensures that the data volume of each pod has enough free space for the state persisted on shutdown
+func preflightStorage(i *ispnv1.Infinispan, ctx pipeline.Context, pods *corev1.PodList) (string, error) {
+	minFree := int64(consts.DefaultUpgradePreflightMinFreeStoragePercent)
+	if i.Spec.Upgrades.Preflight.MinFreeStoragePercent != nil {
+		minFree = int64(*i.Spec.Upgrades.Preflight.MinFreeStoragePercent)
+	}
+
+	for _, pod := range pods.Items {
+		out, err := ctx.Kubernetes().ExecWithOptions(kube.ExecOptions{
+			Container: provision.InfinispanContainer,
+			Command:   []string{"df", "-P", provision.DataMountPath},
+			Namespace: i.Namespace,
+			PodName:   pod.Name,
+		})
+		if err != nil {
+			return "", fmt.Errorf("unable to retrieve data volume usage of pod '%s': %w", pod.Name, err)
+		}
+
+		free, err := freeStoragePercent(out.String())
+		if err != nil {
+			return "", fmt.Errorf("unable to parse data volume usage of pod '%s': %w", pod.Name, err)
+		}
+		if free < minFree {
+			return fmt.Sprintf("The data volume of pod '%s' has %d%% free space, %d%% required", pod.Name, free, minFree), nil
+		}
+	}
+	return "", nil
+}
+
+// freeStoragePercent returns the percentage of free space reported by the POSIX output of df
+func freeStoragePercent(df string) (int64, error) {
+	lines := strings.Split(strings.TrimSpace(df), "\n")
+	if len(lines) < 2 {
+		return 0, fmt.Errorf("unexpected df output '%s'", df)
+	}
+
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 4 {
+		return 0, fmt.Errorf("unexpected df output '%s'", df)
+	}
+
+	total, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	available, err := strconv.ParseInt(fields[3], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if total == 0 {
+		return 0, fmt.Errorf("data volume has no capacity")
+	}
+	return available * 100 / total, nil
+}
+
+// preflightImagePull ensures that the image of the target Operand can be pulled by the upgrade canary pod. The canary
+// resources are removed once the image has been pulled. If canary verification is enabled, the image is pulled by the
+// verification instead, which fails if the image can't be pulled
+func preflightImagePull(i *ispnv1.Infinispan, ctx pipeline.Context, _ *corev1.PodList) (string, error) {
+	if i.IsUpgradeCanaryEnabled() {
+		return "", nil
+	}
+
+	image := i.ImageName()
+	pod, err := reconcileUpgradeCanaryStatefulSet(i, ctx)
+	if err != nil {
+		return "", err
+	}
+	if pod == nil {
+		return fmt.Sprintf("Waiting for pod '%s' to pull image '%s'", upgradeCanaryPodName(i), image), nil
+	}
+
+	pulled, reason := upgradeCanaryImagePulled(pod)
+	if pulled {
+		if !removeUpgradeCanaryResources(i, ctx) {
+			return "", fmt.Errorf("unable to remove canary StatefulSet '%s'", i.GetUpgradeCanaryStatefulSetName())
+		}
+		return "", nil
+	}
+	if reason != "" {
+		return reason, nil
+	}
+	return fmt.Sprintf("Waiting for pod '%s' to pull image '%s'", pod.Name, image), nil
+}
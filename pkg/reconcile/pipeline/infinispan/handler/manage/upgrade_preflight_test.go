@@ -0,0 +1,25 @@
+package manage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreeStoragePercent(t *testing.T) {
+	df := `Filesystem     1024-blocks    Used Available Capacity Mounted on
+/dev/rbd0          1020588  816470    204118      80% /opt/infinispan/server/data
+`
+	free, err := freeStoragePercent(df)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), free)
+}
+
+func TestFreeStoragePercentInvalidOutput(t *testing.T) {
+	_, err := freeStoragePercent("df: /opt/infinispan/server/data: No such file or directory\n")
+	assert.Error(t, err)
+
+	_, err = freeStoragePercent("Filesystem 1024-blocks Used Available Capacity Mounted on\n/dev/rbd0 0 0 0 - /data\n")
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
 	consts "github.com/infinispan/infinispan-operator/controllers/constants"
@@ -58,14 +59,34 @@ func ScheduleGracefulShutdownUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context)
 	}
 
 	if UpgradeRequired(i, ctx) {
+		if i.IsUpgradePreflightEnabled() && !UpgradePreflightChecks(i, ctx) {
+			return
+		}
+
+		if i.IsUpgradeCanaryEnabled() && !UpgradeCanary(i, ctx) {
+			return
+		}
+
 		ctx.Log().Info("schedule an Infinispan cluster upgrade", "current version", i.Status.Operand.Version, "desired version", ctx.Operand().Ref())
 		ctx.Requeue(
 			ctx.UpdateInfinispan(func() {
+				if i.IsUpgradePreflightEnabled() {
+					i.SetCondition(ispnv1.ConditionUpgradePreflightChecksPassed, metav1.ConditionTrue, "")
+				}
 				i.SetCondition(ispnv1.ConditionUpgrade, metav1.ConditionTrue, "")
 				i.Spec.Replicas = 0
+				i.Status.GracefulShutdownUpgrade = &ispnv1.GracefulShutdownUpgradeStatus{
+					Phase:  ispnv1.GracefulShutdownUpgradePhaseUpgrading,
+					Source: i.Status.Operand,
+				}
 				i.Status.Operand = OperandStatus(i, ispnv1.OperandPhasePending, ctx.Operand())
 			}),
 		)
+	} else {
+		if i.IsUpgradePreflightEnabled() {
+			RemoveUpgradePreflightState(i, ctx)
+		}
+		RemoveUpgradeCanaryState(i, ctx)
 	}
 }
 
@@ -261,10 +282,108 @@ func GracefulShutdownUpgrade(i *ispnv1.Infinispan, ctx pipeline.Context) {
 				// Persist replicas needed at restart
 				i.Spec.Replicas = i.Status.ReplicasWantedAtRestart
 				i.SetCondition(ispnv1.ConditionUpgrade, metav1.ConditionFalse, "")
+				if i.Status.GracefulShutdownUpgrade != nil {
+					now := metav1.Now()
+					i.Status.GracefulShutdownUpgrade.StartTime = &now
+				}
 			}))
 	}
 }
 
+// GracefulShutdownUpgradeRollback restores the source Operand of a GracefulShutdown upgrade if the upgraded cluster
+// does not become WellFormed within spec.upgrades.rollback.timeout
+// 1. Once the target Operand is Running, the upgrade is complete and status.gracefulShutdownUpgrade is removed
+// 2. If the timeout is exceeded, set status.gracefulShutdownUpgrade.phase=RollingBack
+// 3. Restore spec.version and spec.image of the source Operand and then remove the resources of the target Operand
+func GracefulShutdownUpgradeRollback(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	upgrade := i.Status.GracefulShutdownUpgrade
+	if upgrade == nil || upgrade.Phase == ispnv1.GracefulShutdownUpgradePhaseRolledBack || i.IsUpgradeCondition() {
+		return
+	}
+
+	logger := ctx.Log()
+	if upgrade.Phase == ispnv1.GracefulShutdownUpgradePhaseUpgrading {
+		if i.Status.Operand.Phase == ispnv1.OperandPhaseRunning {
+			logger.Info("GracefulShutdown upgrade complete", "version", i.Status.Operand.Version)
+			ctx.Requeue(
+				ctx.UpdateInfinispan(func() {
+					i.Status.GracefulShutdownUpgrade = nil
+				}),
+			)
+			return
+		}
+
+		if !i.IsUpgradeRollbackEnabled() || upgrade.StartTime == nil {
+			return
+		}
+
+		timeout := i.Spec.Upgrades.Rollback.Timeout.Duration
+		if time.Since(upgrade.StartTime.Time) < timeout {
+			return
+		}
+
+		// The source version is not known if the cluster was created by an older Operator version
+		_, err := ctx.Operands().WithRef(upgrade.Source.Version)
+		rollback := upgrade.Source.Version != "" && err == nil
+
+		var msg string
+		if rollback {
+			msg = fmt.Sprintf("Cluster not WellFormed within %s of upgrade to '%s'. Rolling back to '%s'", timeout, i.Status.Operand.Version, upgrade.Source.Version)
+		} else {
+			msg = fmt.Sprintf("Cluster not WellFormed within %s of upgrade to '%s'. Unable to rollback to unsupported version '%s'", timeout, i.Status.Operand.Version, upgrade.Source.Version)
+		}
+
+		if upgrade.Message != msg {
+			logger.Info(msg)
+			ctx.EventRecorder().Event(i, corev1.EventTypeWarning, "UpgradeRollback", msg)
+			ctx.Requeue(
+				ctx.UpdateInfinispan(func() {
+					i.Status.GracefulShutdownUpgrade.Message = msg
+					if rollback {
+						i.Status.GracefulShutdownUpgrade.Phase = ispnv1.GracefulShutdownUpgradePhaseRollingBack
+					}
+				}),
+			)
+		}
+		return
+	}
+
+	// The source Operand is restored before the resources of the failed upgrade are removed, so that any resources
+	// recreated by a subsequent reconciliation use the source Operand
+	source := upgrade.Source
+	if i.Status.Operand.Version != source.Version || i.Status.Operand.Image != source.Image {
+		logger.Info("Restoring the source Operand of the failed upgrade", "version", source.Version, "failed version", i.Status.Operand.Version)
+		err := ctx.UpdateInfinispan(func() {
+			i.Spec.Version = source.Version
+			if source.CustomImage {
+				i.Spec.Image = pointer.String(source.Image)
+			} else {
+				i.Spec.Image = nil
+			}
+			i.Status.Operand = source
+			i.Status.Operand.Phase = ispnv1.OperandPhasePending
+		})
+		if err != nil {
+			ctx.Requeue(err)
+			return
+		}
+	}
+
+	// The target Operand never formed a cluster, so there's no state to preserve with a GracefulShutdown
+	logger.Info("Removing Infinispan resources of the failed upgrade")
+	destroyResources(i, ctx)
+	if ctx.FlowStatus().Stop {
+		return
+	}
+
+	ctx.Requeue(
+		ctx.UpdateInfinispan(func() {
+			i.Status.GracefulShutdownUpgrade.Phase = ispnv1.GracefulShutdownUpgradePhaseRolledBack
+			i.SetCondition(ispnv1.ConditionWellFormed, metav1.ConditionFalse, "")
+		}),
+	)
+}
+
 func EnableRebalanceAfterScaleUp(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	// Perform actions on scale up after GracefulShutdown is complete
 	if i.Spec.Replicas > 0 && i.IsConditionTrue(ispnv1.ConditionScalingUp) {
@@ -333,6 +452,9 @@ func destroyResources(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		{i.GetServiceExternalName(), &corev1.Service{}},
 		{i.GetSiteServiceName(), &corev1.Service{}},
 	}
+	for idx := range i.Spec.Endpoints {
+		resources = append(resources, resource{i.GetEndpointServiceExternalName(&i.Spec.Endpoints[idx]), &corev1.Service{}})
+	}
 
 	del := func(name string, obj client.Object) error {
 		if err := ctx.Resources().Delete(name, obj, pipeline.RetryOnErr, pipeline.IgnoreNotFound); err != nil {
@@ -347,13 +469,19 @@ func destroyResources(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		}
 	}
 
-	if ctx.IsTypeSupported(pipeline.RouteGVK) {
-		if err := del(i.GetServiceExternalName(), &routev1.Route{}); err != nil {
-			return
-		}
-	} else if ctx.IsTypeSupported(pipeline.IngressGVK) {
-		if err := del(i.GetServiceExternalName(), &ingressv1.Ingress{}); err != nil {
-			return
+	externalNames := []string{i.GetServiceExternalName()}
+	for idx := range i.Spec.Endpoints {
+		externalNames = append(externalNames, i.GetEndpointServiceExternalName(&i.Spec.Endpoints[idx]))
+	}
+	for _, name := range externalNames {
+		if ctx.IsTypeSupported(pipeline.RouteGVK) {
+			if err := del(name, &routev1.Route{}); err != nil {
+				return
+			}
+		} else if ctx.IsTypeSupported(pipeline.IngressGVK) {
+			if err := del(name, &ingressv1.Ingress{}); err != nil {
+				return
+			}
 		}
 	}
 
@@ -0,0 +1,244 @@
+package manage
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/hash"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// UserResources applies the users of the User CRs to the default realm of every ready server through the REST API,
+// without restarting the pods. The version of each user applied to a server container is recorded on its pod, so that
+// only the users whose password secret or groups have been updated are applied again. Once every pod has the current
+// users, the status of the User CRs is updated and the finalizers of deleted User CRs are removed.
+func UserResources(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	podList, err := ctx.InfinispanPods()
+	if err != nil {
+		return
+	}
+
+	users := ctx.ConfigFiles().Users
+	applied := true
+	updated := map[string]string{}
+	for _, pod := range podList.Items {
+		containerID := serverContainerID(pod)
+		if containerID == "" || !kube.IsPodReady(pod) {
+			applied = false
+			continue
+		}
+
+		podUsers := appliedUsers(pod, containerID)
+		changed, err := applyUsers(ctx.InfinispanClientForPod(pod.Name).Users(), users, podUsers)
+		if err != nil {
+			ctx.Requeue(fmt.Errorf("unable to apply User CRs to pod '%s': %w", pod.Name, err))
+			return
+		}
+		if !changed {
+			continue
+		}
+		annotation, err := usersAnnotation(containerID, podUsers)
+		if err != nil {
+			ctx.Requeue(err)
+			return
+		}
+		ctx.Log().Info("Applied User CRs", "pod", pod.Name)
+		updated[pod.Name] = annotation
+	}
+
+	if len(updated) > 0 && i.IsAuthorizationEnabled() {
+		// Ensure that groups removed from a user no longer grant access to resources
+		ispnClient, err := ctx.InfinispanClient()
+		if err != nil {
+			ctx.Requeue(fmt.Errorf("unable to create Infinispan client: %w", err))
+			return
+		}
+		if err := ispnClient.Roles().FlushCache(); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to flush ACL cache: %w", err))
+			return
+		}
+	}
+
+	// Record the applied users once the ACL cache has been flushed, so that a failed flush is retried
+	for _, pod := range podList.Items {
+		annotation, ok := updated[pod.Name]
+		if !ok {
+			continue
+		}
+		mutateFn := func() error {
+			if pod.CreationTimestamp.IsZero() {
+				return errors.NewNotFound(corev1.Resource(""), pod.Name)
+			}
+			if pod.Annotations == nil {
+				pod.Annotations = map[string]string{}
+			}
+			pod.Annotations[consts.UsersAnnotation] = annotation
+			return nil
+		}
+		if _, err := ctx.Resources().CreateOrUpdate(&pod, false, mutateFn, pipeline.IgnoreNotFound, pipeline.RetryOnErr); err != nil {
+			return
+		}
+	}
+
+	if !applied {
+		// The pods that are not ready yet are updated on a subsequent reconciliation
+		ctx.RequeueEventually(0)
+		return
+	}
+	updateUserResources(i, ctx)
+}
+
+// applyUsers creates or updates the users whose version differs from the version applied to the server, and deletes
+// the users that have been removed. The applied versions are updated in place, and true is returned if any user has
+// been applied. When the server container has no applied versions, the users of deleted User CRs are also deleted, as
+// they may have been added by the identities batch when the container started
+func applyUsers(client api.Users, users []pipeline.User, applied map[string]string) (bool, error) {
+	fresh := len(applied) == 0
+	desired := make(map[string]bool, len(users))
+	changed := false
+	for _, user := range users {
+		if user.Removed {
+			if _, ok := applied[user.Name]; !ok && !fresh {
+				continue
+			}
+			if err := client.Delete(user.Name); err != nil {
+				return changed, err
+			}
+			delete(applied, user.Name)
+			changed = true
+			continue
+		}
+
+		desired[user.Name] = true
+		version := userVersion(user)
+		if applied[user.Name] == version {
+			continue
+		}
+		config := &api.UserConfig{Password: user.Password, Groups: user.Groups}
+		_, exists, err := client.Get(user.Name)
+		if err != nil {
+			return changed, err
+		}
+		if exists {
+			err = client.Update(user.Name, config)
+		} else {
+			err = client.Create(user.Name, config)
+		}
+		if err != nil {
+			return changed, err
+		}
+		applied[user.Name] = version
+		changed = true
+	}
+
+	// Delete the users that are no longer defined by a User CR, e.g. as their password secret has been deleted
+	for name := range applied {
+		if desired[name] {
+			continue
+		}
+		if err := client.Delete(name); err != nil {
+			return changed, err
+		}
+		delete(applied, name)
+		changed = true
+	}
+	return changed, nil
+}
+
+// userVersion identifies the password and groups of a user, so that it is only applied again when either is updated
+func userVersion(user pipeline.User) string {
+	return hash.HashString(user.PasswordSecretVersion, strings.Join(user.Groups, ","))
+}
+
+// appliedUsers returns the version of each user applied to the server container of the pod. No users are returned when
+// the annotation was recorded for a previous container, as a restarted server only has the users of the identities batch
+func appliedUsers(pod corev1.Pod, containerID string) map[string]string {
+	users := map[string]string{}
+	id, versions, found := strings.Cut(pod.Annotations[consts.UsersAnnotation], ";")
+	if !found || id != containerID {
+		return users
+	}
+	if err := json.Unmarshal([]byte(versions), &users); err != nil {
+		return map[string]string{}
+	}
+	return users
+}
+
+// usersAnnotation returns the annotation value recording the version of each user applied to the server container
+func usersAnnotation(containerID string, users map[string]string) (string, error) {
+	versions, err := json.Marshal(users)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal applied users: %w", err)
+	}
+	return fmt.Sprintf("%s;%s", containerID, versions), nil
+}
+
+// updateUserResources records the users applied to every server in the status of the User CRs, and removes the
+// finalizer of the User CRs whose users have been removed from every server
+func updateUserResources(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	userList := &v2alpha1.UserList{}
+	if err := ctx.Kubernetes().ResourcesListByField(i.Namespace, "spec.clusterName", i.Name, userList, ctx.Ctx()); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to list User CRs: %w", err))
+		return
+	}
+
+	applied := make(map[string]pipeline.User, len(ctx.ConfigFiles().Users))
+	for _, user := range ctx.ConfigFiles().Users {
+		applied[user.Name] = user
+	}
+
+	for _, user := range userList.Items {
+		name := user.GetUsername()
+		appliedUser, ok := applied[name]
+		if !ok {
+			continue
+		}
+
+		if !user.GetDeletionTimestamp().IsZero() {
+			if !appliedUser.Removed || !controllerutil.ContainsFinalizer(&user, consts.InfinispanFinalizer) {
+				continue
+			}
+			mutateFn := func() error {
+				controllerutil.RemoveFinalizer(&user, consts.InfinispanFinalizer)
+				return nil
+			}
+			if _, err := ctx.Resources().CreateOrPatch(&user, false, mutateFn, pipeline.IgnoreNotFound, pipeline.RetryOnErr); err != nil {
+				return
+			}
+			ctx.Log().Info("Removed user", "user", name)
+			continue
+		}
+
+		if appliedUser.Removed || user.GetCondition(v2alpha1.UserConditionReady).Status == metav1.ConditionTrue &&
+			user.Status.PasswordSecretVersion == appliedUser.PasswordSecretVersion &&
+			strings.Join(user.Status.Groups, ",") == strings.Join(appliedUser.Groups, ",") {
+			continue
+		}
+		if err := updateUserStatus(ctx, user.Namespace, user.Name, appliedUser); err != nil {
+			ctx.Requeue(fmt.Errorf("unable to update status of User '%s': %w", user.Name, err))
+			return
+		}
+	}
+}
+
+func updateUserStatus(ctx pipeline.Context, namespace, name string, applied pipeline.User) error {
+	user := &v2alpha1.User{}
+	if err := ctx.Kubernetes().Client.Get(ctx.Ctx(), types.NamespacedName{Namespace: namespace, Name: name}, user); err != nil {
+		return err
+	}
+	user.SetCondition(v2alpha1.UserConditionReady, metav1.ConditionTrue, "")
+	user.Status.Groups = applied.Groups
+	user.Status.PasswordSecretVersion = applied.PasswordSecretVersion
+	return ctx.Kubernetes().Client.Status().Update(ctx.Ctx(), user)
+}
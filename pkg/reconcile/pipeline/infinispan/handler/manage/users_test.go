@@ -0,0 +1,92 @@
+package manage
+
+import (
+	"testing"
+
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeUsers struct {
+	users map[string]*api.UserConfig
+	calls []string
+}
+
+func (f *fakeUsers) Create(name string, config *api.UserConfig) error {
+	f.calls = append(f.calls, "create "+name)
+	f.users[name] = config
+	return nil
+}
+
+func (f *fakeUsers) Delete(name string) error {
+	f.calls = append(f.calls, "delete "+name)
+	delete(f.users, name)
+	return nil
+}
+
+func (f *fakeUsers) Get(name string) (*api.UserInfo, bool, error) {
+	config, ok := f.users[name]
+	if !ok {
+		return nil, false, nil
+	}
+	return &api.UserInfo{Username: name, Groups: config.Groups}, true, nil
+}
+
+func (f *fakeUsers) Update(name string, config *api.UserConfig) error {
+	f.calls = append(f.calls, "update "+name)
+	f.users[name] = config
+	return nil
+}
+
+func TestApplyUsers(t *testing.T) {
+	dev := pipeline.User{Name: "dev", Password: "secret", Groups: []string{"developer"}, PasswordSecretVersion: "1"}
+	ops := pipeline.User{Name: "ops", Password: "secret", Groups: []string{"admin"}, PasswordSecretVersion: "1"}
+
+	// A restarted server has the users of the identities batch, but no applied versions
+	client := &fakeUsers{users: map[string]*api.UserConfig{"dev": {Password: "secret"}, "old": {Password: "secret"}}}
+	applied := map[string]string{}
+	changed, err := applyUsers(client, []pipeline.User{{Name: "old", Removed: true}, dev, ops}, applied)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"delete old", "update dev", "create ops"}, client.calls)
+	assert.Equal(t, map[string]string{"dev": userVersion(dev), "ops": userVersion(ops)}, applied)
+
+	// Unchanged users are not applied again
+	client.calls = nil
+	changed, err = applyUsers(client, []pipeline.User{{Name: "old", Removed: true}, dev, ops}, applied)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Empty(t, client.calls)
+
+	// Only the users whose password secret or groups changed are applied
+	dev.Groups = []string{"developer", "monitor"}
+	ops.PasswordSecretVersion = "2"
+	changed, err = applyUsers(client, []pipeline.User{dev, ops}, applied)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"update dev", "update ops"}, client.calls)
+	assert.Equal(t, []string{"developer", "monitor"}, client.users["dev"].Groups)
+
+	// Removed users and users that are no longer defined are deleted
+	client.calls = nil
+	changed, err = applyUsers(client, []pipeline.User{{Name: "dev", Removed: true}}, applied)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"delete dev", "delete ops"}, client.calls)
+	assert.Empty(t, applied)
+}
+
+func TestAppliedUsers(t *testing.T) {
+	annotation, err := usersAnnotation("containerd://1", map[string]string{"dev": "abc"})
+	require.NoError(t, err)
+	pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{consts.UsersAnnotation: annotation}}}
+
+	assert.Equal(t, map[string]string{"dev": "abc"}, appliedUsers(pod, "containerd://1"))
+	assert.Empty(t, appliedUsers(pod, "containerd://2"))
+	assert.Empty(t, appliedUsers(corev1.Pod{}, "containerd://1"))
+}
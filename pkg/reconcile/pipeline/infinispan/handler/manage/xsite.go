@@ -0,0 +1,183 @@
+package manage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// XSiteStatus updates status.crossSite with the state of each remote site, the relay nodes and the backups of every
+// cache as reported by the server. The server is only queried once every DefaultXSiteStatusInterval, independently of
+// how often the cluster is reconciled, so that state transfers are tracked to completion without polling every cache
+// on each reconciliation.
+func XSiteStatus(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	if current := i.Status.CrossSite; current != nil && current.LastObservedTime != nil {
+		if elapsed := time.Since(current.LastObservedTime.Time); elapsed < consts.DefaultXSiteStatusInterval {
+			ctx.RequeueEventually(consts.DefaultXSiteStatusInterval - elapsed)
+			return
+		}
+	}
+	ctx.RequeueEventually(consts.DefaultXSiteStatusInterval)
+
+	observed, err := observeCrossSite(i, ctx)
+	if err != nil {
+		ctx.Log().Error(err, "unable to retrieve the cross-site status")
+		return
+	}
+
+	status := observed.status()
+	status.LastObservedTime = &metav1.Time{Time: time.Now()}
+	if err := ctx.UpdateInfinispan(func() {
+		i.Status.CrossSite = status
+	}); err != nil {
+		ctx.Requeue(err)
+	}
+}
+
+// crossSiteObservation contains the cross-site state retrieved from the server
+type crossSiteObservation struct {
+	// The names of the remote site locations configured in spec.service.sites
+	locations  []string
+	sitesView  []string
+	relayNodes []string
+	sites      map[string]api.XsiteStatus
+	caches     map[string]cacheXsiteObservation
+}
+
+type cacheXsiteObservation struct {
+	sites     map[string]api.XsiteStatus
+	pushState map[string]api.PushStateStatus
+	// The error returned when retrieving the cache's state, so that a single cache cannot prevent the status of the
+	// remaining caches from being reported
+	err error
+}
+
+func observeCrossSite(i *ispnv1.Infinispan, ctx pipeline.Context) (*crossSiteObservation, error) {
+	podList, err := ctx.InfinispanPods()
+	if err != nil {
+		return nil, err
+	}
+
+	observed := &crossSiteObservation{
+		caches: map[string]cacheXsiteObservation{},
+	}
+	for location := range i.GetRemoteSiteLocations() {
+		observed.locations = append(observed.locations, location)
+	}
+
+	var coordinator api.Infinispan
+	for _, pod := range podList.Items {
+		if !kube.IsPodReady(pod) {
+			continue
+		}
+		ispnClient := ctx.InfinispanClientForPod(pod.Name)
+		info, err := ispnClient.Container().Info()
+		if err != nil {
+			ctx.Log().Error(err, "unable to retrieve container info", "pod", pod.Name)
+			continue
+		}
+		if info.RelayNode {
+			observed.relayNodes = append(observed.relayNodes, pod.Name)
+		}
+		if info.Coordinator {
+			coordinator = ispnClient
+			if info.SitesView != nil {
+				for _, site := range *info.SitesView {
+					observed.sitesView = append(observed.sitesView, fmt.Sprint(site))
+				}
+			}
+		}
+	}
+	if coordinator == nil {
+		return nil, fmt.Errorf("coordinator not ready")
+	}
+
+	if observed.sites, err = coordinator.Container().Xsite().SiteStatuses(); err != nil {
+		return nil, err
+	}
+
+	caches, err := coordinator.Caches().Names()
+	if err != nil {
+		return nil, err
+	}
+	for _, cache := range caches {
+		xsite := coordinator.Cache(cache).Xsite()
+		sites, err := xsite.SiteStatuses()
+		if err != nil {
+			observed.caches[cache] = cacheXsiteObservation{err: err}
+			continue
+		}
+		if len(sites) == 0 {
+			// The cache does not backup to any site
+			continue
+		}
+		pushState, err := xsite.PushStateStatus()
+		observed.caches[cache] = cacheXsiteObservation{
+			sites:     sites,
+			pushState: pushState,
+			err:       err,
+		}
+	}
+	return observed, nil
+}
+
+// status converts the observed state to a CrossSiteStatus with all elements sorted by name
+func (o *crossSiteObservation) status() *ispnv1.CrossSiteStatus {
+	status := &ispnv1.CrossSiteStatus{}
+
+	view := make(map[string]bool, len(o.sitesView))
+	for _, site := range o.sitesView {
+		view[site] = true
+	}
+	for _, location := range sortedCopy(o.locations) {
+		status.Sites = append(status.Sites, ispnv1.CrossSiteLocationStatus{
+			Name:      location,
+			Reachable: view[location],
+			Status:    string(o.sites[location]),
+		})
+	}
+
+	status.RelayNodes = sortedCopy(o.relayNodes)
+
+	caches := make([]string, 0, len(o.caches))
+	for cache := range o.caches {
+		caches = append(caches, cache)
+	}
+	for _, cache := range sortedCopy(caches) {
+		observed := o.caches[cache]
+		sites := make([]string, 0, len(observed.sites))
+		for site := range observed.sites {
+			sites = append(sites, site)
+		}
+
+		cacheStatus := ispnv1.CrossSiteCacheStatus{Name: cache}
+		if observed.err != nil {
+			cacheStatus.Error = observed.err.Error()
+		}
+		for _, site := range sortedCopy(sites) {
+			cacheStatus.Backups = append(cacheStatus.Backups, ispnv1.CrossSiteBackupStatus{
+				Site:          site,
+				Status:        string(observed.sites[site]),
+				StateTransfer: string(observed.pushState[site]),
+			})
+		}
+		status.Caches = append(status.Caches, cacheStatus)
+	}
+	return status
+}
+
+func sortedCopy(keys []string) []string {
+	if len(keys) == 0 {
+		return nil
+	}
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+	return sorted
+}
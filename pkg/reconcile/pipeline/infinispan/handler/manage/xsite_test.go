@@ -0,0 +1,87 @@
+package manage
+
+import (
+	"errors"
+	"testing"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/client/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossSiteObservationStatus(t *testing.T) {
+	observed := &crossSiteObservation{
+		locations:  []string{"site-c", "site-b"},
+		sitesView:  []string{"site-a", "site-b"},
+		relayNodes: []string{"pod-1", "pod-0"},
+		sites: map[string]api.XsiteStatus{
+			"site-b": api.XsiteStatusMixed,
+			"site-c": api.XsiteStatusOffline,
+		},
+		caches: map[string]cacheXsiteObservation{
+			"cache-b": {
+				sites: map[string]api.XsiteStatus{"site-b": api.XsiteStatusOnline},
+			},
+			"cache-a": {
+				sites: map[string]api.XsiteStatus{
+					"site-c": api.XsiteStatusOffline,
+					"site-b": api.XsiteStatusOnline,
+				},
+				pushState: map[string]api.PushStateStatus{"site-b": api.PushStateStatusSending},
+			},
+		},
+	}
+
+	assert.Equal(t, &ispnv1.CrossSiteStatus{
+		Sites: []ispnv1.CrossSiteLocationStatus{
+			{Name: "site-b", Reachable: true, Status: "mixed"},
+			{Name: "site-c", Reachable: false, Status: "offline"},
+		},
+		RelayNodes: []string{"pod-0", "pod-1"},
+		Caches: []ispnv1.CrossSiteCacheStatus{
+			{
+				Name: "cache-a",
+				Backups: []ispnv1.CrossSiteBackupStatus{
+					{Site: "site-b", Status: "online", StateTransfer: "SENDING"},
+					{Site: "site-c", Status: "offline"},
+				},
+			},
+			{
+				Name:    "cache-b",
+				Backups: []ispnv1.CrossSiteBackupStatus{{Site: "site-b", Status: "online"}},
+			},
+		},
+	}, observed.status())
+}
+
+func TestCrossSiteObservationStatusCacheError(t *testing.T) {
+	observed := &crossSiteObservation{
+		locations: []string{"site-b"},
+		caches: map[string]cacheXsiteObservation{
+			"cache-a": {err: errors.New("unexpected response 500")},
+			"cache-b": {
+				sites: map[string]api.XsiteStatus{"site-b": api.XsiteStatusOnline},
+			},
+		},
+	}
+
+	assert.Equal(t, &ispnv1.CrossSiteStatus{
+		Sites: []ispnv1.CrossSiteLocationStatus{{Name: "site-b"}},
+		Caches: []ispnv1.CrossSiteCacheStatus{
+			{Name: "cache-a", Error: "unexpected response 500"},
+			{
+				Name:    "cache-b",
+				Backups: []ispnv1.CrossSiteBackupStatus{{Site: "site-b", Status: "online"}},
+			},
+		},
+	}, observed.status())
+}
+
+func TestCrossSiteObservationStatusNoCaches(t *testing.T) {
+	observed := &crossSiteObservation{
+		locations: []string{"site-b"},
+	}
+	assert.Equal(t, &ispnv1.CrossSiteStatus{
+		Sites: []ispnv1.CrossSiteLocationStatus{{Name: "site-b"}},
+	}, observed.status())
+}
@@ -0,0 +1,127 @@
+package provision
+
+import (
+	"errors"
+	"fmt"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	"github.com/infinispan/infinispan-operator/pkg/infinispan/security"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Certificates creates the cert-manager Certificates used for endpoint and cross-site encryption. The issued secrets
+// are consumed by the configure.Keystore, configure.Truststore and configure.TransportTLS handlers.
+func Certificates(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	if !ctx.IsTypeSupported(pipeline.CertificateGVK) {
+		errMsg := fmt.Sprintf("Failed to setup TLS. The '%s' type is not available, cert-manager must be installed", pipeline.CertificateGVK)
+		_ = ctx.UpdateInfinispan(func() {
+			i.SetCondition(ispnv1.ConditionTLSSecretValid, metav1.ConditionFalse, errMsg)
+		})
+		ctx.Stop(errors.New(errMsg))
+		return
+	}
+
+	if i.IsEncryptionCertFromCertManager() {
+		dnsNames := serviceDNSNames(i, i.GetServiceName())
+		certificate := newCertificate(i, i.GetCertificateName(), i.GetKeystoreSecretName(), i.Spec.Security.EndpointEncryption.CertIssuer, dnsNames, nil)
+		if _, err := ctx.Resources().CreateOrUpdate(certificate.obj, true, certificate.mutate, pipeline.RetryOnErr); err != nil {
+			return
+		}
+	}
+
+	if i.IsSiteCertFromCertManager() {
+		// cert-manager encrypts the PKCS#12 keystores with the password stored in this secret
+		passwordSecret := newSecret(i, i.GetSiteKeyStorePasswordSecretName())
+		mutateFn := func() error {
+			passwordSecret.Labels = i.Labels("infinispan-secret-site-keystore-password")
+			if _, exists := passwordSecret.Data[consts.SiteCertManagerKeyStorePasswordKey]; exists {
+				return nil
+			}
+			password, err := security.GeneratePassword()
+			if err != nil {
+				return err
+			}
+			passwordSecret.Type = corev1.SecretTypeOpaque
+			passwordSecret.Data = map[string][]byte{
+				consts.SiteCertManagerKeyStorePasswordKey: []byte(password),
+			}
+			return nil
+		}
+		if _, err := ctx.Resources().CreateOrUpdate(passwordSecret, true, mutateFn, pipeline.RetryOnErr); err != nil {
+			return
+		}
+
+		keystores := map[string]interface{}{
+			"pkcs12": map[string]interface{}{
+				"create": true,
+				"passwordSecretRef": map[string]interface{}{
+					"name": passwordSecret.Name,
+					"key":  consts.SiteCertManagerKeyStorePasswordKey,
+				},
+			},
+		}
+		issuer := i.Spec.Service.Sites.Local.Encryption.CertIssuer
+		dnsNames := serviceDNSNames(i, i.GetSiteServiceName())
+		for _, c := range []struct{ name, secretName string }{
+			{i.GetSiteTransportCertificateName(), i.GetSiteTransportSecretName()},
+			{i.GetSiteRouterCertificateName(), i.GetSiteRouterSecretName()},
+		} {
+			certificate := newCertificate(i, c.name, c.secretName, issuer, dnsNames, keystores)
+			if _, err := ctx.Resources().CreateOrUpdate(certificate.obj, true, certificate.mutate, pipeline.RetryOnErr); err != nil {
+				return
+			}
+		}
+	}
+}
+
+type certificate struct {
+	obj    *unstructured.Unstructured
+	mutate func() error
+}
+
+func newCertificate(i *ispnv1.Infinispan, name, secretName string, issuer *ispnv1.CertificateIssuerRef, dnsNames []string, keystores map[string]interface{}) *certificate {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(pipeline.CertificateGVK)
+	obj.SetName(name)
+	obj.SetNamespace(i.Namespace)
+
+	names := make([]interface{}, len(dnsNames))
+	for idx, dnsName := range dnsNames {
+		names[idx] = dnsName
+	}
+	issuerRef := map[string]interface{}{
+		"name": issuer.Name,
+		"kind": consts.GetWithDefault(string(issuer.Kind), string(ispnv1.CertificateIssuerKindIssuer)),
+	}
+	if issuer.Group != "" {
+		issuerRef["group"] = issuer.Group
+	}
+
+	return &certificate{
+		obj: obj,
+		mutate: func() error {
+			obj.SetLabels(i.Labels("infinispan-certificate"))
+			spec := map[string]interface{}{
+				"secretName": secretName,
+				"dnsNames":   names,
+				"issuerRef":  issuerRef,
+			}
+			if keystores != nil {
+				spec["keystores"] = keystores
+			}
+			return unstructured.SetNestedMap(obj.Object, spec, "spec")
+		},
+	}
+}
+
+func serviceDNSNames(i *ispnv1.Infinispan, service string) []string {
+	return []string{
+		service,
+		fmt.Sprintf("%s.%s.svc", service, i.Namespace),
+		fmt.Sprintf(ispnv1.SiteServiceFQNTemplate, service, i.Namespace),
+	}
+}
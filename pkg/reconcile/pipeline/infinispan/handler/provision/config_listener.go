@@ -10,10 +10,12 @@ import (
 	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
 	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
 	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -49,14 +51,58 @@ func ConfigListener(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		Namespace: namespace,
 	}
 
+	operandVersions, err := ctx.Operands().Json()
+	if err != nil {
+		ctx.Requeue(fmt.Errorf("unable to marshall Operands for ConfigListener deployment: %w", err))
+		return
+	}
+
+	container := &corev1.Container{
+		Name:  InfinispanListenerContainer,
+		Image: configListenerImage,
+		Args: []string{
+			"listener",
+			"-namespace",
+			namespace,
+			"-cluster",
+			i.Name,
+			"-zap-log-level",
+			string(i.Spec.ConfigListener.Logging.Level),
+		},
+		Env: []corev1.EnvVar{{Name: "INFINISPAN_OPERAND_VERSIONS", Value: operandVersions}},
+		Ports: []corev1.ContainerPort{{
+			Name:          "metrics",
+			ContainerPort: 8080,
+			Protocol:      corev1.ProtocolTCP,
+		}, {
+			Name:          "health",
+			ContainerPort: 8081,
+			Protocol:      corev1.ProtocolTCP,
+		}},
+		LivenessProbe:  configListenerProbe("/healthz"),
+		ReadinessProbe: configListenerProbe("/readyz"),
+	}
+
+	if podResources, err := podResources(i); err != nil {
+		ctx.Requeue(fmt.Errorf("unable to calculate ConfigListener pod resources: %w", err))
+		return
+	} else if podResources != nil {
+		container.Resources = *podResources
+	}
+
+	labels := i.PodLabels()
+	labels["app"] = "infinispan-config-listener-pod"
+	affinity := configListenerAffinity(i, labels)
+	replicas := i.ConfigListenerReplicas()
+
 	deployment := &appsv1.Deployment{}
 	listenerExists := r.Load(name, deployment) == nil
 	if listenerExists {
-		container := kube.GetContainer(InfinispanListenerContainer, &deployment.Spec.Template.Spec)
-		if container != nil {
-			if container.Image == configListenerImage {
-				if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
-					if err := ScaleConfigListener(1, i, ctx); err != nil {
+		existing := kube.GetContainer(InfinispanListenerContainer, &deployment.Spec.Template.Spec)
+		if existing != nil {
+			if existing.Image == configListenerImage {
+				if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != replicas {
+					if err := ScaleConfigListener(replicas, i, ctx); err != nil {
 						ctx.Requeue(err)
 						return
 					}
@@ -64,10 +110,10 @@ func ConfigListener(i *ispnv1.Infinispan, ctx pipeline.Context) {
 
 				// Update the deployment args if the ConfigListener log level has been updated
 				logLevel := string(i.Spec.ConfigListener.Logging.Level)
-				if container.Args[len(container.Args)-1] != logLevel {
+				if existing.Args[len(existing.Args)-1] != logLevel {
 					err := UpdateConfigListenerDeployment(i, ctx, func(deployment *appsv1.Deployment) {
-						container = kube.GetContainer(InfinispanListenerContainer, &deployment.Spec.Template.Spec)
-						container.Args[len(container.Args)-1] = logLevel
+						existing = kube.GetContainer(InfinispanListenerContainer, &deployment.Spec.Template.Spec)
+						existing.Args[len(existing.Args)-1] = logLevel
 					})
 
 					if err != nil {
@@ -76,13 +122,10 @@ func ConfigListener(i *ispnv1.Infinispan, ctx pipeline.Context) {
 					}
 				}
 
-				if podResources, err := podResources(i); err != nil {
-					ctx.Requeue(fmt.Errorf("unable to calculate ConfigListener pod resources on update: %w", err))
-					return
-				} else if podResources != nil && !reflect.DeepEqual(*podResources, container.Resources) {
+				if !reflect.DeepEqual(container.Resources, existing.Resources) {
 					err := UpdateConfigListenerDeployment(i, ctx, func(deployment *appsv1.Deployment) {
-						container = kube.GetContainer(InfinispanListenerContainer, &deployment.Spec.Template.Spec)
-						container.Resources = *podResources
+						existing = kube.GetContainer(InfinispanListenerContainer, &deployment.Spec.Template.Spec)
+						existing.Resources = container.Resources
 					})
 
 					if err != nil {
@@ -90,12 +133,25 @@ func ConfigListener(i *ispnv1.Infinispan, ctx pipeline.Context) {
 						return
 					}
 				}
+
+				if !reflect.DeepEqual(affinity, deployment.Spec.Template.Spec.Affinity) {
+					err := UpdateConfigListenerDeployment(i, ctx, func(deployment *appsv1.Deployment) {
+						deployment.Spec.Template.Spec.Affinity = affinity
+					})
+
+					if err != nil {
+						ctx.Requeue(fmt.Errorf("unable to update ConfigListener affinity: %w", err))
+						return
+					}
+				}
 				// The Deployment already exists with the expected spec, do nothing
 			} else {
 				// Deployed configListener has different image, redeploying...
 				ctx.Log().Info("ConfigListener deployment, new image detected", "image", configListenerImage)
 				err := UpdateConfigListenerDeployment(i, ctx, func(deployment *appsv1.Deployment) {
-					deployment.Spec.Template.Spec.Containers[0].Image = configListenerImage
+					// Replace the container as the probes and ports depend on the ConfigListener version
+					deployment.Spec.Template.Spec.Containers = []corev1.Container{*container}
+					deployment.Spec.Template.Spec.Affinity = affinity
 				})
 				if err != nil {
 					ctx.Requeue(fmt.Errorf("unable to update ConfigListener image: %w", err))
@@ -126,43 +182,15 @@ func ConfigListener(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		return
 	}
 
+	// The Role rules are always updated, so that permissions required by a new ConfigListener version are granted
 	role := &rbacv1.Role{
 		ObjectMeta: objectMeta,
-		Rules: []rbacv1.PolicyRule{
-			{
-				APIGroups: []string{v2alpha1.GroupVersion.Group},
-				Resources: []string{"caches"},
-				Verbs: []string{
-					"create",
-					"delete",
-					"get",
-					"list",
-					"patch",
-					"update",
-					"watch",
-				},
-			},
-			{
-				APIGroups: []string{ispnv1.GroupVersion.Group},
-				Resources: []string{"infinispans"},
-				Verbs:     []string{"get"},
-			}, {
-				APIGroups: []string{""},
-				Resources: []string{"pods"},
-				Verbs:     []string{"list"},
-			}, {
-				APIGroups: []string{""},
-				Resources: []string{"pods/exec"},
-				Verbs:     []string{"create"},
-			},
-			{
-				APIGroups: []string{""},
-				Resources: []string{"secrets"},
-				Verbs:     []string{"get"},
-			},
-		},
 	}
-	if err := createOrUpdate(role); err != nil {
+	_, err = r.CreateOrUpdate(role, true, func() error {
+		role.Rules = configListenerRules()
+		return nil
+	}, pipeline.RetryOnErr)
+	if err != nil {
 		return
 	}
 
@@ -183,39 +211,11 @@ func ConfigListener(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		return
 	}
 
-	operandVersions, err := ctx.Operands().Json()
-	if err != nil {
-		ctx.Requeue(fmt.Errorf("unable to marshall Operands for ConfigListener deployment: %w", err))
-
-	}
-
-	container := &corev1.Container{
-		Name:  InfinispanListenerContainer,
-		Image: configListenerImage,
-		Args: []string{
-			"listener",
-			"-namespace",
-			namespace,
-			"-cluster",
-			i.Name,
-			"-zap-log-level",
-			string(i.Spec.ConfigListener.Logging.Level),
-		},
-		Env: []corev1.EnvVar{{Name: "INFINISPAN_OPERAND_VERSIONS", Value: operandVersions}},
-	}
-
-	if podResources, err := podResources(i); err != nil {
-		ctx.Requeue(fmt.Errorf("unable to calculate ConfigListener pod resources: %w", err))
-	} else if podResources != nil {
-		container.Resources = *podResources
-	}
-
 	// The deployment doesn't exist, create it
-	labels := i.PodLabels()
-	labels["app"] = "infinispan-config-listener-pod"
 	deployment = &appsv1.Deployment{
 		ObjectMeta: objectMeta,
 		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32(replicas),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: labels,
 			},
@@ -224,6 +224,7 @@ func ConfigListener(i *ispnv1.Infinispan, ctx pipeline.Context) {
 					Labels: labels,
 				},
 				Spec: corev1.PodSpec{
+					Affinity:           affinity,
 					Containers:         []corev1.Container{*container},
 					ServiceAccountName: name,
 				},
@@ -235,6 +236,88 @@ func ConfigListener(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	}
 }
 
+func configListenerRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{v2alpha1.GroupVersion.Group},
+			Resources: []string{"caches", "schemas"},
+			Verbs: []string{
+				"create",
+				"delete",
+				"get",
+				"list",
+				"patch",
+				"update",
+				"watch",
+			},
+		},
+		{
+			APIGroups: []string{ispnv1.GroupVersion.Group},
+			Resources: []string{"infinispans"},
+			Verbs:     []string{"get"},
+		}, {
+			APIGroups: []string{ispnv1.GroupVersion.Group},
+			Resources: []string{"infinispans/status"},
+			Verbs:     []string{"get", "patch"},
+		}, {
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+			Verbs:     []string{"get", "list"},
+		}, {
+			APIGroups: []string{""},
+			Resources: []string{"pods/exec"},
+			Verbs:     []string{"create"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"secrets"},
+			Verbs:     []string{"get"},
+		}, {
+			// Required for electing the ConfigListener pod that processes server events
+			APIGroups: []string{coordinationv1.GroupName},
+			Resources: []string{"leases"},
+			Verbs:     []string{"create", "get", "update"},
+		},
+	}
+}
+
+// configListenerAffinity returns the user configured affinity, otherwise ConfigListener pods prefer to be deployed on
+// distinct nodes so that a node failure does not remove all ConfigListener pods
+func configListenerAffinity(i *ispnv1.Infinispan, labels map[string]string) *corev1.Affinity {
+	if i.Spec.ConfigListener.Affinity != nil {
+		return i.Spec.ConfigListener.Affinity
+	}
+	return &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight: 100,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: labels,
+					},
+					TopologyKey: "kubernetes.io/hostname",
+				},
+			}},
+		},
+	}
+}
+
+func configListenerProbe(path string) *corev1.Probe {
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   path,
+				Port:   intstr.FromString("health"),
+				Scheme: corev1.URISchemeHTTP,
+			},
+		},
+		FailureThreshold: 5,
+		PeriodSeconds:    10,
+		SuccessThreshold: 1,
+		TimeoutSeconds:   1,
+	}
+}
+
 func podResources(i *ispnv1.Infinispan) (*corev1.ResourceRequirements, error) {
 	spec := i.Spec.ConfigListener
 	if spec.CPU == "" && spec.Memory == "" {
@@ -271,6 +354,7 @@ func RemoveConfigListener(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		&rbacv1.Role{},
 		&rbacv1.RoleBinding{},
 		&corev1.ServiceAccount{},
+		&coordinationv1.Lease{},
 	}
 
 	name := i.GetConfigListenerName()
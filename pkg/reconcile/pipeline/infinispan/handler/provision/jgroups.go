@@ -0,0 +1,110 @@
+package provision
+
+import (
+	"fmt"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	consts "github.com/infinispan/infinispan-operator/controllers/constants"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const JGroupsEncryptVolumeName = "encrypt-jgroups-volume"
+
+// JGroupsServiceAccount provisions the ServiceAccount used by the server pods to discover the cluster members via the
+// Kubernetes API with KUBE_PING. The resources are removed once a different discovery protocol is configured.
+func JGroupsServiceAccount(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	r := ctx.Resources()
+	name := i.GetJGroupsServiceAccountName()
+	if !i.IsJGroupsKubernetesDiscovery() {
+		// Only issue Delete requests for resources that still exist, so that clusters which have never used KUBE_PING
+		// don't send requests to the API server on every reconciliation
+		for _, obj := range []client.Object{&rbacv1.RoleBinding{}, &rbacv1.Role{}, &corev1.ServiceAccount{}} {
+			if err := r.Load(name, obj); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				ctx.Requeue(fmt.Errorf("unable to load JGroups discovery resource: %w", err))
+				return
+			}
+			if err := r.Delete(name, obj, pipeline.RetryOnErr, pipeline.IgnoreNotFound); err != nil {
+				return
+			}
+		}
+		return
+	}
+
+	objectMeta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: i.Namespace,
+	}
+
+	createOrUpdate := func(obj client.Object) error {
+		err := r.Load(obj.GetName(), obj)
+		if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to create JGroups discovery resource: %w", err)
+		}
+
+		if errors.IsNotFound(err) {
+			return r.Create(obj, true, pipeline.RetryOnErr)
+		} else {
+			return r.Update(obj, pipeline.RetryOnErr)
+		}
+	}
+
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: objectMeta,
+	}
+	if err := createOrUpdate(sa); err != nil {
+		return
+	}
+
+	// KUBE_PING only needs to query the pods in the cluster namespace
+	role := &rbacv1.Role{
+		ObjectMeta: objectMeta,
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+			Verbs:     []string{"get", "list"},
+		}},
+	}
+	if err := createOrUpdate(role); err != nil {
+		return
+	}
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: objectMeta,
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     name,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      name,
+			Namespace: i.Namespace,
+		}},
+	}
+	_ = createOrUpdate(roleBinding)
+}
+
+// PodServiceAccountName returns the ServiceAccount used by the server pods, or an empty string if the namespace
+// default should be used
+func PodServiceAccountName(i *ispnv1.Infinispan) string {
+	if i.IsJGroupsKubernetesDiscovery() {
+		return i.GetJGroupsServiceAccountName()
+	}
+	return ""
+}
+
+// AddJGroupsEncryptionVolume mounts the Secret containing the SYM_ENCRYPT keystore
+func AddJGroupsEncryptionVolume(i *ispnv1.Infinispan, spec *corev1.PodSpec) (updated bool) {
+	if i.IsJGroupsSymmetricEncryption() {
+		updated = AddSecretVolume(i.Spec.JGroups.Encryption.SecretName, JGroupsEncryptVolumeName, consts.JGroupsEncryptKeyStoreRoot, spec, InfinispanContainer)
+	}
+	return
+}
@@ -29,6 +29,10 @@ func PodPortsWithXsite(i *ispnv1.Infinispan) []corev1.ContainerPort {
 	if i.HasSites() {
 		ports = append(ports, corev1.ContainerPort{ContainerPort: consts.CrossSitePort, Name: consts.CrossSitePortName, Protocol: corev1.ProtocolTCP})
 	}
+
+	for _, e := range i.Spec.Endpoints {
+		ports = append(ports, corev1.ContainerPort{ContainerPort: e.Port, Name: e.Name, Protocol: corev1.ProtocolTCP})
+	}
 	return ports
 }
 
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -74,4 +75,49 @@ var _ = Describe("Provision", func() {
 		Expect(err).Should(BeNil())
 		Expect(ss.Spec.Template.Spec.PriorityClassName).Should(BeEmpty())
 	})
+
+	It("should create cert-manager Certificate for the cluster service", func() {
+		ispn := &ispnv1.Infinispan{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+			},
+		}
+		issuer := &ispnv1.CertificateIssuerRef{Name: "ca-issuer"}
+		keystores := map[string]interface{}{
+			"pkcs12": map[string]interface{}{"create": true},
+		}
+		certificate := newCertificate(ispn, "cert", "cert-secret", issuer, serviceDNSNames(ispn, ispn.GetServiceName()), keystores)
+		Expect(certificate.mutate()).Should(Succeed())
+
+		obj := certificate.obj
+		Expect(obj.GroupVersionKind()).Should(Equal(infinispan.CertificateGVK))
+		Expect(obj.GetName()).Should(Equal("cert"))
+		Expect(obj.GetNamespace()).Should(Equal(key.Namespace))
+
+		secretName, _, _ := unstructured.NestedString(obj.Object, "spec", "secretName")
+		Expect(secretName).Should(Equal("cert-secret"))
+
+		dnsNames, _, _ := unstructured.NestedStringSlice(obj.Object, "spec", "dnsNames")
+		Expect(dnsNames).Should(Equal([]string{
+			"infinispan-unit",
+			"infinispan-unit.default.svc",
+			"infinispan-unit.default.svc.cluster.local",
+		}))
+
+		issuerRef, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "issuerRef")
+		Expect(issuerRef).Should(Equal(map[string]string{"name": "ca-issuer", "kind": "Issuer"}))
+
+		create, _, _ := unstructured.NestedBool(obj.Object, "spec", "keystores", "pkcs12", "create")
+		Expect(create).Should(BeTrue())
+	})
+
+	It("should compute the truststore hash from the source certificates of a generated truststore", func() {
+		generated := &infinispan.Truststore{File: []byte("with-previous-ca"), SourceHash: "source"}
+		pruned := &infinispan.Truststore{File: []byte("without-previous-ca"), SourceHash: "source"}
+		Expect(TruststoreHash(generated)).Should(Equal(TruststoreHash(pruned)))
+
+		provided := &infinispan.Truststore{File: []byte("provided")}
+		Expect(TruststoreHash(provided)).ShouldNot(Equal(TruststoreHash(&infinispan.Truststore{File: []byte("updated")})))
+	})
 })
@@ -47,6 +47,15 @@ func InfinispanSecuritySecret(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		if i.IsEncryptionEnabled() && len(configFiles.Keystore.PemFile) > 0 {
 			secret.Data["keystore.pem"] = configFiles.Keystore.PemFile
 		}
+		if configFiles.LDAPTruststore != nil {
+			secret.Data[consts.ServerLDAPTruststoreFilename] = configFiles.LDAPTruststore
+		}
+		if rotation := configFiles.CredentialRotation; rotation != nil {
+			secret.Data[consts.ServerPreviousAdminIdentitiesFilename] = rotation.PreviousAdminIdentities
+			if rotation.PreviousUserIdentities != nil {
+				secret.Data[consts.ServerPreviousIdentitiesFilename] = rotation.PreviousUserIdentities
+			}
+		}
 		return nil
 	}
 	_, _ = ctx.Resources().CreateOrUpdate(secret, true, mutateFn, pipeline.RetryOnErr)
@@ -58,15 +67,19 @@ func TruststoreSecret(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	}
 
 	truststore := ctx.ConfigFiles().Truststore
+	if truststore.SourceHash == "" {
+		// The truststore was provided by the user
+		return
+	}
 	secret := newSecret(i, i.GetTruststoreSecretName())
 	mutateFn := func() error {
-		_, truststoreExists := secret.Data[consts.EncryptTruststoreKey]
-		if !truststoreExists {
-			secret.Data = map[string][]byte{
-				consts.EncryptTruststoreKey:         truststore.File,
-				consts.EncryptTruststorePasswordKey: []byte(truststore.Password),
-			}
+		// Retain the ca and cert files that the truststore is generated from
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
 		}
+		secret.Data[consts.EncryptTruststoreKey] = truststore.File
+		secret.Data[consts.EncryptTruststorePasswordKey] = []byte(truststore.Password)
+		secret.Data[consts.EncryptTruststoreSourceHashKey] = []byte(truststore.SourceHash)
 		return nil
 	}
 	_, _ = ctx.Resources().CreateOrUpdate(secret, false, mutateFn, pipeline.RetryOnErr)
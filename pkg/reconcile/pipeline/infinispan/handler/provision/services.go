@@ -2,6 +2,7 @@ package provision
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -12,8 +13,10 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
 	ingressv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func PingService(i *ispnv1.Infinispan, ctx pipeline.Context) {
@@ -58,6 +61,7 @@ func ClusterService(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		servicePort := &svc.Spec.Ports[0]
 		servicePort.Name = consts.InfinispanUserPortName
 		servicePort.Port = consts.InfinispanUserPort
+		svc.Spec.Ports = append(svc.Spec.Ports[:1], endpointServicePorts(i, svc.Spec.Ports)...)
 
 		if i.IsEncryptionCertFromService() {
 			if strings.Contains(i.Spec.Security.EndpointEncryption.CertServiceName, "openshift.io") {
@@ -71,6 +75,25 @@ func ClusterService(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	_, _ = ctx.Resources().CreateOrUpdate(svc, true, mutateFn, pipeline.RetryOnErr)
 }
 
+// endpointServicePorts returns a ServicePort for each of the spec.endpoints, reusing the existing ServicePort values
+func endpointServicePorts(i *ispnv1.Infinispan, existing []corev1.ServicePort) []corev1.ServicePort {
+	ports := make([]corev1.ServicePort, 0, len(i.Spec.Endpoints))
+	for _, e := range i.Spec.Endpoints {
+		port := corev1.ServicePort{}
+		for _, p := range existing {
+			if p.Name == e.Name {
+				port = p
+				break
+			}
+		}
+		port.Name = e.Name
+		port.Port = e.Port
+		port.TargetPort = intstr.FromInt(int(e.Port))
+		ports = append(ports, port)
+	}
+	return ports
+}
+
 func AdminService(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	svc := newService(i, i.GetAdminServiceName())
 
@@ -105,11 +128,29 @@ func AdminService(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	_, _ = ctx.Resources().CreateOrUpdate(svc, true, mutateFn, pipeline.RetryOnErr)
 }
 
+// ExternalService exposes the default endpoint of the cluster as configured by spec.expose, and each of the
+// spec.endpoints that define an expose configuration
 func ExternalService(i *ispnv1.Infinispan, ctx pipeline.Context) {
-	if !i.IsExposed() {
-		return
+	if i.IsExposed() {
+		exposeCluster(i, ctx)
+		if ctx.FlowStatus().Stop {
+			return
+		}
+	}
+	if i.HasExposedEndpoints() {
+		exposeEndpoints(i, ctx)
 	}
+}
 
+// externalEndpoint describes a Service, Route or Ingress that exposes an endpoint of the cluster
+type externalEndpoint struct {
+	name   string
+	labels map[string]string
+	expose *ispnv1.ExposeSpec
+	port   int32
+}
+
+func exposeCluster(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	// If expose type has changed, ensure that we remove all existing expose definitions
 	exposeType := i.GetExposeType()
 	for _, gvk := range pipeline.ServiceTypes {
@@ -150,30 +191,130 @@ func ExternalService(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		}
 	}
 
+	endpoint := &externalEndpoint{
+		name:   i.GetServiceExternalName(),
+		labels: i.ExternalServiceLabels(),
+		expose: i.Spec.Expose,
+		port:   consts.InfinispanUserPort,
+	}
 	switch exposeType {
 	case ispnv1.ExposeTypeLoadBalancer, ispnv1.ExposeTypeNodePort:
-		defineExternalService(i, ctx)
+		defineExternalService(i, ctx, endpoint)
 	case ispnv1.ExposeTypeRoute:
 		if ctx.IsTypeSupported(pipeline.RouteGVK) {
-			defineExternalRoute(i, ctx)
+			defineExternalRoute(i, ctx, endpoint)
 		} else if ctx.IsTypeSupported(pipeline.IngressGVK) {
-			defineExternalIngress(i, ctx)
+			defineExternalIngress(i, ctx, endpoint)
 		} else {
 			ctx.Stop(fmt.Errorf("unable to expose cluster with type Route, as no implementations are supported"))
 		}
 	}
 }
 
-func defineExternalService(i *ispnv1.Infinispan, ctx pipeline.Context) {
-	externalServiceType := corev1.ServiceType(i.Spec.Expose.Type)
+// exposeEndpoints creates a Service, Route or Ingress for each exposed endpoint in spec.endpoints, removing those that
+// are no longer required. The names of the exposed endpoints are recorded in the status, so that the removal of the
+// last exposed endpoint is still reconciled
+func exposeEndpoints(i *ispnv1.Infinispan, ctx pipeline.Context) {
+	required := map[string]string{}
+	var exposed []*externalEndpoint
+	for idx := range i.Spec.Endpoints {
+		e := &i.Spec.Endpoints[idx]
+		if !e.IsExposed() {
+			continue
+		}
+		endpoint := &externalEndpoint{
+			name:   i.GetEndpointServiceExternalName(e),
+			labels: i.EndpointExternalServiceLabels(),
+			expose: e.Expose,
+			port:   e.Port,
+		}
+		exposed = append(exposed, endpoint)
+
+		switch e.Expose.Type {
+		case ispnv1.ExposeTypeLoadBalancer, ispnv1.ExposeTypeNodePort:
+			required[endpoint.name] = pipeline.ServiceGVK.Kind
+		case ispnv1.ExposeTypeRoute:
+			if ctx.IsTypeSupported(pipeline.RouteGVK) {
+				required[endpoint.name] = pipeline.RouteGVK.Kind
+			} else if ctx.IsTypeSupported(pipeline.IngressGVK) && e.Type == ispnv1.EndpointConnectorTypeREST {
+				required[endpoint.name] = pipeline.IngressGVK.Kind
+			} else {
+				ctx.Stop(fmt.Errorf("unable to expose endpoint '%s' with type Route, as no implementations are supported", e.Name))
+				return
+			}
+		}
+	}
+
+	// Remove the resources of endpoints that are no longer exposed, or whose expose type has changed
+	labels := i.EndpointExternalServiceSelectorLabels()
+	for _, gvk := range pipeline.ServiceTypes {
+		if !ctx.IsTypeSupported(gvk) {
+			continue
+		}
+		var list client.ObjectList
+		switch gvk {
+		case pipeline.ServiceGVK:
+			list = &corev1.ServiceList{}
+		case pipeline.RouteGVK:
+			list = &routev1.RouteList{}
+		case pipeline.IngressGVK:
+			list = &ingressv1.IngressList{}
+		}
+		if err := ctx.Resources().List(labels, list); err != nil {
+			ctx.Log().Error(err, fmt.Sprintf("unable to list endpoint %s for deletion", gvk.Kind))
+			continue
+		}
+		objs, err := meta.ExtractList(list)
+		if err != nil {
+			ctx.Requeue(err)
+			return
+		}
+		for _, obj := range objs {
+			o := obj.(client.Object)
+			if required[o.GetName()] == gvk.Kind {
+				continue
+			}
+			if err := ctx.Resources().Delete(o.GetName(), o, pipeline.RetryOnErr, pipeline.IgnoreNotFound); err != nil {
+				return
+			}
+		}
+	}
+
+	for _, endpoint := range exposed {
+		switch required[endpoint.name] {
+		case pipeline.ServiceGVK.Kind:
+			defineExternalService(i, ctx, endpoint)
+		case pipeline.RouteGVK.Kind:
+			defineExternalRoute(i, ctx, endpoint)
+		case pipeline.IngressGVK.Kind:
+			defineExternalIngress(i, ctx, endpoint)
+		}
+		if ctx.FlowStatus().Stop {
+			return
+		}
+	}
+
+	names := make([]string, 0, len(exposed))
+	for _, endpoint := range exposed {
+		names = append(names, endpoint.name)
+	}
+	if !reflect.DeepEqual(names, append([]string{}, i.Status.ExposedEndpoints...)) {
+		_ = ctx.UpdateInfinispan(func() {
+			i.Status.ExposedEndpoints = names
+		})
+	}
+}
+
+func defineExternalService(i *ispnv1.Infinispan, ctx pipeline.Context, endpoint *externalEndpoint) {
+	externalServiceType := corev1.ServiceType(endpoint.expose.Type)
 
-	svc := newService(i, i.GetServiceExternalName())
+	svc := newService(i, endpoint.name)
 	mutateFn := func() error {
 		svc.Annotations = i.ServiceAnnotations()
-		for k, v := range i.Spec.Expose.Annotations {
+		for k, v := range endpoint.expose.Annotations {
 			svc.Annotations[k] = v
 		}
-		svc.Labels = i.ExternalServiceLabels()
+		svc.Labels = endpoint.labels
 		svc.Spec.Type = externalServiceType
 		svc.Spec.Selector = i.ServiceSelectorLabels()
 
@@ -182,10 +323,10 @@ func defineExternalService(i *ispnv1.Infinispan, ctx pipeline.Context) {
 			svc.Spec.Ports = []corev1.ServicePort{{}}
 		}
 		servicePort := &svc.Spec.Ports[0]
-		servicePort.Port = int32(consts.InfinispanUserPort)
-		servicePort.TargetPort = intstr.FromInt(consts.InfinispanUserPort)
+		servicePort.Port = endpoint.port
+		servicePort.TargetPort = intstr.FromInt(int(endpoint.port))
 
-		exposeConf := i.Spec.Expose
+		exposeConf := endpoint.expose
 		if exposeConf.NodePort > 0 && exposeConf.Type == ispnv1.ExposeTypeNodePort {
 			servicePort.NodePort = exposeConf.NodePort
 		}
@@ -197,14 +338,14 @@ func defineExternalService(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	_, _ = ctx.Resources().CreateOrUpdate(svc, true, mutateFn, pipeline.RetryOnErr)
 }
 
-func defineExternalRoute(i *ispnv1.Infinispan, ctx pipeline.Context) {
-	route := newRoute(i, i.GetServiceExternalName())
+func defineExternalRoute(i *ispnv1.Infinispan, ctx pipeline.Context, endpoint *externalEndpoint) {
+	route := newRoute(i, endpoint.name)
 	mutateFn := func() error {
 		route.Annotations = i.ServiceAnnotations()
-		route.Labels = i.ExternalServiceLabels()
-		route.Spec.Host = i.Spec.Expose.Host
+		route.Labels = endpoint.labels
+		route.Spec.Host = endpoint.expose.Host
 		route.Spec.Port = &routev1.RoutePort{
-			TargetPort: intstr.FromInt(consts.InfinispanUserPort),
+			TargetPort: intstr.FromInt(int(endpoint.port)),
 		}
 		route.Spec.To = routev1.RouteTargetReference{
 			Kind: "Service",
@@ -219,7 +360,7 @@ func defineExternalRoute(i *ispnv1.Infinispan, ctx pipeline.Context) {
 	_, _ = ctx.Resources().CreateOrUpdate(route, true, mutateFn, pipeline.RetryOnErr)
 }
 
-func defineExternalIngress(i *ispnv1.Infinispan, ctx pipeline.Context) {
+func defineExternalIngress(i *ispnv1.Infinispan, ctx pipeline.Context, endpoint *externalEndpoint) {
 	pathTypePrefix := ingressv1.PathTypePrefix
 
 	ingress := &ingressv1.Ingress{
@@ -228,17 +369,17 @@ func defineExternalIngress(i *ispnv1.Infinispan, ctx pipeline.Context) {
 			Kind:       "Ingress",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      i.GetServiceExternalName(),
+			Name:      endpoint.name,
 			Namespace: i.Namespace,
 		},
 	}
 
 	mutateFn := func() error {
 		ingress.Annotations = i.ServiceAnnotations()
-		ingress.Labels = i.ExternalServiceLabels()
+		ingress.Labels = endpoint.labels
 		ingress.Spec.Rules = []ingressv1.IngressRule{
 			{
-				Host: i.Spec.Expose.Host,
+				Host: endpoint.expose.Host,
 				IngressRuleValue: ingressv1.IngressRuleValue{
 					HTTP: &ingressv1.HTTPIngressRuleValue{
 						Paths: []ingressv1.HTTPIngressPath{
@@ -248,7 +389,7 @@ func defineExternalIngress(i *ispnv1.Infinispan, ctx pipeline.Context) {
 								Backend: ingressv1.IngressBackend{
 									Service: &ingressv1.IngressServiceBackend{
 										Name: i.Name,
-										Port: ingressv1.ServiceBackendPort{Number: consts.InfinispanUserPort},
+										Port: ingressv1.ServiceBackendPort{Number: endpoint.port},
 									},
 								}}},
 					},
@@ -259,7 +400,7 @@ func defineExternalIngress(i *ispnv1.Infinispan, ctx pipeline.Context) {
 		if i.IsEncryptionEnabled() {
 			ingress.Spec.TLS = []ingressv1.IngressTLS{
 				{
-					Hosts: []string{i.Spec.Expose.Host},
+					Hosts: []string{endpoint.expose.Host},
 				},
 			}
 		}
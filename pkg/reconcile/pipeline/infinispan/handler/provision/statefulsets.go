@@ -112,6 +112,7 @@ func ClusterStatefulSetSpec(statefulSetName string, i *ispnv1.Infinispan, ctx pi
 					Annotations: annotationsForPod,
 				},
 				Spec: corev1.PodSpec{
+					ServiceAccountName:        PodServiceAccountName(i),
 					Affinity:                  i.Affinity(),
 					Tolerations:               i.Tolerations(),
 					TopologySpreadConstraints: i.TopologySpreadConstraints(),
@@ -172,15 +173,24 @@ func ClusterStatefulSetSpec(statefulSetName string, i *ispnv1.Infinispan, ctx pi
 	addUserConfigVolumes(ctx, i, statefulSet)
 	addTLS(ctx, i, statefulSet)
 	AddXSiteTLSVolumes(ctx, i, statefulSet)
+	AddJGroupsEncryptionVolume(i, &statefulSet.Spec.Template.Spec)
 	return statefulSet, nil
 }
 
 func PodEnvsAndHash(i *ispnv1.Infinispan, configFiles *pipeline.ConfigFiles) ([]corev1.EnvVar, string) {
-	envs := PodEnv(i, &[]corev1.EnvVar{
+	podEnvs := []corev1.EnvVar{
 		{Name: "CONFIG_HASH", Value: hash.HashString(configFiles.ServerBaseConfig, configFiles.ServerAdminConfig)},
-		{Name: "ADMIN_IDENTITIES_HASH", Value: hash.HashByte(configFiles.AdminIdentities.IdentitiesFile)},
-		{Name: "IDENTITIES_BATCH", Value: consts.ServerOperatorSecurity + "/" + consts.ServerIdentitiesBatchFilename},
-	})
+	}
+	if !i.IsCredentialRotationEnabled() {
+		// The admin identities of clusters whose credentials can be rotated are applied to the running pods instead
+		podEnvs = append(podEnvs, corev1.EnvVar{Name: "ADMIN_IDENTITIES_HASH", Value: hash.HashByte(configFiles.AdminIdentities.IdentitiesFile)})
+	}
+	podEnvs = append(podEnvs, corev1.EnvVar{Name: "IDENTITIES_BATCH", Value: consts.ServerOperatorSecurity + "/" + consts.ServerIdentitiesBatchFilename})
+	envs := PodEnv(i, &podEnvs)
+	if configFiles.LDAPTruststore != nil {
+		// The LDAP client SSL context is only created on startup, so the pods must be restarted when the CA changes
+		envs = append(envs, corev1.EnvVar{Name: "LDAP_TRUSTSTORE_HASH", Value: hash.HashByte(configFiles.LDAPTruststore)})
+	}
 	hash := sha1.New()
 	for _, e := range envs {
 		hash.Write([]byte(e.Name))
@@ -195,11 +205,18 @@ func StatefulSetPodLabels(statefulSetName string, i *ispnv1.Infinispan) map[stri
 	return labelsForPod
 }
 
+// IsUserIdentitiesApplied returns true if updates to the user identities are applied to the running pods, so that the
+// pods are not restarted when the identities change. Only the generated identities of clusters whose credentials can be
+// rotated are applied
+func IsUserIdentitiesApplied(i *ispnv1.Infinispan) bool {
+	return i.IsCredentialRotationEnabled() && i.IsGeneratedSecret()
+}
+
 func addUserIdentities(ctx pipeline.Context, i *ispnv1.Infinispan, statefulset *appsv1.StatefulSet) {
 	// Only append IDENTITIES_HASH and secret volume if authentication is enabled
 	spec := &statefulset.Spec.Template.Spec
 	ispnContainer := kube.GetContainer(InfinispanContainer, spec)
-	if AddVolumeForUserAuthentication(i, spec) {
+	if AddVolumeForUserAuthentication(i, spec) && !IsUserIdentitiesApplied(i) {
 		ispnContainer.Env = append(ispnContainer.Env,
 			corev1.EnvVar{
 				Name:  "IDENTITIES_HASH",
@@ -342,12 +359,22 @@ func addTLS(ctx pipeline.Context, i *ispnv1.Infinispan, statefulSet *appsv1.Stat
 			ispnContainer.Env = append(ispnContainer.Env,
 				corev1.EnvVar{
 					Name:  "TRUSTSTORE_HASH",
-					Value: hash.HashByte(configFiles.Truststore.File),
+					Value: TruststoreHash(configFiles.Truststore),
 				})
 		}
 	}
 }
 
+// TruststoreHash returns the hash of the certificates trusted by the truststore. The hash of a generated truststore is
+// computed from its source certificates, so that removing the previous CA once a rotation has completed does not rotate
+// the pods again
+func TruststoreHash(truststore *pipeline.Truststore) string {
+	if truststore.SourceHash != "" {
+		return truststore.SourceHash
+	}
+	return hash.HashByte(truststore.File)
+}
+
 func AddXSiteTLSVolumes(ctx pipeline.Context, i *ispnv1.Infinispan, statefulset *appsv1.StatefulSet) (updated bool) {
 	if i.IsSiteTLSEnabled() {
 		spec := &statefulset.Spec.Template.Spec
@@ -156,20 +156,29 @@ func (b *builder) Build() pipeline.Pipeline {
 	// `serving-cert-secret-name` annotation is required in order to configure the Keystore
 	handlers.Add(provision.ClusterService)
 
+	// Provision the cert-manager Certificates before executing the configuration handlers, as the issued Secrets are
+	// required in order to configure the Keystore, Truststore and cross-site TLS
+	handlers.AddFeatureSpecific(i.IsEncryptionCertFromCertManager() || i.IsSiteCertFromCertManager(), provision.Certificates)
+
 	// Configuration Handlers
 	handlers.AddFeatureSpecific(i.HasSites(), configure.XSite)
 	handlers.AddFeatureSpecific(i.IsSiteTLSEnabled(),
 		configure.TransportTLS,
 		configure.GossipRouterTLS,
 	)
-	handlers.AddFeatureSpecific(i.IsCredentialStoreSecretDefined(), configure.CredentialStoreSecret)
+	handlers.AddFeatureSpecific(i.IsJGroupsSymmetricEncryption(), configure.JGroupsEncryption)
+	// Always executed so that the credentials of Cache CR stores are added to the credential store
+	handlers.Add(configure.CredentialStore)
 	handlers.AddFeatureSpecific(i.IsAuthenticationEnabled(), configure.UserAuthenticationSecret)
 	handlers.AddFeatureSpecific(i.UserConfigDefined(), configure.UserConfigMap)
 	handlers.AddFeatureSpecific(i.IsEncryptionEnabled(), configure.Keystore)
 	handlers.AddFeatureSpecific(i.IsClientCertEnabled(), configure.Truststore)
+	handlers.AddFeatureSpecific(i.IsLDAPRealmEnabled() && i.GetLDAPCASecretName() != "", configure.LDAPTruststore)
 	handlers.AddFeatureSpecific(i.IsAuthenticationEnabled() && i.IsGeneratedSecret(), configure.UserIdentities)
+	handlers.AddFeatureSpecific(i.IsPropertiesRealmEnabled(), configure.UserResources)
 	handlers.Add(
 		configure.AdminSecret,
+		configure.CredentialRotation,
 		configure.InfinispanServer,
 		configure.Logging,
 		configure.AdminIdentities,
@@ -189,17 +198,18 @@ func (b *builder) Build() pipeline.Pipeline {
 			provision.InfinispanSecuritySecret,
 			provision.InfinispanConfigMap,
 			provision.PingService,
+			provision.JGroupsServiceAccount,
 			provision.AdminService,
 			provision.ClusterStatefulSet,
 			provision.ServiceMonitor,
 		)
-		handlers.AddFeatureSpecific(i.IsExposed(), provision.ExternalService)
+		handlers.AddFeatureSpecific(i.IsExposed() || i.HasExposedEndpoints(), provision.ExternalService)
 	}
 
 	// Manage the created Cluster
 	handlers.Add(manage.PodStatus)
 	handlers.AddFeatureSpecific(i.HotRodRollingUpgrades(), manage.HotRodRollingUpgrade)
-	handlers.AddFeatureSpecific(i.GracefulShutdownUpgrades(), manage.GracefulShutdownUpgrade)
+	handlers.AddFeatureSpecific(i.GracefulShutdownUpgrades(), manage.GracefulShutdownUpgrade, manage.GracefulShutdownUpgradeRollback)
 	handlers.Add(
 		manage.RemoveFailedInitContainers,
 		manage.UpdatePodLabels,
@@ -210,6 +220,9 @@ func (b *builder) Build() pipeline.Pipeline {
 		manage.AwaitUpgrade,
 		manage.ClusterScaling,
 		manage.StatefulSetRollingUpgrade,
+	)
+	handlers.AddFeatureSpecific(i.IsEncryptionEnabled(), manage.TLSRotation)
+	handlers.Add(
 		manage.AwaitPodIps,
 		manage.EnableRebalanceAfterScaleUp,
 	)
@@ -218,10 +231,15 @@ func (b *builder) Build() pipeline.Pipeline {
 		manage.ConfigureLoggers,
 		provision.ConfigListener,
 	)
+	// Always added, as a requested credential rotation enables the rotation of the generated credentials
+	handlers.Add(manage.CredentialRotation)
+	handlers.Add(manage.CredentialStore)
+	handlers.AddFeatureSpecific(i.IsPropertiesRealmEnabled(), manage.UserResources)
 	handlers.Add(
 		manage.ConsoleUrl,
 	)
-	handlers.AddFeatureSpecific(i.HasSites(), manage.XSiteViewCondition)
+	handlers.AddFeatureSpecific(i.HasSites(), manage.XSiteViewCondition, manage.XSiteStatus)
+	handlers.AddFeatureSpecific(i.IsAutoscalingEnabled(), manage.MemoryAutoscaling)
 
 	b.handlers = handlers.Build()
 
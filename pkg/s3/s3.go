@@ -0,0 +1,80 @@
+// Package s3 provides presigned URLs for uploading and downloading objects to S3 compatible object storage, such as
+// AWS S3 or MinIO, using path-style requests signed with AWS Signature Version 4
+package s3
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultRegion = "us-east-1"
+
+	// MaxExpiry is the maximum validity of a presigned URL permitted by AWS Signature Version 4
+	MaxExpiry = 7 * 24 * time.Hour
+)
+
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type Config struct {
+	Credentials Credentials
+	// The endpoint of the object store, e.g. "https://s3.us-east-1.amazonaws.com" or "http://minio.minio.svc:9000"
+	Endpoint string
+	// The region of the bucket. DefaultRegion is used if empty
+	Region string
+	Bucket string
+}
+
+type Client struct {
+	Config
+	endpoint *url.URL
+	// now returns the time used to sign requests, overridden in tests
+	now func() time.Time
+}
+
+func New(c Config) (*Client, error) {
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object store endpoint '%s': %w", c.Endpoint, err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("invalid object store endpoint '%s': scheme must be http or https", c.Endpoint)
+	}
+	if c.Region == "" {
+		c.Region = DefaultRegion
+	}
+	return &Client{
+		Config:   c,
+		endpoint: endpoint,
+		now:      time.Now,
+	}, nil
+}
+
+// PresignPut returns a URL that can be used to upload the object with the provided key without credentials
+func (c *Client) PresignPut(key string, expires time.Duration) (string, error) {
+	return c.presign("PUT", key, expires)
+}
+
+// PresignGet returns a URL that can be used to download the object with the provided key without credentials
+func (c *Client) PresignGet(key string, expires time.Duration) (string, error) {
+	return c.presign("GET", key, expires)
+}
+
+func (c *Client) presign(method, key string, expires time.Duration) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("object key must not be empty")
+	}
+	if expires <= 0 || expires > MaxExpiry {
+		return "", fmt.Errorf("presigned URL expiry must be greater than 0 and at most %s", MaxExpiry)
+	}
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.Bucket + "/" + strings.TrimPrefix(key, "/")
+	u.RawPath = ""
+	presign(&u, method, c.Credentials, c.Region, expires, c.now())
+	return u.String(), nil
+}
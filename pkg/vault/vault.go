@@ -0,0 +1,268 @@
+// Package vault provides a minimal client for reading secrets from the KV version 2 secrets engine of HashiCorp Vault,
+// authenticating with either a static token or the Kubernetes auth method
+package vault
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMount is the mount path of the KV version 2 secrets engine enabled by Vault in dev mode
+	DefaultMount = "secret"
+	// DefaultKubernetesAuthMount is the mount path of the Kubernetes auth method
+	DefaultKubernetesAuthMount = "kubernetes"
+
+	tokenHeader    = "X-Vault-Token"
+	requestTimeout = 10 * time.Second
+)
+
+type Config struct {
+	// The address of the Vault server, e.g. "https://vault.vault.svc:8200"
+	Address string
+	// The token used to authenticate requests. Ignored if KubernetesRole is set
+	Token string
+	// The Vault role used to login with the Kubernetes auth method
+	KubernetesRole string
+	// The ServiceAccount token presented when logging in with the Kubernetes auth method
+	KubernetesJWT string
+	// The cache of the tokens obtained with the Kubernetes auth method. A login is required for every Client if not set
+	Tokens *TokenCache
+}
+
+// TokenCache caches the tokens obtained with the Kubernetes auth method until their lease expires, so that a login is
+// not required every time that a secret is read
+type TokenCache struct {
+	mutex  sync.Mutex
+	tokens map[string]cachedToken
+	now    func() time.Time
+}
+
+type cachedToken struct {
+	token  string
+	expiry time.Time
+}
+
+func NewTokenCache() *TokenCache {
+	return &TokenCache{
+		tokens: map[string]cachedToken{},
+		now:    time.Now,
+	}
+}
+
+func (t *TokenCache) get(key string) (string, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	cached, ok := t.tokens[key]
+	if !ok || !cached.expiry.IsZero() && !t.now().Before(cached.expiry) {
+		return "", false
+	}
+	return cached.token, true
+}
+
+// put caches the token until 90% of its lease has elapsed, so that it is renewed before Vault revokes it. Tokens
+// without a lease never expire
+func (t *TokenCache) put(key, token string, lease time.Duration) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	cached := cachedToken{token: token}
+	if lease > 0 {
+		cached.expiry = t.now().Add(lease * 9 / 10)
+	}
+	t.tokens[key] = cached
+}
+
+func (t *TokenCache) remove(key string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.tokens, key)
+}
+
+// statusError is returned when Vault responds with an unexpected status
+type statusError struct {
+	status int
+	errors []string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected response status %d: %s", e.status, strings.Join(e.errors, ", "))
+}
+
+type Client struct {
+	Config
+	address *url.URL
+	http    *http.Client
+}
+
+// Secret is the latest version of a KV version 2 secret
+type Secret struct {
+	Data    map[string]string
+	Version int
+}
+
+func New(c Config) (*Client, error) {
+	address, err := url.Parse(c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Vault address '%s': %w", c.Address, err)
+	}
+	if address.Scheme != "http" && address.Scheme != "https" {
+		return nil, fmt.Errorf("invalid Vault address '%s': scheme must be http or https", c.Address)
+	}
+	if c.Token == "" && c.KubernetesRole == "" {
+		return nil, fmt.Errorf("either a Vault token or a Kubernetes auth role must be provided")
+	}
+	return &Client{
+		Config:  c,
+		address: address,
+		http:    &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// ReadKV returns the latest version of the secret stored at path in the KV version 2 secrets engine mounted at mount
+func (c *Client) ReadKV(mount, path string) (*Secret, error) {
+	if path == "" {
+		return nil, fmt.Errorf("secret path must not be empty")
+	}
+	if mount == "" {
+		mount = DefaultMount
+	}
+
+	token, cached, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &struct {
+		Data struct {
+			Data     map[string]interface{} `json:"data"`
+			Metadata struct {
+				Version int `json:"version"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}{}
+	err = c.do(http.MethodGet, trim(mount)+"/data/"+trim(path), token, nil, rsp)
+	var statusErr *statusError
+	if cached && errors.As(err, &statusErr) && statusErr.status == http.StatusForbidden {
+		// The cached token has been revoked, so login again
+		c.Tokens.remove(c.tokenKey())
+		if token, _, err = c.token(); err != nil {
+			return nil, err
+		}
+		err = c.do(http.MethodGet, trim(mount)+"/data/"+trim(path), token, nil, rsp)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read secret '%s' from mount '%s': %w", path, mount, err)
+	}
+
+	secret := &Secret{
+		Data:    make(map[string]string, len(rsp.Data.Data)),
+		Version: rsp.Data.Metadata.Version,
+	}
+	for k, v := range rsp.Data.Data {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("the value of key '%s' in secret '%s' must be a string", k, path)
+		}
+		secret.Data[k] = s
+	}
+	return secret, nil
+}
+
+// token returns the token used to authenticate requests, logging in with the Kubernetes auth method if configured.
+// Returns true if the token was retrieved from the TokenCache
+func (c *Client) token() (string, bool, error) {
+	if c.KubernetesRole == "" {
+		return c.Token, false, nil
+	}
+
+	if c.Tokens != nil {
+		if token, ok := c.Tokens.get(c.tokenKey()); ok {
+			return token, true, nil
+		}
+	}
+
+	body := map[string]string{
+		"role": c.KubernetesRole,
+		"jwt":  c.KubernetesJWT,
+	}
+	rsp := &struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int    `json:"lease_duration"`
+		} `json:"auth"`
+	}{}
+	if err := c.do(http.MethodPost, "auth/"+DefaultKubernetesAuthMount+"/login", "", body, rsp); err != nil {
+		return "", false, fmt.Errorf("unable to login with Kubernetes auth role '%s': %w", c.KubernetesRole, err)
+	}
+	if rsp.Auth.ClientToken == "" {
+		return "", false, fmt.Errorf("unable to login with Kubernetes auth role '%s': no client token returned", c.KubernetesRole)
+	}
+	if c.Tokens != nil {
+		c.Tokens.put(c.tokenKey(), rsp.Auth.ClientToken, time.Duration(rsp.Auth.LeaseDuration)*time.Second)
+	}
+	return rsp.Auth.ClientToken, false, nil
+}
+
+// tokenKey identifies the tokens obtained by logging in to the Vault server with the role and ServiceAccount token
+func (c *Client) tokenKey() string {
+	jwt := sha256.Sum256([]byte(c.KubernetesJWT))
+	return c.Address + "|" + c.KubernetesRole + "|" + hex.EncodeToString(jwt[:])
+}
+
+func (c *Client) do(method, path, token string, body, into interface{}) error {
+	u := *c.address
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/v1/" + path
+	u.RawPath = ""
+
+	var payload io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, u.String(), payload)
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set(tokenHeader, token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rsp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		errs := &struct {
+			Errors []string `json:"errors"`
+		}{}
+		_ = json.NewDecoder(rsp.Body).Decode(errs)
+		return &statusError{status: rsp.StatusCode, errors: errs.Errors}
+	}
+	if err := json.NewDecoder(rsp.Body).Decode(into); err != nil {
+		return fmt.Errorf("unable to decode response: %w", err)
+	}
+	return nil
+}
+
+func trim(s string) string {
+	return strings.Trim(s, "/")
+}
@@ -0,0 +1,194 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	rootToken      = "root"
+	kubernetesRole = "infinispan"
+	kubernetesJWT  = "service-account-token"
+)
+
+// devServer is a minimal in-memory stand-in for a Vault server started in dev mode, which mounts a KV version 2
+// secrets engine at "secret" and has the Kubernetes auth method enabled
+type devServer struct {
+	sync.Mutex
+	secrets map[string][]map[string]interface{}
+	logins  int
+	// token is the client token issued by the last login, which is valid for lease seconds
+	token string
+	lease int
+}
+
+func (d *devServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	d.Lock()
+	defer d.Unlock()
+
+	if r.Method == http.MethodPost && r.URL.Path == "/v1/auth/kubernetes/login" {
+		login := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&login); err != nil || login["role"] != kubernetesRole || login["jwt"] != kubernetesJWT {
+			writeErrors(w, http.StatusForbidden, "permission denied")
+			return
+		}
+		d.logins++
+		d.token = fmt.Sprintf("kubernetes-token-%d", d.logins)
+		writeJSON(w, map[string]interface{}{"auth": map[string]interface{}{"client_token": d.token, "lease_duration": d.lease}})
+		return
+	}
+
+	if token := r.Header.Get(tokenHeader); token != rootToken && (token == "" || token != d.token) {
+		writeErrors(w, http.StatusForbidden, "permission denied")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")
+	versions, ok := d.secrets[path]
+	if r.Method != http.MethodGet || path == r.URL.Path || !ok {
+		writeErrors(w, http.StatusNotFound)
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{
+			"data":     versions[len(versions)-1],
+			"metadata": map[string]interface{}{"version": len(versions)},
+		},
+	})
+}
+
+func (d *devServer) put(path string, data map[string]interface{}) {
+	d.Lock()
+	defer d.Unlock()
+	d.secrets[path] = append(d.secrets[path], data)
+}
+
+func writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeErrors(w http.ResponseWriter, status int, errs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": append([]string{}, errs...)})
+}
+
+func newDevServer(t *testing.T) (*devServer, *httptest.Server) {
+	dev := &devServer{secrets: map[string][]map[string]interface{}{}}
+	server := httptest.NewServer(dev)
+	t.Cleanup(server.Close)
+	return dev, server
+}
+
+func TestReadKV(t *testing.T) {
+	dev, server := newDevServer(t)
+	dev.put("infinispan/credentials", map[string]interface{}{"db-password": "changeme"})
+	dev.put("infinispan/credentials", map[string]interface{}{"db-password": "rotated", "api-key": "key"})
+
+	client, err := New(Config{Address: server.URL, Token: rootToken})
+	require.NoError(t, err)
+
+	secret, err := client.ReadKV("", "/infinispan/credentials")
+	require.NoError(t, err)
+	assert.Equal(t, 2, secret.Version)
+	assert.Equal(t, map[string]string{"db-password": "rotated", "api-key": "key"}, secret.Data)
+
+	_, err = client.ReadKV(DefaultMount, "infinispan/missing")
+	assert.ErrorContains(t, err, "404")
+}
+
+func TestReadKVKubernetesAuth(t *testing.T) {
+	dev, server := newDevServer(t)
+	dev.put("credentials", map[string]interface{}{"alias": "password"})
+
+	client, err := New(Config{Address: server.URL, KubernetesRole: kubernetesRole, KubernetesJWT: kubernetesJWT})
+	require.NoError(t, err)
+
+	secret, err := client.ReadKV(DefaultMount, "credentials")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"alias": "password"}, secret.Data)
+	assert.Equal(t, 1, dev.logins)
+
+	client, err = New(Config{Address: server.URL, KubernetesRole: "unknown", KubernetesJWT: kubernetesJWT})
+	require.NoError(t, err)
+	_, err = client.ReadKV(DefaultMount, "credentials")
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+// TestReadKVKubernetesAuthTokenCache tests that a login is only required when the cached token expires or is revoked
+func TestReadKVKubernetesAuthTokenCache(t *testing.T) {
+	dev, server := newDevServer(t)
+	dev.lease = 3600
+	dev.put("credentials", map[string]interface{}{"alias": "password"})
+
+	now := time.Now()
+	tokens := NewTokenCache()
+	tokens.now = func() time.Time { return now }
+	readKV := func() {
+		client, err := New(Config{Address: server.URL, KubernetesRole: kubernetesRole, KubernetesJWT: kubernetesJWT, Tokens: tokens})
+		require.NoError(t, err)
+		secret, err := client.ReadKV(DefaultMount, "credentials")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"alias": "password"}, secret.Data)
+	}
+
+	readKV()
+	readKV()
+	assert.Equal(t, 1, dev.logins)
+
+	// The token is renewed before its lease expires
+	now = now.Add(55 * time.Minute)
+	readKV()
+	assert.Equal(t, 2, dev.logins)
+
+	// A revoked token is replaced
+	dev.Lock()
+	dev.token = "revoked"
+	dev.Unlock()
+	readKV()
+	assert.Equal(t, 3, dev.logins)
+	readKV()
+	assert.Equal(t, 3, dev.logins)
+}
+
+func TestReadKVInvalidToken(t *testing.T) {
+	dev, server := newDevServer(t)
+	dev.put("credentials", map[string]interface{}{"alias": "password"})
+
+	client, err := New(Config{Address: server.URL, Token: "invalid"})
+	require.NoError(t, err)
+	_, err = client.ReadKV(DefaultMount, "credentials")
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+func TestReadKVNonStringValue(t *testing.T) {
+	dev, server := newDevServer(t)
+	dev.put("credentials", map[string]interface{}{"port": 5432})
+
+	client, err := New(Config{Address: server.URL, Token: rootToken})
+	require.NoError(t, err)
+	_, err = client.ReadKV(DefaultMount, "credentials")
+	assert.ErrorContains(t, err, "must be a string")
+}
+
+func TestInvalidConfig(t *testing.T) {
+	_, err := New(Config{Address: "vault:8200", Token: rootToken})
+	assert.Error(t, err)
+	_, err = New(Config{Address: "http://vault:8200"})
+	assert.Error(t, err)
+
+	client, err := New(Config{Address: "http://vault:8200", Token: rootToken})
+	require.NoError(t, err)
+	_, err = client.ReadKV(DefaultMount, "")
+	assert.Error(t, err)
+}
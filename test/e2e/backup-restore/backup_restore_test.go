@@ -11,6 +11,7 @@ import (
 	v1 "github.com/infinispan/infinispan-operator/api/v1"
 	"github.com/infinispan/infinispan-operator/api/v2alpha1"
 	v2 "github.com/infinispan/infinispan-operator/api/v2alpha1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
 	"github.com/infinispan/infinispan-operator/pkg/mime"
 	"github.com/infinispan/infinispan-operator/test/e2e/utils"
 	tutils "github.com/infinispan/infinispan-operator/test/e2e/utils"
@@ -18,9 +19,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 var testKube = tutils.NewTestKubernetes(os.Getenv("TESTING_CONTEXT"))
@@ -237,3 +242,232 @@ func waitForNoCluster(infinispan *v1.Infinispan) {
 	})
 	tutils.ExpectNoError(err)
 }
+
+func TestBackupSchedule(t *testing.T) {
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	testName := tutils.TestName(t)
+	name := strcase.ToKebab(testName)
+	namespace := tutils.Namespace
+
+	infinispan := datagridService(t, name, 1)
+	testKube.Create(infinispan)
+	testKube.WaitForInfinispanPods(1, tutils.SinglePodTimeout, infinispan.Name, namespace)
+	testKube.WaitForInfinispanCondition(infinispan.Name, namespace, v1.ConditionWellFormed)
+
+	schedule := &v2.BackupSchedule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "infinispan.org/v2alpha1",
+			Kind:       "BackupSchedule",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{"test-name": testName},
+		},
+		Spec: v2.BackupScheduleSpec{
+			Schedule: "* * * * *",
+			Template: v2.BackupSpec{
+				Cluster: infinispan.Name,
+			},
+			Retention: &v2.BackupScheduleRetentionSpec{
+				KeepLast: pointer.Int32(1),
+			},
+		},
+	}
+	schedule.Default()
+	testKube.Create(schedule)
+
+	// Wait for two scheduled backups to complete successfully
+	var firstBackup string
+	key := types.NamespacedName{Namespace: namespace, Name: schedule.Name}
+	err := wait.Poll(tutils.DefaultPollPeriod, 5*tutils.SinglePodTimeout, func() (bool, error) {
+		if err := testKube.Kubernetes.Client.Get(context.TODO(), key, schedule); err != nil {
+			return false, err
+		}
+		if schedule.Status.LastFailed != nil {
+			return false, fmt.Errorf("scheduled backup '%s' failed: %s", schedule.Status.LastFailed.Name, schedule.Status.LastFailed.Reason)
+		}
+		if last := schedule.Status.LastSuccessful; last != nil {
+			if firstBackup == "" {
+				firstBackup = last.Name
+			}
+			return last.Name != firstBackup, nil
+		}
+		return false, nil
+	})
+	tutils.ExpectNoError(err)
+
+	// Ensure that the first backup is removed once it falls outside of the retention policy
+	err = wait.Poll(tutils.DefaultPollPeriod, tutils.SinglePodTimeout, func() (bool, error) {
+		backup := &v2.Backup{}
+		e := testKube.Kubernetes.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: firstBackup}, backup)
+		return e != nil && k8errors.IsNotFound(e), nil
+	})
+	tutils.ExpectNoError(err)
+
+	// Suspend the schedule so that no further backups are created whilst the namespace is cleaned up
+	schedule.Spec.Suspend = true
+	testKube.Update(schedule)
+}
+
+func TestBackupRestoreObjectStore(t *testing.T) {
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	testName := tutils.TestName(t)
+	name := strcase.ToKebab(testName)
+	namespace := tutils.Namespace
+	numEntries := 100
+
+	// 1. Deploy a MinIO server to act as the S3 compatible object store
+	credentials := deployObjectStore(testName, namespace)
+	defer testKube.DeleteSecret(credentials)
+	objectStore := v2.ObjectStoreSpec{
+		Endpoint:          fmt.Sprintf("http://%s.%s.svc:%d", objectStoreName, namespace, objectStorePort),
+		Bucket:            objectStoreBucket,
+		Prefix:            name,
+		CredentialsSecret: credentials.Name,
+	}
+
+	// 2. Create and populate the source cluster
+	sourceCluster := name + "-source"
+	infinispan := datagridService(t, sourceCluster, 1)
+	testKube.Create(infinispan)
+	testKube.WaitForInfinispanPods(1, tutils.SinglePodTimeout, infinispan.Name, namespace)
+	testKube.WaitForInfinispanCondition(sourceCluster, namespace, v1.ConditionWellFormed)
+
+	cacheName := "someCache"
+	cache := tutils.NewCacheHelper(cacheName, utils.HTTPClientForCluster(infinispan, testKube))
+	cache.Create("{\"distributed-cache\":{\"mode\":\"SYNC\"}}", mime.ApplicationJson)
+	cache.Populate(numEntries)
+	cache.AssertSize(numEntries)
+
+	// 3. Backup the cluster's content to the object store
+	backupName := "backup"
+	backup := backupSpec(testName, backupName, namespace, sourceCluster)
+	backup.Spec.Volume.ObjectStore = &objectStore
+	testKube.Create(backup)
+	testKube.WaitForValidBackupPhase(backupName, namespace, v2.BackupSucceeded)
+
+	tutils.ExpectNoError(testKube.Kubernetes.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: backupName}, backup))
+	objectKey := name + "/" + backupName + ".zip"
+	if backup.Status.ObjectKey != objectKey {
+		panic(fmt.Sprintf("expected Backup objectKey '%s', got '%s'", objectKey, backup.Status.ObjectKey))
+	}
+	if backup.Status.PVC != "" {
+		panic(fmt.Sprintf("expected no PersistentVolumeClaim to be created for the Backup, got '%s'", backup.Status.PVC))
+	}
+
+	// 4. Replace the source cluster with an empty target cluster
+	testKube.DeleteInfinispan(infinispan)
+	waitForNoCluster(infinispan)
+
+	targetCluster := name + "-target"
+	infinispan = datagridService(t, targetCluster, 1)
+	testKube.Create(infinispan)
+	testKube.WaitForInfinispanPods(1, tutils.SinglePodTimeout, infinispan.Name, namespace)
+	testKube.WaitForInfinispanCondition(targetCluster, namespace, v1.ConditionWellFormed)
+
+	// 5. Restore the archive from the object store, without referencing the Backup CR
+	restoreName := "restore"
+	restore := restoreSpec(testName, restoreName, namespace, "", targetCluster)
+	restore.Spec.ObjectStore = &v2.RestoreObjectStoreSpec{
+		ObjectStoreSpec: objectStore,
+		Key:             backup.Status.ObjectKey,
+	}
+	testKube.Create(restore)
+	tutils.ExpectNoError(testKube.WaitForValidRestorePhase(restoreName, namespace, v2.RestoreSucceeded))
+	testKube.WaitForInfinispanPods(1, tutils.SinglePodTimeout, infinispan.Name, namespace)
+
+	// 6. Ensure that all data is in the target cluster
+	tutils.NewCacheHelper(cacheName, utils.HTTPClientForCluster(infinispan, testKube)).AssertSize(numEntries)
+}
+
+const (
+	objectStoreName   = "minio"
+	objectStoreBucket = "backups"
+	objectStorePort   = 9000
+)
+
+// deployObjectStore creates a single node MinIO server, with the bucket used by the tests, and returns the secret
+// containing its credentials. The Deployment and Service are owned by the secret, so they're removed with it.
+func deployObjectStore(testName, namespace string) *corev1.Secret {
+	labels := map[string]string{"app": objectStoreName}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectStoreName + "-credentials",
+			Namespace: namespace,
+			Labels:    map[string]string{"test-name": testName},
+		},
+		StringData: map[string]string{
+			constants.ObjectStoreAccessKeyID:     "infinispan",
+			constants.ObjectStoreSecretAccessKey: "infinispan-secret",
+		},
+	}
+	testKube.CreateSecret(secret)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectStoreName,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32(1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  objectStoreName,
+						Image: tutils.ObjectStoreImageName,
+						// MinIO treats each top-level directory as a bucket, so create the bucket before starting the server
+						Command: []string{"sh", "-c", fmt.Sprintf("mkdir -p /data/%s && minio server /data", objectStoreBucket)},
+						Env: []corev1.EnvVar{
+							{Name: "MINIO_ROOT_USER", ValueFrom: secretKeyRef(secret.Name, constants.ObjectStoreAccessKeyID)},
+							{Name: "MINIO_ROOT_PASSWORD", ValueFrom: secretKeyRef(secret.Name, constants.ObjectStoreSecretAccessKey)},
+						},
+						Ports: []corev1.ContainerPort{{ContainerPort: objectStorePort}},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{
+									Path: "/minio/health/ready",
+									Port: intstr.FromInt(objectStorePort),
+								},
+							},
+							PeriodSeconds: 1,
+						},
+					}},
+				},
+			},
+		},
+	}
+	tutils.ExpectNoError(controllerutil.SetControllerReference(secret, deployment, tutils.Scheme))
+	testKube.Create(deployment)
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      objectStoreName,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports:    []corev1.ServicePort{{Port: objectStorePort}},
+		},
+	}
+	tutils.ExpectNoError(controllerutil.SetControllerReference(secret, service, tutils.Scheme))
+	testKube.Create(service)
+
+	testKube.WaitForPods(1, tutils.SinglePodTimeout, &client.ListOptions{Namespace: namespace, LabelSelector: k8slabels.SelectorFromSet(labels)}, nil)
+	return secret
+}
+
+func secretKeyRef(name, key string) *corev1.EnvVarSource {
+	return &corev1.EnvVarSource{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  key,
+		},
+	}
+}
@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -128,6 +129,9 @@ func TestUpdateCacheCR(t *testing.T) {
 	testKube.Create(cache)
 	cache = testKube.WaitForCacheConditionReady(cacheName, ispn.Name, tutils.Namespace)
 	testifyAssert.Equal(t, int64(1), cache.GetGeneration())
+	// The cache is created with a generated name, so that the cache name can be assigned as an alias of the migration target
+	sourceCache := fmt.Sprintf("%s-%d", cacheName, cache.GetGeneration())
+	testifyAssert.Equal(t, sourceCache, cache.Status.ServerCacheName)
 
 	// Populate cache
 	numEntries := 1
@@ -195,6 +199,9 @@ func TestUpdateRecreateStrategy(t *testing.T) {
 	testKube.Create(cache)
 	cache = testKube.WaitForCacheConditionReady(cacheName, ispn.Name, tutils.Namespace)
 	testifyAssert.Equal(t, int64(1), cache.GetGeneration())
+	// The cache is created with a generated name, so that the cache name can be assigned as an alias of the migration target
+	sourceCache := fmt.Sprintf("%s-%d", cacheName, cache.GetGeneration())
+	testifyAssert.Equal(t, sourceCache, cache.Status.ServerCacheName)
 
 	// Populate cache
 	numEntries := 1
@@ -242,6 +249,56 @@ func TestUpdateRecreateStrategy(t *testing.T) {
 	cacheHelper.AssertSize(0)
 }
 
+func TestUpdateMigrateStrategy(t *testing.T) {
+	tutils.SkipPriorTo(t, "15.0.0", "Cache aliases are only supported by Infinispan 15 or later")
+	t.Parallel()
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	ispn := initCluster(t, true)
+	cacheName := ispn.Name
+
+	// Create Cache CR with a typed configuration
+	cache := cacheCR(cacheName, ispn)
+	cache.Spec.Updates.Strategy = v2alpha1.CacheUpdateMigrate
+	cache.Spec.Configuration = &v2alpha1.CacheConfigurationSpec{
+		Type: v2alpha1.CacheTypeDistributed,
+	}
+	testKube.Create(cache)
+	cache = testKube.WaitForCacheConditionReady(cacheName, ispn.Name, tutils.Namespace)
+	testifyAssert.Equal(t, int64(1), cache.GetGeneration())
+	// The cache is created with a generated name, so that the cache name can be assigned as an alias of the migration target
+	sourceCache := fmt.Sprintf("%s-%d", cacheName, cache.GetGeneration())
+	testifyAssert.Equal(t, sourceCache, cache.Status.ServerCacheName)
+
+	// Populate cache
+	numEntries := 10
+	client := tutils.HTTPClientForCluster(ispn, testKube)
+	cacheHelper := tutils.NewCacheHelper(cacheName, client)
+	cacheHelper.Populate(numEntries)
+
+	// The cache type can't be changed at runtime, so the data must be migrated to a new cache
+	cache.Spec.Configuration.Type = v2alpha1.CacheTypeReplicated
+	testKube.Update(cache)
+
+	cache = testKube.WaitForCacheState(cacheName, ispn.Name, tutils.Namespace, func(cache *v2alpha1.Cache) bool {
+		m := cache.Status.Migration
+		return m != nil && m.Phase == v2alpha1.CacheMigrationSucceeded
+	})
+	targetCache := fmt.Sprintf("%s-%d", cacheName, cache.GetGeneration())
+	testifyAssert.Equal(t, sourceCache, cache.Status.Migration.SourceCache)
+	testifyAssert.Equal(t, targetCache, cache.Status.Migration.TargetCache)
+	testifyAssert.Equal(t, int64(numEntries), cache.Status.Migration.EntriesMigrated)
+	testifyAssert.Equal(t, targetCache, cache.Status.ServerCacheName)
+	testKube.WaitForCacheConditionReady(cacheName, ispn.Name, tutils.Namespace)
+
+	// Assert the entries are available via the cache alias
+	cacheHelper.AssertSize(numEntries)
+	config, err := cacheHelper.CacheClient.Config(mime.ApplicationJson)
+	tutils.ExpectNoError(err)
+	testifyAssert.Contains(t, config, "replicated-cache")
+	assertConfigListenerHasNoErrorsOrRestarts(t, ispn)
+}
+
 func TestCacheWithServerLifecycle(t *testing.T) {
 	t.Parallel()
 	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
@@ -423,6 +480,59 @@ func TestCacheWithJSON(t *testing.T) {
 	assertConfigListenerHasNoErrorsOrRestarts(t, ispn)
 }
 
+func TestCacheWithConfiguration(t *testing.T) {
+	t.Parallel()
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	ispn := initCluster(t, true)
+	cacheName := ispn.Name
+
+	// Create Cache CR with a typed configuration
+	cache := cacheCR(cacheName, ispn)
+	cache.Spec.Configuration = &v2alpha1.CacheConfigurationSpec{
+		Type:   v2alpha1.CacheTypeDistributed,
+		Owners: pointer.Int32(1),
+		Memory: &v2alpha1.CacheMemorySpec{
+			MaxCount: pointer.Int64(100),
+		},
+	}
+	testKube.Create(cache)
+	cache = testKube.WaitForCacheConditionReady(cacheName, ispn.Name, tutils.Namespace)
+	testifyAssert.Equal(t, int64(1), cache.GetGeneration())
+
+	type Cache struct {
+		Cache struct {
+			Owners string `json:"owners"`
+			Memory struct {
+				MaxCount string `json:"max-count"`
+			} `json:"memory"`
+		} `json:"distributed-cache"`
+	}
+
+	// Assert the configuration was converted to the server representation
+	client := tutils.HTTPClientForCluster(ispn, testKube)
+	cacheHelper := tutils.NewCacheHelper(cacheName, client)
+	config, err := cacheHelper.CacheClient.Config(mime.ApplicationJson)
+	tutils.ExpectNoError(err)
+	distCache := &Cache{}
+	tutils.ExpectNoError(json.Unmarshal([]byte(config), distCache))
+	testifyAssert.Equal(t, "1", distCache.Cache.Owners)
+	testifyAssert.Equal(t, "100", distCache.Cache.Memory.MaxCount)
+
+	// Update the configuration and assert that the change is applied on the server
+	cache.Spec.Configuration.Memory.MaxCount = pointer.Int64(50)
+	testKube.Update(cache)
+	tutils.ExpectNoError(wait.Poll(tutils.DefaultPollPeriod, tutils.SinglePodTimeout, func() (bool, error) {
+		config, err := cacheHelper.CacheClient.Config(mime.ApplicationJson)
+		if err != nil {
+			return false, err
+		}
+		tutils.ExpectNoError(json.Unmarshal([]byte(config), distCache))
+		return distCache.Cache.Memory.MaxCount == "50", nil
+	}))
+	assertConfigListenerHasNoErrorsOrRestarts(t, ispn)
+}
+
 func TestCacheClusterRecreate(t *testing.T) {
 	t.Parallel()
 	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
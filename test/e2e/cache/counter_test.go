@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	tutils "github.com/infinispan/infinispan-operator/test/e2e/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/pointer"
+)
+
+func TestCounterCR(t *testing.T) {
+	t.Parallel()
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	ispn := initClusterWithSuffix(t, "-counter", false)
+	client := tutils.HTTPClientForCluster(ispn, testKube)
+
+	counter := &v2alpha1.Counter{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "infinispan.org/v2alpha1",
+			Kind:       "Counter",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ispn.Name,
+			Namespace: ispn.Namespace,
+			Labels:    ispn.ObjectMeta.Labels,
+		},
+		Spec: v2alpha1.CounterSpec{
+			ClusterName:  ispn.Name,
+			Name:         "rate-limit",
+			Type:         v2alpha1.CounterTypeStrong,
+			InitialValue: 5,
+			LowerBound:   pointer.Int64(0),
+			UpperBound:   pointer.Int64(10),
+			Storage:      v2alpha1.CounterStorageVolatile,
+		},
+	}
+	testKube.Create(counter)
+
+	counterHasValue := func(value int64) func(*v2alpha1.Counter) bool {
+		return func(c *v2alpha1.Counter) bool {
+			return c.GetCondition(v2alpha1.CounterConditionReady).Status == metav1.ConditionTrue &&
+				c.Status.Value != nil && *c.Status.Value == value
+		}
+	}
+	testKube.WaitForCounterState(counter.Name, counter.Namespace, counterHasValue(5))
+
+	// Update the counter value on the server and ensure that the status is eventually updated
+	path := "rest/v2/counters/" + counter.Spec.Name
+	rsp, err := client.Post(path+"?action=increment", "", nil)
+	tutils.ExpectNoError(err)
+	tutils.ExpectNoError(rsp.Body.Close())
+	counter = testKube.WaitForCounterState(counter.Name, counter.Namespace, counterHasValue(6))
+
+	// Reset the counter via the CR annotation
+	if counter.Annotations == nil {
+		counter.Annotations = map[string]string{}
+	}
+	counter.Annotations[constants.CounterAnnotationReset] = "true"
+	testKube.Update(counter)
+	testKube.WaitForCounterState(counter.Name, counter.Namespace, func(c *v2alpha1.Counter) bool {
+		_, annotated := c.Annotations[constants.CounterAnnotationReset]
+		return !annotated && counterHasValue(5)(c)
+	})
+
+	// Assert that the counter is removed from the server once the Counter CR has been deleted
+	tutils.ExpectNoError(testKube.Kubernetes.Client.Delete(ctx, counter))
+	err = wait.Poll(tutils.ConditionPollPeriod, tutils.ConditionWaitTimeout, func() (bool, error) {
+		rsp, err := client.Get(path, nil)
+		if err != nil {
+			return false, err
+		}
+		defer rsp.Body.Close()
+		return rsp.StatusCode == http.StatusNotFound, nil
+	})
+	tutils.ExpectNoError(err)
+}
@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	"github.com/infinispan/infinispan-operator/pkg/mime"
+	tutils "github.com/infinispan/infinispan-operator/test/e2e/utils"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const personSchema = `package example;
+
+/* @Indexed */
+message Person {
+  /* @Basic */
+  optional string name = 1;
+}`
+
+func TestSchemaCR(t *testing.T) {
+	t.Parallel()
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	ispn := initClusterWithSuffix(t, "-schema", true)
+	client := tutils.HTTPClientForCluster(ispn, testKube)
+
+	schema := &v2alpha1.Schema{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "infinispan.org/v2alpha1",
+			Kind:       "Schema",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "person",
+			Namespace: ispn.Namespace,
+			Labels:    ispn.ObjectMeta.Labels,
+		},
+		Spec: v2alpha1.SchemaSpec{
+			ClusterName: ispn.Name,
+			// Introduce a syntax error so that the server reports a validation error
+			Schema: strings.Replace(personSchema, "message Person", "message Person Person", 1),
+		},
+	}
+
+	// Create a Cache that depends on the Schema before the Schema is Ready
+	cache := cacheCR("indexed-cache", ispn)
+	cache.Spec.Template = `{"distributed-cache":{"encoding":{"media-type":"application/x-protostream"},"indexing":{"enabled":true,"storage":"local-heap","indexed-entities":["example.Person"]}}}`
+	cache.Spec.Dependencies = &v2alpha1.CacheDependencies{
+		Schemas: []string{schema.Name},
+	}
+	testKube.Create(cache)
+	testKube.WaitForCacheState(cache.Spec.Name, cache.Spec.ClusterName, cache.Namespace, func(c *v2alpha1.Cache) bool {
+		condition := c.GetCondition(v2alpha1.CacheConditionReady)
+		return condition.Status == metav1.ConditionFalse && strings.Contains(condition.Message, schema.Name)
+	})
+
+	testKube.Create(schema)
+	schema = testKube.WaitForSchemaState(schema.Name, schema.Namespace, func(s *v2alpha1.Schema) bool {
+		return s.Status.ValidationError != nil
+	})
+	assert.False(t, schema.IsReady())
+
+	// Fix the schema and ensure that the dependent Cache is created
+	schema.Spec.Schema = personSchema
+	testKube.Update(schema)
+	testKube.WaitForSchemaState(schema.Name, schema.Namespace, func(s *v2alpha1.Schema) bool {
+		return s.IsReady() && s.Status.ValidationError == nil
+	})
+	testKube.WaitForCacheConditionReady(cache.Spec.Name, cache.Spec.ClusterName, cache.Namespace)
+
+	// Assert that the schema is removed from the server once the Schema CR has been deleted
+	path := "rest/v2/schemas/" + schema.GetSchemaName()
+	testKube.DeleteCache(cache)
+	tutils.ExpectNoError(testKube.Kubernetes.Client.Delete(ctx, schema))
+	waitForSchemaStatus(client, path, http.StatusNotFound)
+
+	// Assert that schemas created directly on the server are imported by the ConfigListener
+	path = "rest/v2/schemas/imported.proto"
+	rsp, err := client.Put(path, personSchema, map[string]string{"Content-Type": string(mime.TextPlain)})
+	tutils.ExpectNoError(err)
+	tutils.ExpectNoError(rsp.Body.Close())
+
+	// The imported Schema CR has a deterministic name, so that Cache CRs can depend on it
+	imported := testKube.WaitForSchemaState(ispn.Name+"-imported", ispn.Namespace, func(s *v2alpha1.Schema) bool {
+		return s.GetSchemaName() == "imported.proto"
+	})
+	assert.Equal(t, personSchema, imported.Spec.Schema)
+	assertConfigListenerHasNoErrorsOrRestarts(t, ispn)
+}
+
+func waitForSchemaStatus(client tutils.HTTPClient, path string, status int) {
+	err := wait.Poll(tutils.ConditionPollPeriod, tutils.ConditionWaitTimeout, func() (bool, error) {
+		rsp, err := client.Get(path, nil)
+		if err != nil {
+			return false, err
+		}
+		defer rsp.Body.Close()
+		return rsp.StatusCode == status, nil
+	})
+	tutils.ExpectNoError(err)
+}
@@ -3,13 +3,18 @@ package infinispan
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"testing"
 
 	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
 	cconsts "github.com/infinispan/infinispan-operator/controllers/constants"
 	ispnClient "github.com/infinispan/infinispan-operator/pkg/infinispan/client"
+	pipeline "github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan"
 	tutils "github.com/infinispan/infinispan-operator/test/e2e/utils"
+	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
@@ -71,6 +76,59 @@ func TestTLSConditionWithBadKeystore(t *testing.T) {
 	testKube.WaitForInfinispanConditionFalse(spec.Name, spec.Namespace, ispnv1.ConditionTLSSecretValid)
 }
 
+func TestTLSWithCertManager(t *testing.T) {
+	t.Parallel()
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	ok, err := testKube.Kubernetes.IsGroupVersionKindSupported(pipeline.CertificateGVK)
+	tutils.ExpectNoError(err)
+	if !ok {
+		t.Skip("cert-manager not available. Skipping test")
+	}
+
+	spec := tutils.DefaultSpec(t, testKube, func(i *ispnv1.Infinispan) {
+		i.Spec.Security = ispnv1.InfinispanSecurity{
+			EndpointEncryption: &ispnv1.EndpointEncryption{
+				Type: ispnv1.CertificateSourceTypeCertManager,
+				CertIssuer: &ispnv1.CertificateIssuerRef{
+					Name: i.Name + "-issuer",
+				},
+			},
+		}
+	})
+
+	// Create a self-signed Issuer, the issued certificate is also its own CA
+	issuer := &unstructured.Unstructured{}
+	issuer.SetGroupVersionKind(pipeline.CertificateGVK.GroupVersion().WithKind("Issuer"))
+	issuer.SetName(spec.Name + "-issuer")
+	issuer.SetNamespace(tutils.Namespace)
+	issuer.Object["spec"] = map[string]interface{}{
+		"selfSigned": map[string]interface{}{},
+	}
+	testKube.Create(issuer)
+	defer func() {
+		tutils.ExpectMaybeNotFound(testKube.Kubernetes.Client.Delete(context.TODO(), issuer))
+	}()
+
+	testKube.CreateInfinispan(spec, tutils.Namespace)
+	testKube.WaitForInfinispanCondition(spec.Name, spec.Namespace, ispnv1.ConditionTLSSecretValid)
+	testKube.WaitForInfinispanPods(1, tutils.SinglePodTimeout, spec.Name, tutils.Namespace)
+	ispn := testKube.WaitForInfinispanCondition(spec.Name, spec.Namespace, ispnv1.ConditionWellFormed)
+
+	secret := testKube.GetSecret(ispn.GetKeystoreSecretName(), tutils.Namespace)
+	defer testKube.DeleteSecret(secret)
+
+	// Ensure that we can connect to the endpoint with TLS using the issued CA
+	certPool := x509.NewCertPool()
+	assert.True(t, certPool.AppendCertsFromPEM(secret.Data["ca.crt"]))
+	tlsConfig := &tls.Config{
+		RootCAs:    certPool,
+		ServerName: ispn.GetServiceName(),
+	}
+	client_ := tutils.HTTPSClientForCluster(ispn, tlsConfig, testKube)
+	checkRestConnection(client_)
+}
+
 func checkRestConnection(client tutils.HTTPClient) {
 	_, err := ispnClient.New(tutils.CurrentOperand, client).Container().Members()
 	tutils.ExpectNoError(err)
@@ -0,0 +1,76 @@
+package infinispan
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	users "github.com/infinispan/infinispan-operator/pkg/infinispan/security"
+	tutils "github.com/infinispan/infinispan-operator/test/e2e/utils"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestEndpointConnectors(t *testing.T) {
+	tutils.SkipPriorTo(t, "15.0.0", "RESP endpoints require Infinispan 15.0.0 or later")
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	endpoint := ispnv1.EndpointSpec{
+		Name: "redis",
+		Type: ispnv1.EndpointConnectorTypeRESP,
+		Port: 6379,
+	}
+	spec := tutils.DefaultSpec(t, testKube, func(i *ispnv1.Infinispan) {
+		if i.IsExposed() && i.GetExposeType() == ispnv1.ExposeTypeNodePort {
+			endpoint.Expose = &ispnv1.ExposeSpec{Type: ispnv1.ExposeTypeNodePort}
+		}
+		i.Spec.Endpoints = []ispnv1.EndpointSpec{endpoint}
+	})
+
+	testKube.CreateInfinispan(spec, tutils.Namespace)
+	testKube.WaitForInfinispanPods(1, tutils.SinglePodTimeout, spec.Name, tutils.Namespace)
+	ispn := testKube.WaitForInfinispanCondition(spec.Name, spec.Namespace, ispnv1.ConditionWellFormed)
+
+	assert := assert.New(t)
+	require := require.New(t)
+
+	configMap := testKube.GetConfigMap(ispn.GetConfigName(), ispn.Namespace)
+	assert.Contains(configMap.Data["infinispan-base.xml"], `<resp-connector name="redis"/>`)
+
+	// The endpoint port must be available via the cluster Service
+	svc := &corev1.Service{}
+	require.NoError(testKube.Kubernetes.Client.Get(context.TODO(), types.NamespacedName{Name: ispn.GetServiceName(), Namespace: ispn.Namespace}, svc))
+	var found bool
+	for _, port := range svc.Spec.Ports {
+		if port.Name == endpoint.Name && port.Port == endpoint.Port {
+			found = true
+		}
+	}
+	assert.True(found, "endpoint port not found in the cluster Service")
+
+	if endpoint.Expose == nil {
+		return
+	}
+
+	external := &corev1.Service{}
+	require.NoError(testKube.Kubernetes.Client.Get(context.TODO(), types.NamespacedName{Name: ispn.GetEndpointServiceExternalName(&endpoint), Namespace: ispn.Namespace}, external))
+	host, err := testKube.Kubernetes.GetNodeHost(logr.Discard(), context.TODO())
+	require.NoError(err)
+	pass, err := users.UserPassword(constants.DefaultDeveloperUser, ispn.GetSecretName(), ispn.Namespace, testKube.Kubernetes, context.TODO())
+	require.NoError(err)
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", host, external.Spec.Ports[0].NodePort),
+		Username: constants.DefaultDeveloperUser,
+		Password: pass,
+	})
+	size, err := client.DBSize(context.TODO()).Result()
+	require.NoError(err)
+	assert.Equal(int64(0), size)
+}
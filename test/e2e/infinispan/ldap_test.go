@@ -0,0 +1,138 @@
+package infinispan
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ispnv1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/controllers/constants"
+	users "github.com/infinispan/infinispan-operator/pkg/infinispan/security"
+	tutils "github.com/infinispan/infinispan-operator/test/e2e/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	ldapServerName = "ldap"
+	ldapPort       = 1389
+	ldapRoot       = "dc=example,dc=org"
+	ldapUser       = "user01"
+	ldapPassword   = "password01"
+)
+
+func TestLDAPRealm(t *testing.T) {
+	t.Parallel()
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	secret := deployLDAPServer(tutils.TestName(t), tutils.Namespace)
+	defer testKube.DeleteSecret(secret)
+
+	spec := tutils.DefaultSpec(t, testKube, func(i *ispnv1.Infinispan) {
+		i.Spec.Security.LDAP = &ispnv1.LDAPRealmSpec{
+			URL:                      fmt.Sprintf("ldap://%s.%s.svc.cluster.local:%d", ldapServerName, tutils.Namespace, ldapPort),
+			BindDN:                   "cn=admin," + ldapRoot,
+			BindCredentialSecretName: secret.Name,
+			UserSearchDN:             "ou=users," + ldapRoot,
+			UserFilter:               "(cn={0})",
+			UserNameAttribute:        "cn",
+			GroupSearchDN:            "ou=users," + ldapRoot,
+		}
+	})
+
+	testKube.CreateInfinispan(spec, tutils.Namespace)
+	testKube.WaitForInfinispanPods(1, tutils.SinglePodTimeout, spec.Name, tutils.Namespace)
+	ispn := testKube.WaitForInfinispanCondition(spec.Name, spec.Namespace, ispnv1.ConditionWellFormed)
+
+	// Users are authenticated with their directory credentials
+	schema := testKube.GetSchemaForRest(ispn)
+	testAuthentication(ispn, schema, ldapUser, ldapPassword)
+
+	// The operator generated users remain valid alongside the directory users
+	pass, err := users.UserPassword(constants.DefaultDeveloperUser, ispn.GetSecretName(), ispn.Namespace, testKube.Kubernetes, context.TODO())
+	tutils.ExpectNoError(err)
+	testAuthentication(ispn, schema, constants.DefaultDeveloperUser, pass)
+}
+
+// deployLDAPServer creates a single node OpenLDAP server containing the test user and returns the secret containing the
+// password of the admin bind DN. The Deployment and Service are owned by the secret, so they're removed with it.
+func deployLDAPServer(testName, namespace string) *corev1.Secret {
+	labels := map[string]string{"app": ldapServerName}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ldapServerName + "-bind",
+			Namespace: namespace,
+			Labels:    map[string]string{"test-name": testName},
+		},
+		StringData: map[string]string{
+			constants.LDAPBindPasswordKey: "adminpassword",
+		},
+	}
+	testKube.CreateSecret(secret)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ldapServerName,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: pointer.Int32(1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  ldapServerName,
+						Image: tutils.LDAPImageName,
+						Env: []corev1.EnvVar{
+							{Name: "LDAP_ROOT", Value: ldapRoot},
+							{Name: "LDAP_PORT_NUMBER", Value: fmt.Sprint(ldapPort)},
+							{Name: "LDAP_ADMIN_USERNAME", Value: "admin"},
+							{Name: "LDAP_ADMIN_PASSWORD", ValueFrom: &corev1.EnvVarSource{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+									Key:                  constants.LDAPBindPasswordKey,
+								},
+							}},
+							{Name: "LDAP_USERS", Value: ldapUser},
+							{Name: "LDAP_PASSWORDS", Value: ldapPassword},
+						},
+						Ports: []corev1.ContainerPort{{ContainerPort: ldapPort}},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(ldapPort)},
+							},
+							PeriodSeconds: 1,
+						},
+					}},
+				},
+			},
+		},
+	}
+	tutils.ExpectNoError(controllerutil.SetControllerReference(secret, deployment, tutils.Scheme))
+	testKube.Create(deployment)
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ldapServerName,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports:    []corev1.ServicePort{{Port: ldapPort}},
+		},
+	}
+	tutils.ExpectNoError(controllerutil.SetControllerReference(secret, service, tutils.Scheme))
+	testKube.Create(service)
+
+	testKube.WaitForPods(1, tutils.SinglePodTimeout, &client.ListOptions{Namespace: namespace, LabelSelector: k8slabels.SelectorFromSet(labels)}, nil)
+	return secret
+}
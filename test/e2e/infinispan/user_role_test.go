@@ -0,0 +1,200 @@
+package infinispan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	v1 "github.com/infinispan/infinispan-operator/api/v1"
+	"github.com/infinispan/infinispan-operator/api/v2alpha1"
+	cconsts "github.com/infinispan/infinispan-operator/controllers/constants"
+	kube "github.com/infinispan/infinispan-operator/pkg/kubernetes"
+	"github.com/infinispan/infinispan-operator/pkg/reconcile/pipeline/infinispan/handler/provision"
+	tutils "github.com/infinispan/infinispan-operator/test/e2e/utils"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestUserAndRoleCRs(t *testing.T) {
+	t.Parallel()
+	defer testKube.CleanNamespaceAndLogOnPanic(t, tutils.Namespace)
+
+	ispn := tutils.DefaultSpec(t, testKube, func(i *v1.Infinispan) {
+		i.Spec.Replicas = 2
+		i.Spec.Security.Authorization = &v1.Authorization{
+			Enabled: true,
+		}
+	})
+	testKube.CreateInfinispan(ispn, tutils.Namespace)
+	testKube.WaitForInfinispanPods(2, tutils.SinglePodTimeout, ispn.Name, tutils.Namespace)
+	ispn = testKube.WaitForInfinispanCondition(ispn.Name, ispn.Namespace, v1.ConditionWellFormed)
+
+	adminClient := tutils.HTTPClientForCluster(ispn, testKube)
+	// Both caches restrict access to the admin role, so cache scoped roles are only added to the allowed-cache
+	for _, cacheName := range []string{"allowed-cache", "denied-cache"} {
+		cache := &v2alpha1.Cache{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "infinispan.org/v2alpha1",
+				Kind:       "Cache",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cacheName,
+				Namespace: ispn.Namespace,
+				Labels:    ispn.ObjectMeta.Labels,
+			},
+			Spec: v2alpha1.CacheSpec{
+				ClusterName: ispn.Name,
+				Name:        cacheName,
+				Template:    `{"distributed-cache":{"mode":"SYNC","security":{"authorization":{"enabled":true,"roles":["admin"]}}}}`,
+			},
+		}
+		cache.Default()
+		testKube.Create(cache)
+		testKube.WaitForCacheConditionReady(cacheName, ispn.Name, ispn.Namespace)
+	}
+
+	role := &v2alpha1.Role{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "infinispan.org/v2alpha1",
+			Kind:       "Role",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "reader",
+			Namespace: ispn.Namespace,
+			Labels:    ispn.ObjectMeta.Labels,
+		},
+		Spec: v2alpha1.RoleSpec{
+			ClusterName: ispn.Name,
+			Permissions: []v2alpha1.RolePermission{"ALL_READ"},
+			Scope:       v2alpha1.RoleScopeCache,
+			Caches:      []string{"allowed-cache"},
+		},
+	}
+	testKube.Create(role)
+	testKube.WaitForRoleState(role.Name, role.Namespace, func(r *v2alpha1.Role) bool {
+		return r.GetCondition(v2alpha1.RoleConditionReady).Status == metav1.ConditionTrue
+	})
+	// The role must be merged with the roles of the Cache CR template
+	testKube.WaitForCacheState("allowed-cache", ispn.Name, ispn.Namespace, func(c *v2alpha1.Cache) bool {
+		return len(c.Status.AuthorizationRoles) == 1 && c.Status.AuthorizationRoles[0] == role.Name
+	})
+
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ispn.Name + "-developer",
+			Namespace: ispn.Namespace,
+		},
+		Type:       corev1.SecretTypeOpaque,
+		StringData: map[string]string{cconsts.UserPasswordKey: "password"},
+	}
+	testKube.CreateSecret(secret)
+	defer testKube.DeleteSecret(secret)
+
+	user := &v2alpha1.User{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "infinispan.org/v2alpha1",
+			Kind:       "User",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "developer",
+			Namespace: ispn.Namespace,
+			Labels:    ispn.ObjectMeta.Labels,
+		},
+		Spec: v2alpha1.UserSpec{
+			ClusterName:        ispn.Name,
+			PasswordSecretName: secret.Name,
+			Groups:             []string{role.Name},
+		},
+	}
+	testKube.Create(user)
+	testKube.WaitForUserState(user.Name, user.Namespace, func(u *v2alpha1.User) bool {
+		return u.GetCondition(v2alpha1.UserConditionReady).Status == metav1.ConditionTrue
+	})
+
+	userClient := tutils.NewHTTPClient(user.GetUsername(), "password", testKube.GetSchemaForRest(ispn))
+	userClient.SetHostAndPort(adminClient.GetHostAndPort())
+
+	// The role only grants access to the caches listed in spec.caches
+	waitForStatus(userClient, "rest/v2/caches/allowed-cache?action=size", http.StatusOK)
+	waitForStatus(userClient, "rest/v2/caches/denied-cache?action=size", http.StatusForbidden)
+
+	// The user must be authenticated by every server, including a server that has been restarted
+	waitForPodsStatus(ispn, "password", "rest/v2/caches/allowed-cache?action=size", http.StatusOK)
+	pods := testKube.WaitForInfinispanPods(2, tutils.SinglePodTimeout, ispn.Name, tutils.Namespace)
+	tutils.ExpectNoError(testKube.Kubernetes.Client.Delete(context.TODO(), &pods.Items[0]))
+	testKube.WaitForInfinispanPods(2, tutils.SinglePodTimeout, ispn.Name, tutils.Namespace)
+	testKube.WaitForInfinispanCondition(ispn.Name, ispn.Namespace, v1.ConditionWellFormed)
+	waitForPodsStatus(ispn, "password", "rest/v2/caches/allowed-cache?action=size", http.StatusOK)
+
+	// Updating the password secret must replace the password on every server
+	secret = testKube.GetSecret(secret.Name, secret.Namespace)
+	secret.Data[cconsts.UserPasswordKey] = []byte("changed")
+	testKube.UpdateSecret(secret)
+	waitForPodsStatus(ispn, "changed", "rest/v2/caches/allowed-cache?action=size", http.StatusOK)
+	waitForPodsStatus(ispn, "password", "rest/v2/caches/allowed-cache?action=size", http.StatusUnauthorized)
+
+	// Removing the user from the group must revoke its access
+	user = testKube.WaitForUserState(user.Name, user.Namespace, func(u *v2alpha1.User) bool { return len(u.Status.Groups) > 0 })
+	user.Spec.Groups = nil
+	testKube.Update(user)
+	waitForPodsStatus(ispn, "changed", "rest/v2/caches/allowed-cache?action=size", http.StatusForbidden)
+
+	// Deleting the User CR must remove the user from every server
+	tutils.ExpectNoError(testKube.Kubernetes.Client.Delete(context.TODO(), user))
+	waitForPodsStatus(ispn, "changed", "rest/v2/caches/allowed-cache?action=size", http.StatusUnauthorized)
+
+	// Deleting the Role CR must remove the role from the cache configuration and the server, retaining the roles of
+	// the template
+	tutils.ExpectNoError(testKube.Kubernetes.Client.Delete(context.TODO(), role))
+	waitForStatus(adminClient, "rest/v2/security/permissions/"+role.GetRoleName(), http.StatusNotFound)
+	cache := testKube.WaitForCacheState("allowed-cache", ispn.Name, ispn.Namespace, func(c *v2alpha1.Cache) bool {
+		return len(c.Status.AuthorizationRoles) == 0
+	})
+	assert.Contains(t, cache.Spec.Template, "admin")
+	assert.NotContains(t, cache.Spec.Template, role.Name)
+	waitForStatus(adminClient, "rest/v2/caches/allowed-cache?action=size", http.StatusOK)
+}
+
+func waitForStatus(client tutils.HTTPClient, path string, status int) {
+	err := wait.Poll(tutils.ConditionPollPeriod, tutils.ConditionWaitTimeout, func() (bool, error) {
+		rsp, err := client.Get(path, nil)
+		if err != nil {
+			return false, err
+		}
+		tutils.ExpectNoError(rsp.Body.Close())
+		return rsp.StatusCode == status, nil
+	})
+	tutils.ExpectNoError(err)
+}
+
+// waitForPodsStatus waits until a request of the developer user to the server of every pod returns the status. The
+// requests are sent from within the server containers, so that each server is verified independently of the Service
+func waitForPodsStatus(i *v1.Infinispan, password, path string, status int) {
+	pods := testKube.WaitForInfinispanPods(int(i.Spec.Replicas), tutils.SinglePodTimeout, i.Name, tutils.Namespace)
+	url := fmt.Sprintf("%s://localhost:%d/%s", testKube.GetSchemaForRest(i), cconsts.InfinispanUserPort, path)
+	for _, pod := range pods.Items {
+		err := wait.Poll(tutils.ConditionPollPeriod, tutils.ConditionWaitTimeout, func() (bool, error) {
+			out, err := testKube.Kubernetes.ExecWithOptions(kube.ExecOptions{
+				Container: provision.InfinispanContainer,
+				Command:   []string{"curl", "-k", "-s", "-o", "/dev/null", "-w", "%{http_code}", "--digest", "-K", "-", url},
+				PodName:   pod.Name,
+				Namespace: pod.Namespace,
+				Stdin:     strings.NewReader(fmt.Sprintf("user = \"developer:%s\"\n", password)),
+			})
+			if err != nil {
+				return false, nil
+			}
+			return out.String() == strconv.Itoa(status), nil
+		})
+		tutils.ExpectNoError(err)
+	}
+}
@@ -48,6 +48,14 @@ var (
 	WebServerRootFolder = "/usr/share/nginx/html"
 	WebServerPortNumber = 8080
 
+	// The MinIO release used as an S3 compatible object store. Later releases no longer support creating buckets from
+	// directories in the data path
+	ObjectStoreImageName = constants.GetEnvWithDefault("TEST_MINIO_IMAGE", "quay.io/minio/minio:RELEASE.2022-10-24T18-35-07Z")
+
+	// The OpenLDAP server used as a stand-in for a corporate directory. The image creates the configured users and a
+	// group containing all of them on startup
+	LDAPImageName = constants.GetEnvWithDefault("TEST_LDAP_IMAGE", "docker.io/bitnami/openldap:2.6")
+
 	CleanupXSiteOnFinish = strings.ToUpper(constants.GetEnvWithDefault("TESTING_CLEANUP_XSITE_ON_FINISH", "TRUE")) == "TRUE"
 )
 
@@ -191,9 +191,15 @@ func (k TestKubernetes) CleanNamespaceAndLogWithPanic(t *testing.T, namespace st
 		k.WriteAllResourcesToFile(dir, "", namespace, "StatefulSet", &appsv1.StatefulSetList{}, map[string]string{})
 		k.WriteAllResourcesToFile(dir, "", namespace, "Infinispan", &ispnv1.InfinispanList{}, map[string]string{})
 		k.WriteAllResourcesToFile(dir, "", namespace, "Backup", &ispnv2.BackupList{}, map[string]string{})
+		k.WriteAllResourcesToFile(dir, "", namespace, "BackupSchedule", &ispnv2.BackupScheduleList{}, map[string]string{})
+		k.WriteAllResourcesToFile(dir, "", namespace, "XSiteOperation", &ispnv2.XSiteOperationList{}, map[string]string{})
 		k.WriteAllResourcesToFile(dir, "", namespace, "Restore", &ispnv2.RestoreList{}, map[string]string{})
 		k.WriteAllResourcesToFile(dir, "", namespace, "Batch", &ispnv2.BatchList{}, map[string]string{})
 		k.WriteAllResourcesToFile(dir, "", namespace, "Cache", &ispnv2.CacheList{}, map[string]string{})
+		k.WriteAllResourcesToFile(dir, "", namespace, "Counter", &ispnv2.CounterList{}, map[string]string{})
+		k.WriteAllResourcesToFile(dir, "", namespace, "Schema", &ispnv2.SchemaList{}, map[string]string{})
+		k.WriteAllResourcesToFile(dir, "", namespace, "User", &ispnv2.UserList{}, map[string]string{})
+		k.WriteAllResourcesToFile(dir, "", namespace, "Role", &ispnv2.RoleList{}, map[string]string{})
 		k.WriteAllMetricsToFile(dir, namespace)
 	}
 
@@ -207,8 +213,14 @@ func (k TestKubernetes) CleanNamespaceAndLogWithPanic(t *testing.T, namespace st
 
 		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.Batch{}, opts...))
 		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.Cache{}, opts...))
+		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.Counter{}, opts...))
+		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.Schema{}, opts...))
+		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.User{}, opts...))
+		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.Role{}, opts...))
 		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv1.Infinispan{}, opts...))
 		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.Restore{}, opts...))
+		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.BackupSchedule{}, opts...))
+		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.XSiteOperation{}, opts...))
 		ExpectMaybeNotFound(k.Kubernetes.Client.DeleteAllOf(ctx, &ispnv2.Backup{}, opts...))
 		k.WaitForPods(0, 3*SinglePodTimeout, &client.ListOptions{Namespace: namespace, LabelSelector: labels.SelectorFromSet(map[string]string{"app": "infinispan-pod", "infinispan_cr": specLabel["test-name"]})}, nil)
 		k.WaitForPods(0, 3*SinglePodTimeout, &client.ListOptions{Namespace: namespace, LabelSelector: labels.SelectorFromSet(map[string]string{"app": "infinispan-batch-pod"})}, nil)
@@ -885,7 +897,13 @@ func RunOperator(m *testing.M, k *TestKubernetes) {
 			k.DeleteNamespace(namespace)
 			k.DeleteCRD("infinispans.infinispan.org")
 			k.DeleteCRD("caches.infinispan.org")
+			k.DeleteCRD("counters.infinispan.org")
+			k.DeleteCRD("schemas.infinispan.org")
+			k.DeleteCRD("users.infinispan.org")
+			k.DeleteCRD("roles.infinispan.org")
 			k.DeleteCRD("backup.infinispan.org")
+			k.DeleteCRD("backupschedules.infinispan.org")
+			k.DeleteCRD("xsiteoperations.infinispan.org")
 			k.DeleteCRD("restore.infinispan.org")
 			k.DeleteCRD("batch.infinispan.org")
 			k.NewNamespace(namespace)
@@ -980,6 +998,73 @@ func (k TestKubernetes) WaitForCacheCondition(cacheName, clusterName, namespace
 	})
 }
 
+// WaitForCounterState retrieves the Counter CR with the provided name and namespace, then waits for the desired state
+func (k TestKubernetes) WaitForCounterState(name, namespace string, predicate func(*ispnv2.Counter) bool) *ispnv2.Counter {
+	counter := &ispnv2.Counter{}
+	err := wait.Poll(ConditionPollPeriod, ConditionWaitTimeout, func() (done bool, err error) {
+		if err = k.Kubernetes.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, counter); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return predicate(counter), nil
+	})
+	ExpectNoError(err)
+	return counter
+}
+
+// WaitForSchemaState retrieves the Schema CR with the provided name and namespace, then waits for the desired state
+func (k TestKubernetes) WaitForSchemaState(name, namespace string, predicate func(*ispnv2.Schema) bool) *ispnv2.Schema {
+	schema := &ispnv2.Schema{}
+	err := wait.Poll(ConditionPollPeriod, ConditionWaitTimeout, func() (done bool, err error) {
+		if err = k.Kubernetes.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, schema); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return predicate(schema), nil
+	})
+	ExpectNoError(err)
+	return schema
+}
+
+// WaitForUserState retrieves the User CR with the provided name and namespace, then waits for the desired state
+func (k TestKubernetes) WaitForUserState(name, namespace string, predicate func(*ispnv2.User) bool) *ispnv2.User {
+	user := &ispnv2.User{}
+	err := wait.Poll(ConditionPollPeriod, ConditionWaitTimeout, func() (done bool, err error) {
+		if err = k.Kubernetes.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, user); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return predicate(user), nil
+	})
+	ExpectNoError(err)
+	return user
+}
+
+// WaitForRoleState retrieves the Role CR with the provided name and namespace, then waits for the desired state
+func (k TestKubernetes) WaitForRoleState(name, namespace string, predicate func(*ispnv2.Role) bool) *ispnv2.Role {
+	role := &ispnv2.Role{}
+	err := wait.Poll(ConditionPollPeriod, ConditionWaitTimeout, func() (done bool, err error) {
+		if err = k.Kubernetes.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, role); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return predicate(role), nil
+	})
+	ExpectNoError(err)
+	return role
+}
+
+func (k TestKubernetes) WaitForXSiteOperationPhase(name, namespace string, phase ispnv2.XSiteOperationPhase) *ispnv2.XSiteOperation {
+	operation := &ispnv2.XSiteOperation{}
+	err := wait.Poll(ConditionPollPeriod, ConditionWaitTimeout, func() (done bool, err error) {
+		if err = k.Kubernetes.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, operation); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		if operation.Status.Phase == ispnv2.XSiteOperationFailed && phase != ispnv2.XSiteOperationFailed {
+			return true, fmt.Errorf("xsite operation failed. Reason: %s", operation.Status.Reason)
+		}
+		return phase == operation.Status.Phase, nil
+	})
+	ExpectNoError(err)
+	return operation
+}
+
 // GetStatefulSet gets an Infinispan resource in the given namespace
 func (k TestKubernetes) GetStatefulSet(name, namespace string) *appsv1.StatefulSet {
 	infinispan := &appsv1.StatefulSet{}
@@ -945,3 +945,95 @@ func getVersionManager(t *testing.T, kube *tutils.TestKubernetes) *version.Manag
 	tutils.ExpectNoError(err)
 	return versionManager
 }
+
+func TestXSiteOperation(t *testing.T) {
+	testName := tutils.TestName(t)
+	testKubes := map[string]*crossSiteKubernetes{"xsite1": {}, "xsite2": {}}
+	clientConfig := clientcmd.GetConfigFromFileOrDie(tutils.FindKubeConfig())
+
+	testKubes["xsite1"].crossSite = *crossSiteSpec(strcase.ToKebab(testName), 1, "xsite1", "xsite2", "", ispnv1.CrossSiteExposeTypeClusterIP, 0, 0)
+	testKubes["xsite2"].crossSite = *crossSiteSpec(strcase.ToKebab(testName), 1, "xsite2", "xsite1", "", ispnv1.CrossSiteExposeTypeClusterIP, 0, 0)
+	for _, testKube := range testKubes {
+		testKube.context = clientConfig.CurrentContext
+		testKube.namespace = fmt.Sprintf("%s-%s", tutils.Namespace, "xsite2")
+		testKube.kube = tutils.NewTestKubernetes(testKube.context)
+		testKube.crossSite.ObjectMeta.Labels = map[string]string{"test-name": testName}
+	}
+
+	defer testKubes["xsite1"].kube.CleanNamespaceAndLogOnPanic(t, testKubes["xsite1"].namespace)
+	defer testKubes["xsite2"].kube.CleanNamespaceAndLogOnPanic(t, testKubes["xsite2"].namespace)
+
+	for _, testKube := range testKubes {
+		testKube.kube.CreateInfinispan(&testKube.crossSite, testKube.namespace)
+	}
+	for _, testKube := range testKubes {
+		testKube.kube.WaitForInfinispanPods(1, tutils.SinglePodTimeout, testKube.crossSite.Name, testKube.namespace)
+		testKube.kube.WaitForInfinispanCondition(testKube.crossSite.Name, testKube.namespace, ispnv1.ConditionCrossSiteViewFormed)
+	}
+
+	site1 := testKubes["xsite1"]
+	versionManager := getVersionManager(t, site1.kube)
+	client := tutils.HTTPClientForClusterWithVersionManager(&site1.crossSite, site1.kube, versionManager)
+	cacheName := "xsiteOperationCache"
+	cache := tutils.NewCacheHelper(cacheName, client)
+	cache.Create("{\"distributed-cache\":{\"mode\":\"SYNC\",\"backups\":{\"xsite2\":{\"backup\":{\"strategy\":\"ASYNC\"}}}}}", mime.ApplicationJson)
+
+	site2 := testKubes["xsite2"]
+	backupCache := tutils.NewCacheHelper(cacheName, tutils.HTTPClientForClusterWithVersionManager(&site2.crossSite, site2.kube, versionManager))
+	backupCache.Create("{\"distributed-cache\":{\"mode\":\"SYNC\",\"backups\":{\"xsite1\":{\"backup\":{\"strategy\":\"ASYNC\"}}}}}", mime.ApplicationJson)
+
+	// Executes the operation on all caches that backup to xsite2 and returns the result of the xsiteOperationCache
+	execute := func(name string, operation ispnv2.XSiteOperationType, takeOffline *ispnv2.XSiteTakeOfflineSpec) ispnv2.XSiteCacheResult {
+		spec := &ispnv2.XSiteOperation{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "infinispan.org/v2alpha1",
+				Kind:       "XSiteOperation",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: site1.namespace,
+				Labels:    map[string]string{"test-name": testName},
+			},
+			Spec: ispnv2.XSiteOperationSpec{
+				Cluster:     site1.crossSite.Name,
+				Operation:   operation,
+				Site:        "xsite2",
+				TakeOffline: takeOffline,
+			},
+		}
+		site1.kube.Create(spec)
+		result := site1.kube.WaitForXSiteOperationPhase(name, site1.namespace, ispnv2.XSiteOperationSucceeded)
+		for _, c := range result.Status.Caches {
+			if c.Name == cacheName {
+				return c
+			}
+		}
+		assert.Failf(t, "cache result not found", "XSiteOperation '%s' has no result for cache '%s'", name, cacheName)
+		return ispnv2.XSiteCacheResult{}
+	}
+
+	assert.Equal(t, "offline", execute("take-offline", ispnv2.XSiteOperationTakeOffline, nil).Status)
+
+	// Ensure that the cross-site status reports the offline backup
+	ispn := site1.kube.WaitForInfinispanState(site1.crossSite.Name, site1.namespace, func(i *ispnv1.Infinispan) bool {
+		crossSite := i.Status.CrossSite
+		if crossSite == nil || len(crossSite.Caches) == 0 {
+			return false
+		}
+		for _, c := range crossSite.Caches {
+			if c.Name == cacheName {
+				return len(c.Backups) == 1 && c.Backups[0].Status == "offline"
+			}
+		}
+		return false
+	})
+	assert.Equal(t, []ispnv1.CrossSiteLocationStatus{{Name: "xsite2", Reachable: true, Status: "offline"}}, ispn.Status.CrossSite.Sites)
+	assert.NotEmpty(t, ispn.Status.CrossSite.RelayNodes)
+
+	assert.Equal(t, "online", execute("bring-online", ispnv2.XSiteOperationBringOnline, nil).Status)
+	execute("configure-take-offline", ispnv2.XSiteOperationConfigureTakeOffline, &ispnv2.XSiteTakeOfflineSpec{AfterFailures: 5, MinWait: 1000})
+
+	cache.Populate(10)
+	assert.Equal(t, "OK", execute("push-state", ispnv2.XSiteOperationPushState, nil).Status)
+	backupCache.AssertSize(10)
+}